@@ -0,0 +1,184 @@
+package bridge
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+	"github.com/qameta/betterstack/webhooks"
+	"github.com/thoas/go-funk"
+)
+
+// Formatter turns an incident event into the JSON payload of a chat webhook
+type Formatter func(event webhooks.Event) any
+
+// ChatBridge posts incident events to a chat incoming webhook in the format of the platform. It is a
+// webhooks.Handler like every other consumer of the event stream.
+type ChatBridge struct {
+	URL    string
+	Format Formatter
+
+	// Don't post events replayed by webhooks.Backfill
+	SkipReplayed bool
+
+	HTTPClient *http.Client
+}
+
+func NewTeamsBridge(webhookURL string) *ChatBridge {
+	return &ChatBridge{URL: webhookURL, Format: TeamsFormatter}
+}
+
+func NewDiscordBridge(webhookURL string) *ChatBridge {
+	return &ChatBridge{URL: webhookURL, Format: DiscordFormatter}
+}
+
+func (b *ChatBridge) Handle(ctx context.Context, event webhooks.Event) error {
+	if event.Replayed && b.SkipReplayed {
+		return nil
+	}
+	if funk.IsEmpty(b.URL) || b.Format == nil {
+		return errors.New("chat bridge needs a webhook URL and a formatter")
+	}
+
+	var serialized, serErr = json.Marshal(b.Format(event))
+	if serErr != nil {
+		return fmt.Errorf("failed to serialize message: %v", serErr)
+	}
+
+	var request, requestErr = http.NewRequestWithContext(ctx, http.MethodPost, b.URL, bytes.NewReader(serialized))
+	if requestErr != nil {
+		return fmt.Errorf("failed to create request: %v", requestErr)
+	}
+	request.Header.Set(client.ContentType, client.ApplicationJSON)
+
+	var httpClient = b.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	var response, responseErr = httpClient.Do(request)
+	if responseErr != nil {
+		return fmt.Errorf("failed to execute request: %v", responseErr)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusBadRequest {
+		var detail, _ = io.ReadAll(io.LimitReader(response.Body, 1<<10))
+		return fmt.Errorf("webhook answered %s: %s", response.Status, strings.TrimSpace(string(detail)))
+	}
+	return nil
+}
+
+type fact struct {
+	Name  string
+	Value string
+}
+
+// headline and facts are shared by all formatters, so every platform tells the same story
+func headline(event webhooks.Event) string {
+	switch event.Kind() {
+	case webhooks.EventAcknowledged:
+		return fmt.Sprintf("%s: incident acknowledged%s", event.Incident.Name, by(event.Incident.AcknowledgedBy))
+	case webhooks.EventResolved:
+		return fmt.Sprintf("%s is back up%s", event.Incident.Name, by(event.Incident.ResolvedBy))
+	default:
+		return fmt.Sprintf("%s is down", event.Incident.Name)
+	}
+}
+
+func facts(event webhooks.Event) []fact {
+	var result []fact
+	var add = func(name, value string) {
+		if funk.NotEmpty(value) {
+			result = append(result, fact{Name: name, Value: value})
+		}
+	}
+	add("Cause", event.Incident.Cause)
+	add("URL", event.Incident.URL)
+	if event.Incident.StartedAt != nil {
+		add("Started", event.Incident.StartedAt.UTC().Format("2006-01-02 15:04:05 MST"))
+	}
+	if event.Incident.StartedAt != nil && event.Incident.ResolvedAt != nil {
+		add("Duration", event.Incident.ResolvedAt.Sub(*event.Incident.StartedAt).Round(time.Second).String())
+	}
+	add("Regions", strings.Join(event.Incident.Regions, ", "))
+	add("Incident", event.Incident.ID)
+	return result
+}
+
+// TeamsFormatter renders an Adaptive Card, accepted by Teams incoming webhooks and Workflows
+func TeamsFormatter(event webhooks.Event) any {
+	var color = "Attention"
+	switch event.Kind() {
+	case webhooks.EventAcknowledged:
+		color = "Warning"
+	case webhooks.EventResolved:
+		color = "Good"
+	}
+
+	var factSet []map[string]string
+	for _, f := range facts(event) {
+		factSet = append(factSet, map[string]string{"title": f.Name, "value": f.Value})
+	}
+
+	var card = map[string]any{
+		"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+		"type":    "AdaptiveCard",
+		"version": "1.4",
+		"body": []map[string]any{
+			{"type": "TextBlock", "text": headline(event), "size": "Large", "weight": "Bolder", "color": color, "wrap": true},
+			{"type": "FactSet", "facts": factSet},
+		},
+	}
+	if funk.NotEmpty(event.Incident.URL) {
+		card["actions"] = []map[string]string{{"type": "Action.OpenUrl", "title": "Open checked URL", "url": event.Incident.URL}}
+	}
+
+	return map[string]any{
+		"type": "message",
+		"attachments": []map[string]any{
+			{"contentType": "application/vnd.microsoft.card.adaptive", "content": card},
+		},
+	}
+}
+
+// Embed colors of Discord messages, red, amber and green
+const discordColorStarted = 0xE5484D
+const discordColorAcknowledged = 0xF5A524
+const discordColorResolved = 0x30A46C
+
+// DiscordFormatter renders a Discord webhook message with one embed
+func DiscordFormatter(event webhooks.Event) any {
+	var color = discordColorStarted
+	switch event.Kind() {
+	case webhooks.EventAcknowledged:
+		color = discordColorAcknowledged
+	case webhooks.EventResolved:
+		color = discordColorResolved
+	}
+
+	var fields []map[string]any
+	for _, f := range facts(event) {
+		fields = append(fields, map[string]any{"name": f.Name, "value": f.Value, "inline": f.Name != "URL"})
+	}
+
+	var embed = map[string]any{
+		"title":  headline(event),
+		"color":  color,
+		"fields": fields,
+	}
+	if at := event.OccurredAt(); !at.IsZero() {
+		embed["timestamp"] = at.UTC().Format(time.RFC3339)
+	}
+
+	return map[string]any{
+		"username": "Better Stack",
+		"embeds":   []map[string]any{embed},
+	}
+}
@@ -0,0 +1,260 @@
+package bridge
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+	"github.com/qameta/betterstack/webhooks"
+	"github.com/thoas/go-funk"
+)
+
+const DefaultGitHubURL = "https://api.github.com"
+
+const DefaultIssueTitle = "Outage: {{ .Incident.Name }}"
+
+const DefaultIssueBody = `**{{ .Incident.Name }}** is down{{ with .Incident.Cause }}: {{ . }}{{ end }}.
+{{ with .Incident.URL }}
+Checked URL: {{ . }}{{ end }}
+{{ with .Incident.StartedAt }}Started: {{ .UTC.Format "2006-01-02 15:04:05 MST" }}{{ end }}
+{{ with .Incident.Regions }}Regions: {{ join . ", " }}{{ end }}
+
+_Opened from Better Stack incident {{ .Incident.ID }}, this issue is closed when the incident resolves._`
+
+// Hidden marker in the issue body tying the issue to its incident
+const issueMarker = "<!-- betterstack-incident: %s -->"
+
+// Open and closed issues with the label searched for the issue of an incident, newest first
+const issueSearchPages = 5
+
+type GitHubOptions struct {
+	Token string
+
+	// Repository as owner/name
+	Repository string
+
+	// Labels of opened issues, the first one also finds the issue of an incident again. Defaults to "incident".
+	Labels []string
+
+	// Issue title and body templates, rendered with the webhooks.Event. Default to DefaultIssueTitle and
+	// DefaultIssueBody. The body template may use join.
+	Title string
+	Body  string
+
+	// Don't open issues for events replayed by webhooks.Backfill
+	SkipReplayed bool
+
+	// API root, set for GitHub Enterprise. Defaults to DefaultGitHubURL.
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+// GitHubBridge opens an issue when an incident starts, comments when it is acknowledged and comments and closes it
+// when it resolves. It is a webhooks.Handler, so it consumes webhook deliveries, backfills and any other event
+// stream alike.
+type GitHubBridge struct {
+	opts  GitHubOptions
+	title *template.Template
+	body  *template.Template
+
+	// Incident ID to issue number, found issues are remembered
+	mu     sync.Mutex
+	issues map[string]int
+}
+
+type githubIssue struct {
+	Number int    `json:"number,omitempty"`
+	Title  string `json:"title,omitempty"`
+	Body   string `json:"body,omitempty"`
+	State  string `json:"state,omitempty"`
+
+	Labels []string `json:"labels,omitempty"`
+}
+
+var templateFuncs = template.FuncMap{"join": strings.Join}
+
+func NewGitHubBridge(opts GitHubOptions) (*GitHubBridge, error) {
+	if funk.IsEmpty(opts.Token) {
+		return nil, errors.New("a GitHub token is required")
+	}
+	if parts := strings.Split(opts.Repository, "/"); len(parts) != 2 || funk.IsEmpty(parts[0]) || funk.IsEmpty(parts[1]) {
+		return nil, fmt.Errorf("repository %q is not owner/name", opts.Repository)
+	}
+	if len(opts.Labels) == 0 {
+		opts.Labels = []string{"incident"}
+	}
+	if funk.IsEmpty(opts.Title) {
+		opts.Title = DefaultIssueTitle
+	}
+	if funk.IsEmpty(opts.Body) {
+		opts.Body = DefaultIssueBody
+	}
+	if funk.IsEmpty(opts.BaseURL) {
+		opts.BaseURL = DefaultGitHubURL
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	var title, titleErr = template.New("title").Funcs(templateFuncs).Parse(opts.Title)
+	if titleErr != nil {
+		return nil, fmt.Errorf("failed to parse title template: %v", titleErr)
+	}
+	var body, bodyErr = template.New("body").Funcs(templateFuncs).Parse(opts.Body)
+	if bodyErr != nil {
+		return nil, fmt.Errorf("failed to parse body template: %v", bodyErr)
+	}
+
+	return &GitHubBridge{opts: opts, title: title, body: body, issues: map[string]int{}}, nil
+}
+
+func (b *GitHubBridge) Handle(ctx context.Context, event webhooks.Event) error {
+	if event.Replayed && b.opts.SkipReplayed {
+		return nil
+	}
+
+	var number, findErr = b.findIssue(ctx, event.Incident.ID)
+	if findErr != nil {
+		return findErr
+	}
+	if number == 0 {
+		var openErr error
+		if number, openErr = b.openIssue(ctx, event); openErr != nil {
+			return openErr
+		}
+	}
+
+	switch event.Kind() {
+	case webhooks.EventAcknowledged:
+		return b.comment(ctx, number, fmt.Sprintf("Acknowledged%s.", by(event.Incident.AcknowledgedBy)))
+	case webhooks.EventResolved:
+		if commentErr := b.comment(ctx, number, fmt.Sprintf("Resolved%s.", by(event.Incident.ResolvedBy))); commentErr != nil {
+			return commentErr
+		}
+		return b.request(ctx, http.MethodPatch, b.repoPath("/issues/%d", number), githubIssue{State: "closed"}, nil)
+	}
+	return nil
+}
+
+func by(who string) string {
+	if funk.IsEmpty(who) {
+		return client.Blanc
+	}
+	return " by " + who
+}
+
+func (b *GitHubBridge) openIssue(ctx context.Context, event webhooks.Event) (int, error) {
+	var title, body bytes.Buffer
+	if renderErr := b.title.Execute(&title, event); renderErr != nil {
+		return 0, fmt.Errorf("failed to render issue title: %v", renderErr)
+	}
+	if renderErr := b.body.Execute(&body, event); renderErr != nil {
+		return 0, fmt.Errorf("failed to render issue body: %v", renderErr)
+	}
+	body.WriteString("\n\n" + fmt.Sprintf(issueMarker, event.Incident.ID))
+
+	var created githubIssue
+	var issue = githubIssue{Title: strings.TrimSpace(title.String()), Body: body.String(), Labels: b.opts.Labels}
+	if createErr := b.request(ctx, http.MethodPost, b.repoPath("/issues"), issue, &created); createErr != nil {
+		return 0, createErr
+	}
+
+	b.mu.Lock()
+	b.issues[event.Incident.ID] = created.Number
+	b.mu.Unlock()
+	return created.Number, nil
+}
+
+// findIssue returns the number of the issue opened for the incident, 0 when there is none
+func (b *GitHubBridge) findIssue(ctx context.Context, incidentID string) (int, error) {
+	b.mu.Lock()
+	var number, known = b.issues[incidentID]
+	b.mu.Unlock()
+	if known {
+		return number, nil
+	}
+
+	var marker = fmt.Sprintf(issueMarker, incidentID)
+	for page := 1; page <= issueSearchPages; page++ {
+		var params = url.Values{}
+		params.Set("labels", b.opts.Labels[0])
+		params.Set("state", "all")
+		params.Set("per_page", "100")
+		params.Set("page", fmt.Sprintf("%d", page))
+
+		var issues []githubIssue
+		if listErr := b.request(ctx, http.MethodGet, b.repoPath("/issues")+"?"+params.Encode(), nil, &issues); listErr != nil {
+			return 0, listErr
+		}
+		for _, issue := range issues {
+			if strings.Contains(issue.Body, marker) {
+				b.mu.Lock()
+				b.issues[incidentID] = issue.Number
+				b.mu.Unlock()
+				return issue.Number, nil
+			}
+		}
+		if len(issues) < 100 {
+			break
+		}
+	}
+	return 0, nil
+}
+
+func (b *GitHubBridge) comment(ctx context.Context, number int, text string) error {
+	return b.request(ctx, http.MethodPost, b.repoPath("/issues/%d/comments", number), map[string]string{"body": text}, nil)
+}
+
+func (b *GitHubBridge) repoPath(format string, args ...any) string {
+	return strings.TrimSuffix(b.opts.BaseURL, "/") + "/repos/" + b.opts.Repository + fmt.Sprintf(format, args...)
+}
+
+func (b *GitHubBridge) request(ctx context.Context, method, target string, payload, result any) error {
+	var body io.Reader
+	if payload != nil {
+		var serialized, serErr = json.Marshal(payload)
+		if serErr != nil {
+			return serErr
+		}
+		body = bytes.NewReader(serialized)
+	}
+
+	var request, requestErr = http.NewRequestWithContext(ctx, method, target, body)
+	if requestErr != nil {
+		return fmt.Errorf("failed to create request: %v", requestErr)
+	}
+	request.Header.Set("Authorization", "Bearer "+b.opts.Token)
+	request.Header.Set("Accept", "application/vnd.github+json")
+	request.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if payload != nil {
+		request.Header.Set(client.ContentType, client.ApplicationJSON)
+	}
+
+	var response, responseErr = b.opts.HTTPClient.Do(request)
+	if responseErr != nil {
+		return fmt.Errorf("failed to execute request: %v", responseErr)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusBadRequest {
+		var detail, _ = io.ReadAll(io.LimitReader(response.Body, 1<<10))
+		return fmt.Errorf("GitHub answered %s: %s", response.Status, strings.TrimSpace(string(detail)))
+	}
+	if result == nil {
+		return nil
+	}
+	if unmErr := json.NewDecoder(response.Body).Decode(result); unmErr != nil {
+		return fmt.Errorf("failed to unmarshal response: %v", unmErr)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package capacity
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/qameta/betterstack/client"
+)
+
+// DefaultCheckFrequency is applied by the API when check_frequency is not set. In seconds.
+const DefaultCheckFrequency = 180
+
+const secondsPerMonth = 30 * 24 * 60 * 60
+
+// DefaultRegions are used by the API when a monitor does not set regions
+var DefaultRegions = []string{client.RegionUS, client.RegionEU, client.RegionAsia, client.RegionAustralia}
+
+type PlanLimits struct {
+	// Lowest check frequency the plan allows. In seconds, 0 means no floor.
+	MinCheckFrequency int
+
+	// Maximum number of monitors, 0 means unlimited
+	MaxMonitors int
+
+	// Maximum number of checks per month the plan includes, 0 means unlimited
+	MaxChecksPerMonth float64
+
+	// Weight of a check run from the region, regions not listed weigh 1
+	RegionMultipliers map[string]float64
+}
+
+type MonitorCost struct {
+	Monitor        client.Monitor
+	CheckFrequency int
+	Regions        []string
+	ChecksPerMonth float64
+}
+
+type Overage struct {
+	Monitor client.Monitor
+	Reason  string
+}
+
+type Estimation struct {
+	Limits         PlanLimits
+	Monitors       int
+	ChecksPerMonth float64
+
+	// Cost of every monitor, most expensive first
+	Costs []MonitorCost
+
+	// Monitors responsible for exceeding the plan
+	Overages []Overage
+}
+
+func (e Estimation) Fits() bool {
+	return len(e.Overages) == 0
+}
+
+// Estimate computes the load the desired monitors put on the plan and lists the monitors which do not fit. When the
+// monthly check budget is exceeded, the most expensive monitors are blamed until the remainder fits.
+func Estimate(monitors []client.Monitor, limits PlanLimits) Estimation {
+	var result = Estimation{
+		Limits:   limits,
+		Monitors: len(monitors),
+	}
+
+	for _, monitor := range monitors {
+		var cost = Cost(monitor, limits)
+		result.Costs = append(result.Costs, cost)
+		result.ChecksPerMonth += cost.ChecksPerMonth
+
+		if limits.MinCheckFrequency > 0 && cost.CheckFrequency < limits.MinCheckFrequency {
+			result.Overages = append(result.Overages, Overage{
+				Monitor: monitor,
+				Reason: fmt.Sprintf("check_frequency %ds is below the plan floor of %ds",
+					cost.CheckFrequency, limits.MinCheckFrequency),
+			})
+		}
+	}
+
+	if limits.MaxMonitors > 0 && len(monitors) > limits.MaxMonitors {
+		for _, monitor := range monitors[limits.MaxMonitors:] {
+			result.Overages = append(result.Overages, Overage{
+				Monitor: monitor,
+				Reason:  fmt.Sprintf("exceeds the plan limit of %d monitors", limits.MaxMonitors),
+			})
+		}
+	}
+
+	sort.SliceStable(result.Costs, func(i, j int) bool {
+		return result.Costs[i].ChecksPerMonth > result.Costs[j].ChecksPerMonth
+	})
+
+	if limits.MaxChecksPerMonth > 0 && result.ChecksPerMonth > limits.MaxChecksPerMonth {
+		var remaining = result.ChecksPerMonth
+		for _, cost := range result.Costs {
+			if remaining <= limits.MaxChecksPerMonth {
+				break
+			}
+			remaining -= cost.ChecksPerMonth
+			result.Overages = append(result.Overages, Overage{
+				Monitor: cost.Monitor,
+				Reason: fmt.Sprintf("uses %.0f of %.0f monthly checks, total demand is %.0f",
+					cost.ChecksPerMonth, limits.MaxChecksPerMonth, result.ChecksPerMonth),
+			})
+		}
+	}
+
+	return result
+}
+
+// Cost returns the monthly check volume of a single monitor weighted by region multipliers
+func Cost(monitor client.Monitor, limits PlanLimits) MonitorCost {
+	var frequency = monitor.CheckFrequency
+	if frequency <= 0 {
+		frequency = DefaultCheckFrequency
+	}
+
+	var regions = monitor.Regions
+	if len(regions) == 0 {
+		regions = DefaultRegions
+	}
+
+	var weight float64
+	for _, region := range regions {
+		var multiplier, found = limits.RegionMultipliers[region]
+		if !found {
+			multiplier = 1
+		}
+		weight += multiplier
+	}
+
+	return MonitorCost{
+		Monitor:        monitor,
+		CheckFrequency: frequency,
+		Regions:        regions,
+		ChecksPerMonth: float64(secondsPerMonth) / float64(frequency) * weight,
+	}
+}
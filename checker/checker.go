@@ -0,0 +1,280 @@
+package checker
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+const DefaultTimeout = 30 * time.Second
+
+// Keyword checks inspect at most this many bytes of the response body
+const MaxBodySize = 10 << 20
+
+var ErrUnsupportedMonitorType = errors.New("monitor type is not supported by the local checker")
+
+type Result struct {
+	Monitor   client.Monitor
+	Up        bool
+	CheckedAt time.Time
+	Duration  time.Duration
+
+	// Why the check failed, empty when the check passed
+	Reason string
+
+	// HTTP status code, set for HTTP based checks
+	StatusCode int
+
+	// Duration reported by the Playwright runner, set for playwright checks
+	ScenarioDuration time.Duration
+
+	// Set when the check could not be executed at all (as opposed to the target being down)
+	Err error
+}
+
+type Checker struct {
+	// Used when the monitor does not set request_timeout
+	Timeout time.Duration
+
+	// Skip certificate verification for HTTPS checks. The monitor verify_ssl flag is not consulted because an unset
+	// flag is indistinguishable from false.
+	InsecureSkipVerify bool
+
+	// Path of the ping binary used for ping checks
+	PingCommand string
+
+	// Path of the npx binary used to start the Playwright runner for playwright checks
+	NpxCommand string
+
+	// Node project with @playwright/test installed the scenarios are run in, defaults to the temp directory
+	PlaywrightDir string
+}
+
+func New() *Checker {
+	return &Checker{
+		Timeout:     DefaultTimeout,
+		PingCommand: "ping",
+		NpxCommand:  "npx",
+	}
+}
+
+// Check executes a monitor definition once with the default checker
+func Check(ctx context.Context, monitor client.Monitor) Result {
+	return New().Check(ctx, monitor)
+}
+
+// Check executes the monitor definition once, mirroring the semantics Better Stack applies to the monitor type
+func (c *Checker) Check(ctx context.Context, monitor client.Monitor) Result {
+	var timeout = c.Timeout
+	if monitor.RequestTimeout > 0 {
+		timeout = time.Duration(monitor.RequestTimeout) * time.Second
+	}
+
+	var checkCtx, cancel = context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var result = Result{
+		Monitor:   monitor,
+		CheckedAt: time.Now(),
+	}
+
+	switch monitor.MonitorType {
+	case client.MonitorTypeStatus, client.MonitorTypeStatusCode, client.MonitorTypeExpectedStatusCode,
+		client.MonitorTypeKeyword, client.MonitorTypeKeywordAbsence:
+		c.checkHTTP(checkCtx, monitor, &result)
+	case client.MonitorTypeTCP:
+		c.checkTCP(checkCtx, monitor, &result)
+	case client.MonitorTypePing:
+		c.checkPing(checkCtx, monitor, timeout, &result)
+	case client.MonitorTypeDNS:
+		c.checkDNS(checkCtx, monitor, &result)
+	case client.MonitorTypePlaywright:
+		c.checkPlaywright(checkCtx, monitor, &result)
+	default:
+		result.Err = fmt.Errorf("%s: %w", monitor.MonitorType, ErrUnsupportedMonitorType)
+	}
+
+	result.Duration = time.Since(result.CheckedAt)
+	return result
+}
+
+func (c *Checker) checkHTTP(ctx context.Context, monitor client.Monitor, result *Result) {
+	var method = strings.ToUpper(monitor.HTTPMethod)
+	if funk.IsEmpty(method) {
+		method = http.MethodGet
+	}
+
+	var body io.Reader
+	if funk.NotEmpty(monitor.RequestBody) && method != http.MethodGet && method != http.MethodHead {
+		body = strings.NewReader(monitor.RequestBody)
+	}
+
+	var request, reqErr = http.NewRequestWithContext(ctx, method, monitor.URL, body)
+	if reqErr != nil {
+		result.Err = fmt.Errorf("failed to create request: %v", reqErr)
+		return
+	}
+
+	for _, header := range monitor.RequestHeaders {
+		request.Header.Add(header.Name, header.Value)
+	}
+	if funk.NotEmpty(monitor.AuthUsername) {
+		request.SetBasicAuth(monitor.AuthUsername, monitor.AuthPassword)
+	}
+
+	var httpClient = &http.Client{
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: c.InsecureSkipVerify},
+		},
+	}
+	if !monitor.FollowRedirects {
+		httpClient.CheckRedirect = func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	}
+	if monitor.RememberCookies {
+		// cookiejar.New only fails on invalid options, none are passed
+		httpClient.Jar, _ = cookiejar.New(nil)
+	}
+
+	var response, respErr = httpClient.Do(request)
+	if respErr != nil {
+		result.Reason = fmt.Sprintf("request failed: %v", respErr)
+		return
+	}
+	defer response.Body.Close()
+
+	result.StatusCode = response.StatusCode
+
+	switch monitor.MonitorType {
+	case client.MonitorTypeStatus:
+		result.Up = response.StatusCode >= 200 && response.StatusCode < 300
+		if !result.Up {
+			result.Reason = fmt.Sprintf("expected a 2XX status code, got %d", response.StatusCode)
+		}
+	case client.MonitorTypeStatusCode, client.MonitorTypeExpectedStatusCode:
+		result.Up = funk.ContainsInt(monitor.ExpectedStatusCodes, response.StatusCode)
+		if !result.Up {
+			result.Reason = fmt.Sprintf("status code %d is not one of %v", response.StatusCode,
+				monitor.ExpectedStatusCodes)
+		}
+	case client.MonitorTypeKeyword, client.MonitorTypeKeywordAbsence:
+		var content, readErr = io.ReadAll(io.LimitReader(response.Body, MaxBodySize))
+		if readErr != nil {
+			result.Reason = fmt.Sprintf("failed to read response body: %v", readErr)
+			return
+		}
+		var found = strings.Contains(string(content), monitor.RequiredKeyword)
+		if monitor.MonitorType == client.MonitorTypeKeyword {
+			result.Up = found
+			if !found {
+				result.Reason = fmt.Sprintf("keyword %q not found", monitor.RequiredKeyword)
+			}
+		} else {
+			result.Up = !found
+			if found {
+				result.Reason = fmt.Sprintf("keyword %q found", monitor.RequiredKeyword)
+			}
+		}
+	}
+}
+
+func (c *Checker) checkTCP(ctx context.Context, monitor client.Monitor, result *Result) {
+	if monitor.Port == 0 {
+		result.Err = errors.New("tcp monitor requires a port")
+		return
+	}
+
+	var address = net.JoinHostPort(hostOf(monitor.URL), strconv.Itoa(monitor.Port))
+	var dialer net.Dialer
+	var conn, dialErr = dialer.DialContext(ctx, "tcp", address)
+	if dialErr != nil {
+		result.Reason = fmt.Sprintf("failed to connect to %s: %v", address, dialErr)
+		return
+	}
+	_ = conn.Close()
+
+	result.Up = true
+}
+
+func (c *Checker) checkPing(ctx context.Context, monitor client.Monitor, timeout time.Duration, result *Result) {
+	var seconds = int(timeout.Seconds())
+	if seconds < 1 {
+		seconds = 1
+	}
+
+	var host = hostOf(monitor.URL)
+	var output, runErr = exec.CommandContext(ctx, c.PingCommand, "-c", "1", "-W", strconv.Itoa(seconds), host).
+		CombinedOutput()
+	if runErr != nil {
+		var exitErr *exec.ExitError
+		if errors.As(runErr, &exitErr) {
+			result.Reason = fmt.Sprintf("ping %s failed: %s", host, strings.TrimSpace(string(output)))
+			return
+		}
+		result.Err = fmt.Errorf("failed to run %s: %v", c.PingCommand, runErr)
+		return
+	}
+
+	result.Up = true
+}
+
+func (c *Checker) checkDNS(ctx context.Context, monitor client.Monitor, result *Result) {
+	var domain = strings.TrimSpace(monitor.RequestBody)
+	if funk.IsEmpty(domain) {
+		result.Err = errors.New("dns monitor requires the domain to query in the request body")
+		return
+	}
+
+	var port = "53"
+	if monitor.Port > 0 {
+		port = strconv.Itoa(monitor.Port)
+	}
+	var server = net.JoinHostPort(hostOf(monitor.URL), port)
+
+	var resolver = &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, network, server)
+		},
+	}
+
+	var addresses, lookupErr = resolver.LookupHost(ctx, domain)
+	if lookupErr != nil {
+		result.Reason = fmt.Sprintf("failed to resolve %s via %s: %v", domain, server, lookupErr)
+		return
+	}
+	if len(addresses) == 0 {
+		result.Reason = fmt.Sprintf("%s returned no records for %s", server, domain)
+		return
+	}
+
+	result.Up = true
+}
+
+// hostOf extracts the host from a monitor URL, which for ping, tcp or dns monitors is usually a bare host name
+func hostOf(rawURL string) string {
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "//" + rawURL
+	}
+	var parsedURL, parseErr = url.Parse(rawURL)
+	if parseErr != nil || funk.IsEmpty(parsedURL.Hostname()) {
+		return rawURL
+	}
+	return parsedURL.Hostname()
+}
@@ -0,0 +1,105 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+)
+
+const MonitorStatusUp = "up"
+const MonitorStatusDown = "down"
+
+// Regions which have not reported a successful check for this many check intervals are considered failing
+const staleIntervals = 3
+
+// A region responding this many times slower than the local probe is reported as a discrepancy
+const slowFactor = 3
+
+type RegionResult struct {
+	Region       string
+	LastSuccess  time.Time
+	ResponseTime time.Duration
+	Stale        bool
+}
+
+type Comparison struct {
+	Monitor client.Monitor
+	Local   Result
+
+	// Status reported by Better Stack, e.g. up, down, paused, maintenance
+	RemoteStatus string
+	Regions      []RegionResult
+
+	// Human-readable differences between the local probe and Better Stack
+	Discrepancies []string
+}
+
+// Compare runs the monitor locally and lines the result up with what Better Stack currently reports for it: the
+// monitor status and the latest response time of every region.
+func (c *Checker) Compare(ctx context.Context, api *client.BetterstackClient, monitorID string) (Comparison, error) {
+	var result Comparison
+
+	var monitorResponse, monErr = api.Monitors().Get(ctx, monitorID)
+	if monErr != nil {
+		return result, fmt.Errorf("failed to get monitor: %v", monErr)
+	}
+	result.Monitor = monitorResponse.Data.Attributes
+	result.RemoteStatus = result.Monitor.Status
+
+	var timesResponse, timesErr = api.Monitors().ResponseTimes(ctx, monitorID)
+	if timesErr != nil {
+		return result, fmt.Errorf("failed to get response times: %v", timesErr)
+	}
+
+	result.Local = c.Check(ctx, result.Monitor)
+	if result.Local.Err != nil {
+		return result, fmt.Errorf("failed to run local check: %v", result.Local.Err)
+	}
+
+	var frequency = time.Duration(result.Monitor.CheckFrequency) * time.Second
+	if frequency <= 0 {
+		frequency = 3 * time.Minute
+	}
+
+	for _, region := range timesResponse.Data.Attributes.Regions {
+		var regionResult = RegionResult{Region: region.Region, Stale: true}
+		if latest, found := region.Latest(); found {
+			regionResult.LastSuccess = latest.At
+			regionResult.ResponseTime = time.Duration(latest.ResponseTime * float64(time.Second))
+			regionResult.Stale = result.Local.CheckedAt.Sub(latest.At) > staleIntervals*frequency
+		}
+		result.Regions = append(result.Regions, regionResult)
+	}
+	sort.Slice(result.Regions, func(i, j int) bool {
+		return result.Regions[i].Region < result.Regions[j].Region
+	})
+
+	switch {
+	case result.Local.Up && result.RemoteStatus == MonitorStatusDown:
+		result.Discrepancies = append(result.Discrepancies, "check passes locally but Better Stack reports the monitor down")
+	case !result.Local.Up && result.RemoteStatus == MonitorStatusUp:
+		result.Discrepancies = append(result.Discrepancies,
+			fmt.Sprintf("check fails locally (%s) but Better Stack reports the monitor up", result.Local.Reason))
+	}
+
+	for _, region := range result.Regions {
+		if region.Stale {
+			if result.Local.Up {
+				result.Discrepancies = append(result.Discrepancies,
+					fmt.Sprintf("check passes locally but region %s has no recent successful check", region.Region))
+			}
+			continue
+		}
+		if result.Local.Up && result.Local.Duration > 0 && region.ResponseTime > slowFactor*result.Local.Duration {
+			result.Discrepancies = append(result.Discrepancies,
+				fmt.Sprintf("region %s responds in %s, %d times slower than the local %s",
+					region.Region, region.ResponseTime, int(region.ResponseTime/result.Local.Duration),
+					result.Local.Duration))
+		}
+	}
+
+	return result, nil
+}
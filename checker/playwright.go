@@ -0,0 +1,134 @@
+package checker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+var ErrPlaywrightUnavailable = errors.New("playwright runner is not available")
+
+type playwrightReport struct {
+	Stats struct {
+		Expected   int     `json:"expected"`
+		Unexpected int     `json:"unexpected"`
+		Flaky      int     `json:"flaky"`
+		Skipped    int     `json:"skipped"`
+		Duration   float64 `json:"duration"`
+	} `json:"stats"`
+	Suites []playwrightSuite `json:"suites"`
+	Errors []playwrightError `json:"errors"`
+}
+
+type playwrightSuite struct {
+	Suites []playwrightSuite `json:"suites"`
+	Specs  []struct {
+		Title string `json:"title"`
+		Tests []struct {
+			Results []struct {
+				Status string            `json:"status"`
+				Errors []playwrightError `json:"errors"`
+			} `json:"results"`
+		} `json:"tests"`
+	} `json:"specs"`
+}
+
+type playwrightError struct {
+	Message string `json:"message"`
+}
+
+// checkPlaywright stores the scenario as a spec file and runs it with the Playwright test runner. The runner is
+// started in PlaywrightDir, which has to be a node project with @playwright/test installed.
+func (c *Checker) checkPlaywright(ctx context.Context, monitor client.Monitor, result *Result) {
+	if funk.IsEmpty(monitor.PlaywrightScript) {
+		result.Err = errors.New("playwright monitor requires playwright_script")
+		return
+	}
+
+	var npx, lookErr = exec.LookPath(c.NpxCommand)
+	if lookErr != nil {
+		result.Err = fmt.Errorf("%w: %v", ErrPlaywrightUnavailable, lookErr)
+		return
+	}
+
+	var workDir = c.PlaywrightDir
+	if funk.IsEmpty(workDir) {
+		workDir = os.TempDir()
+	}
+
+	var specDir, dirErr = os.MkdirTemp(workDir, "betterstack-scenario-")
+	if dirErr != nil {
+		result.Err = fmt.Errorf("failed to create scenario directory: %v", dirErr)
+		return
+	}
+	defer os.RemoveAll(specDir)
+
+	var specFile = filepath.Join(specDir, "scenario.spec.js")
+	if writeErr := os.WriteFile(specFile, []byte(monitor.PlaywrightScript), 0o600); writeErr != nil {
+		result.Err = fmt.Errorf("failed to write scenario: %v", writeErr)
+		return
+	}
+
+	var relativeSpec, _ = filepath.Rel(workDir, specFile)
+	var command = exec.CommandContext(ctx, npx, "playwright", "test", "--reporter=json", relativeSpec)
+	command.Dir = workDir
+
+	var stdout, stderr bytes.Buffer
+	command.Stdout = &stdout
+	command.Stderr = &stderr
+
+	// The runner exits non-zero when the scenario fails, the JSON report tells the two apart from runner errors
+	var runErr = command.Run()
+
+	var report playwrightReport
+	if unmErr := json.Unmarshal(stdout.Bytes(), &report); unmErr != nil {
+		if runErr != nil {
+			result.Err = fmt.Errorf("failed to run playwright: %v: %s", runErr, stderr.String())
+		} else {
+			result.Err = fmt.Errorf("failed to parse playwright report: %v", unmErr)
+		}
+		return
+	}
+
+	result.ScenarioDuration = time.Duration(report.Stats.Duration * float64(time.Millisecond))
+
+	if len(report.Errors) > 0 {
+		result.Reason = report.Errors[0].Message
+		return
+	}
+
+	result.Up = report.Stats.Unexpected == 0 && report.Stats.Expected+report.Stats.Flaky > 0
+	if !result.Up {
+		result.Reason = firstFailure(report.Suites)
+		if funk.IsEmpty(result.Reason) {
+			result.Reason = "scenario did not pass"
+		}
+	}
+}
+
+func firstFailure(suites []playwrightSuite) string {
+	for _, suite := range suites {
+		for _, spec := range suite.Specs {
+			for _, test := range spec.Tests {
+				for _, run := range test.Results {
+					if run.Status != "passed" && run.Status != "skipped" && len(run.Errors) > 0 {
+						return fmt.Sprintf("%s: %s", spec.Title, run.Errors[0].Message)
+					}
+				}
+			}
+		}
+		if reason := firstFailure(suite.Suites); funk.NotEmpty(reason) {
+			return reason
+		}
+	}
+	return client.Blanc
+}
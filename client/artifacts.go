@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/thoas/go-funk"
+)
+
+// Kinds of artifacts the API links from incidents
+const ArtifactScreenshot = "screenshot"
+const ArtifactResponse = "response"
+
+// MaxArtifactSize caps downloads, screenshots of full pages are a few megabytes
+const MaxArtifactSize = 32 << 20
+
+var ErrArtifactTooLarge = errors.New("artifact exceeds the size limit")
+
+// Artifact references evidence Better Stack stored when a check failed. The API exposes the screenshot and the
+// saved response of an incident; Playwright run logs and traces are only shown in the dashboard.
+type Artifact struct {
+	Kind       string
+	IncidentID string
+	URL        string
+
+	// Start of the incident, nil when the API omitted it
+	At *time.Time
+}
+
+type ArtifactContent struct {
+	Artifact
+	ContentType string
+	Data        []byte
+}
+
+// Artifacts returns the artifacts of an incident, empty when the check stored none
+func (s IncidentsService) Artifacts(ctx context.Context, incidentID string) ([]Artifact, error) {
+	var incident, incidentErr = s.Get(ctx, incidentID)
+	if incidentErr != nil {
+		return nil, fmt.Errorf("failed to get incident: %w", incidentErr)
+	}
+	return artifacts(incident.Data.Attributes), nil
+}
+
+// Artifacts returns the artifacts of all incidents of a monitor in the time range, e.g. the screenshots of
+// the failed runs of a Playwright monitor. Zero from and to leave the range open.
+func (s MonitorsService) Artifacts(ctx context.Context, monitorID string, from, to time.Time) ([]Artifact, error) {
+	var c = s.client
+	var incidents, incidentsErr = c.Incidents().ListForMonitor(ctx, monitorID, from, to)
+	if incidentsErr != nil {
+		return nil, fmt.Errorf("failed to list incidents: %w", incidentsErr)
+	}
+	var result []Artifact
+	for _, incident := range incidents {
+		result = append(result, artifacts(incident)...)
+	}
+	return result, nil
+}
+
+func artifacts(incident Incident) []Artifact {
+	var result []Artifact
+	if funk.NotEmpty(incident.ScreenshotURL) {
+		result = append(result, Artifact{Kind: ArtifactScreenshot, IncidentID: incident.ID, URL: incident.ScreenshotURL, At: incident.StartedAt})
+	}
+	if funk.NotEmpty(incident.ResponseURL) {
+		result = append(result, Artifact{Kind: ArtifactResponse, IncidentID: incident.ID, URL: incident.ResponseURL, At: incident.StartedAt})
+	}
+	return result
+}
+
+// DownloadArtifact fetches the bytes of an artifact, e.g. to attach them to a ticket. The API token is only sent
+// to Better Stack hosts over https and to the base URL, artifacts on storage links are fetched without it. A
+// Better Stack URL over plain http is rejected rather than fetched with or without the token.
+func (c *BetterstackClient) DownloadArtifact(ctx context.Context, artifact Artifact) (ArtifactContent, error) {
+	var result = ArtifactContent{Artifact: artifact}
+
+	var target, parseErr = url.Parse(artifact.URL)
+	if parseErr != nil || (target.Scheme != "https" && target.Scheme != "http") {
+		return result, fmt.Errorf("invalid artifact URL: %q", artifact.URL)
+	}
+
+	var request, requestErr = http.NewRequestWithContext(ctx, http.MethodGet, artifact.URL, nil)
+	if requestErr != nil {
+		return result, fmt.Errorf("failed to create request: %v", requestErr)
+	}
+
+	var authorized = c.baseHost(target) || (betterstackHost(target.Hostname()) && target.Scheme == "https")
+	if !authorized && betterstackHost(target.Hostname()) {
+		return result, fmt.Errorf("refusing to download artifact over %s: %q", target.Scheme, artifact.URL)
+	}
+
+	var response *http.Response
+	var responseErr error
+	if authorized {
+		response, responseErr = c.send(request, c.headers.clone())
+	} else {
+		response, responseErr = c.httpClient.Do(request)
+	}
+	if responseErr != nil {
+		return result, fmt.Errorf("failed to execute request: %w", responseErr)
+	}
+	defer discard(response)
+
+	if apiErr := checkResponse(response, http.StatusOK); apiErr != nil {
+		return result, fmt.Errorf("failed to download %s of incident %s: %w", artifact.Kind, artifact.IncidentID, apiErr)
+	}
+
+	var data, readErr = io.ReadAll(io.LimitReader(response.Body, MaxArtifactSize+1))
+	if readErr != nil {
+		return result, fmt.Errorf("failed to read artifact: %v", readErr)
+	}
+	if len(data) > MaxArtifactSize {
+		return result, ErrArtifactTooLarge
+	}
+
+	result.ContentType = response.Header.Get(ContentType)
+	if funk.IsEmpty(result.ContentType) {
+		result.ContentType = http.DetectContentType(data)
+	}
+	result.Data = data
+	return result, nil
+}
+
+// baseHost returns true when the URL points at the host of a base URL set on the client
+func (c *BetterstackClient) baseHost(target *url.URL) bool {
+	var base, parseErr = url.Parse(c.BaseURL())
+	return parseErr == nil && c.BaseURL() != BaseURL && strings.EqualFold(base.Host, target.Host) &&
+		strings.EqualFold(base.Scheme, target.Scheme)
+}
+
+func betterstackHost(host string) bool {
+	return host == "betterstack.com" || strings.HasSuffix(host, ".betterstack.com")
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDownloadArtifactSendsTokenOnlyToTrustedHosts(t *testing.T) {
+	var authorizations = map[string]string{}
+	var handler = func(name string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			authorizations[name] = r.Header.Get("Authorization")
+			_, _ = w.Write([]byte("artifact"))
+		}
+	}
+	var api = httptest.NewServer(handler("api"))
+	defer api.Close()
+	var storage = httptest.NewServer(handler("storage"))
+	defer storage.Close()
+	var c = NewClient("token", WithBaseURL(api.URL))
+
+	for name, url := range map[string]string{"api": api.URL + "/screenshot.png", "storage": storage.URL + "/screenshot.png"} {
+		if _, downloadErr := c.DownloadArtifact(context.Background(), Artifact{URL: url}); downloadErr != nil {
+			t.Fatalf("%s: %v", name, downloadErr)
+		}
+	}
+	if authorizations["api"] != "Bearer token" || authorizations["storage"] != Blanc {
+		t.Errorf("unexpected authorizations: %v", authorizations)
+	}
+
+	for _, url := range []string{"http://uptime.betterstack.com/screenshot.png", "ftp://uptime.betterstack.com/screenshot.png"} {
+		if _, downloadErr := c.DownloadArtifact(context.Background(), Artifact{URL: url}); downloadErr == nil {
+			t.Errorf("%s: expected the download refused", url)
+		}
+	}
+}
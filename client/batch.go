@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Up to this many monitors are fetched one by one, more are picked from a listing of the account, which takes one
+// request per 250 monitors
+const batchListThreshold = 50
+
+// Parallel GETs of GetMonitors, low enough to stay within the API rate limit
+const batchConcurrency = 4
+
+var ErrMonitorNotFound = errors.New("monitor not found")
+
+// GetMany fetches many monitors at once. Few IDs are fetched with parallel GETs, many are picked from a single
+// listing. Duplicate IDs are fetched once. IDs which could not be fetched are keyed in the error map, unknown IDs
+// with ErrMonitorNotFound when the listing was used.
+func (s MonitorsService) GetMany(ctx context.Context, ids []string) (map[string]Monitor, map[string]error) {
+	var result = map[string]Monitor{}
+	var errs = map[string]error{}
+
+	var wanted = map[string]bool{}
+	var unique []string
+	for _, id := range ids {
+		if !wanted[id] {
+			wanted[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	if len(unique) > batchListThreshold {
+		var monitors, listErr = s.List(ctx, func(monitor Monitor) bool { return wanted[monitor.ID] })
+		for _, monitor := range monitors {
+			result[monitor.ID] = monitor
+		}
+		for _, id := range unique {
+			if _, found := result[id]; found {
+				continue
+			}
+			if listErr != nil {
+				errs[id] = listErr
+			} else {
+				errs[id] = ErrMonitorNotFound
+			}
+		}
+		return result, errs
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var jobs = make(chan string)
+	for i := 0; i < batchConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range jobs {
+				var monitor, getErr = s.Get(ctx, id)
+				mu.Lock()
+				if getErr != nil {
+					errs[id] = getErr
+				} else {
+					result[id] = monitor.Data.Attributes
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, id := range unique {
+		jobs <- id
+	}
+	close(jobs)
+	wg.Wait()
+
+	return result, errs
+}
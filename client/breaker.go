@@ -0,0 +1,138 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const DefaultBreakerThreshold = 5
+const DefaultBreakerCooldown = 30 * time.Second
+
+const BreakerClosed = "closed"
+const BreakerOpen = "open"
+const BreakerHalfOpen = "half-open"
+
+var ErrCircuitOpen = errors.New("circuit breaker is open, the API failed repeatedly")
+
+// CircuitBreaker stops sending requests after Threshold consecutive failures, i.e. network errors and 5xx
+// responses; cancelled requests, 4xx and rate limits don't count. While open every request fails with
+// ErrCircuitOpen. After Cooldown a single probe request is let through (half-open): its success closes the
+// breaker, its failure opens it for another Cooldown. A breaker may be shared by several clients of the same
+// account.
+type CircuitBreaker struct {
+	Threshold int
+	Cooldown  time.Duration
+
+	mu       sync.Mutex
+	state    string
+	failures int
+	openedAt time.Time
+}
+
+// NewCircuitBreaker returns a closed breaker, zero values take DefaultBreakerThreshold and DefaultBreakerCooldown
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	if threshold <= 0 {
+		threshold = DefaultBreakerThreshold
+	}
+	if cooldown <= 0 {
+		cooldown = DefaultBreakerCooldown
+	}
+	return &CircuitBreaker{Threshold: threshold, Cooldown: cooldown, state: BreakerClosed}
+}
+
+// WithCircuitBreaker makes the client fail fast with ErrCircuitOpen while the breaker is open
+func WithCircuitBreaker(breaker *CircuitBreaker) Option {
+	return func(c *BetterstackClient) {
+		c.breaker = breaker
+	}
+}
+
+// State returns BreakerClosed, BreakerOpen or BreakerHalfOpen. An open breaker whose cooldown passed reports
+// half-open, the next request probes the API.
+func (b *CircuitBreaker) State() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var _, cooldown = b.limits()
+	if b.state == BreakerOpen && time.Since(b.openedAt) >= cooldown {
+		return BreakerHalfOpen
+	}
+	return b.current()
+}
+
+func (b *CircuitBreaker) current() string {
+	if b.state == Blanc {
+		return BreakerClosed
+	}
+	return b.state
+}
+
+func (b *CircuitBreaker) limits() (int, time.Duration) {
+	var threshold, cooldown = b.Threshold, b.Cooldown
+	if threshold <= 0 {
+		threshold = DefaultBreakerThreshold
+	}
+	if cooldown <= 0 {
+		cooldown = DefaultBreakerCooldown
+	}
+	return threshold, cooldown
+}
+
+// allow returns ErrCircuitOpen unless the request may be sent. Of the requests after the cooldown only the first
+// is let through as probe.
+func (b *CircuitBreaker) allow(logger Logger) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var _, cooldown = b.limits()
+	switch b.current() {
+	case BreakerOpen:
+		if remaining := cooldown - time.Since(b.openedAt); remaining > 0 {
+			return fmt.Errorf("%w, retrying in %v", ErrCircuitOpen, remaining.Round(time.Second))
+		}
+		b.state = BreakerHalfOpen
+		logger.Info("circuit breaker half-open, probing the API")
+	case BreakerHalfOpen:
+		return fmt.Errorf("%w, probing the API", ErrCircuitOpen)
+	}
+	return nil
+}
+
+// record counts the outcome of a request let through by allow
+func (b *CircuitBreaker) record(request *http.Request, response *http.Response, respErr error, logger Logger) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var threshold, cooldown = b.limits()
+
+	switch {
+	case respErr != nil && (request.Context().Err() != nil || errors.Is(respErr, ErrRateLimited)):
+		// Says nothing about the health of the API. An interrupted probe leaves probing to the next request.
+		if b.current() == BreakerHalfOpen {
+			b.state = BreakerOpen
+		}
+		return
+	case respErr == nil && response.StatusCode < http.StatusInternalServerError:
+		if b.current() != BreakerClosed {
+			logger.Info("circuit breaker closed, the API recovered")
+		}
+		b.state, b.failures = BreakerClosed, 0
+		return
+	}
+
+	b.failures++
+	switch {
+	case b.current() == BreakerHalfOpen:
+		logger.Warn("circuit breaker probe failed, failing fast", "cooldown", cooldown)
+	case b.failures >= threshold:
+		logger.Warn("circuit breaker open, failing fast", "failures", b.failures, "cooldown", cooldown)
+	default:
+		return
+	}
+	b.state, b.openedAt = BreakerOpen, time.Now()
+}
+
+// CircuitOpen returns true while the circuit breaker of the client fails requests fast, false without a breaker
+func (c *BetterstackClient) CircuitOpen() bool {
+	return c.breaker != nil && c.breaker.State() == BreakerOpen
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreakerOpensAndRecovers(t *testing.T) {
+	var server = newScriptedServer(t, scriptedResponse{status: http.StatusInternalServerError},
+		scriptedResponse{status: http.StatusInternalServerError}, okResponse)
+	var breaker = NewCircuitBreaker(2, 50*time.Millisecond)
+	var c = NewClient("token", WithBaseURL(server.URL), WithCircuitBreaker(breaker))
+	var get = func() error {
+		var _, getErr = c.Monitors().Get(context.Background(), "1")
+		return getErr
+	}
+
+	_, _ = get(), get()
+	if breaker.State() != BreakerOpen {
+		t.Fatalf("expected the breaker open after 2 failures, got %s", breaker.State())
+	}
+	if openErr := get(); !errors.Is(openErr, ErrCircuitOpen) || server.requests.Load() != 2 {
+		t.Fatalf("expected a fast failure without request, got %d requests: %v", server.requests.Load(), openErr)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	if breaker.State() != BreakerHalfOpen {
+		t.Fatalf("expected the breaker half-open after the cooldown, got %s", breaker.State())
+	}
+	if probeErr := get(); probeErr != nil {
+		t.Fatalf("probe failed: %v", probeErr)
+	}
+	if breaker.State() != BreakerClosed {
+		t.Errorf("expected the breaker closed after a successful probe, got %s", breaker.State())
+	}
+}
+
+func TestCircuitBreakerIgnoresClientErrors(t *testing.T) {
+	var server = newScriptedServer(t, scriptedResponse{status: http.StatusNotFound})
+	var breaker = NewCircuitBreaker(1, time.Minute)
+	var c = NewClient("token", WithBaseURL(server.URL), WithCircuitBreaker(breaker))
+
+	for range 3 {
+		_, _ = c.Monitors().Get(context.Background(), "1")
+	}
+	if breaker.State() != BreakerClosed || server.requests.Load() != 3 {
+		t.Errorf("404s opened the breaker: %s after %d requests", breaker.State(), server.requests.Load())
+	}
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var ErrBudgetExhausted = errors.New("retry budget exhausted")
+
+// Budget bounds a bulk operation as a whole: MaxRetries failures may be retried or skipped across all its items,
+// and Timeout caps its total duration. A systemic API problem then ends the operation early with a summary instead
+// of retrying item after item. One budget can be shared by the steps of a sync and by concurrent workers. A nil
+// budget is unlimited.
+type Budget struct {
+	MaxRetries int
+
+	// Overall duration, zero leaves it to the context
+	Timeout time.Duration
+
+	mu      sync.Mutex
+	started time.Time
+	retries int
+	lastErr error
+}
+
+func NewBudget(maxRetries int, timeout time.Duration) *Budget {
+	return &Budget{MaxRetries: maxRetries, Timeout: timeout}
+}
+
+// Context derives the context of the operation carrying the deadline of the budget. The deadline is fixed by the
+// first call, later steps sharing the budget get the same one.
+func (b *Budget) Context(ctx context.Context) (context.Context, context.CancelFunc) {
+	if b == nil {
+		return context.WithCancel(ctx)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.started.IsZero() {
+		b.started = time.Now()
+	}
+	if b.Timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithDeadline(ctx, b.started.Add(b.Timeout))
+}
+
+// Spend takes one retry for err. It fails with ErrBudgetExhausted once more than MaxRetries were taken, the
+// operation should stop then.
+func (b *Budget) Spend(err error) error {
+	if b == nil {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.started.IsZero() {
+		b.started = time.Now()
+	}
+	b.retries++
+	b.lastErr = err
+	if b.retries > b.MaxRetries {
+		return fmt.Errorf("%w after %d failures in %v, last: %v", ErrBudgetExhausted, b.retries,
+			time.Since(b.started).Round(time.Second), err)
+	}
+	return nil
+}
+
+// Retries taken so far
+func (b *Budget) Retries() int {
+	if b == nil {
+		return 0
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.retries
+}
+
+// Explain wraps the error an operation stopped with, adding the budget state when the deadline was the cause
+func (b *Budget) Explain(err error) error {
+	if b == nil || !errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.lastErr != nil {
+		return fmt.Errorf("deadline of %v exceeded after %d failures, last: %v: %w", b.Timeout, b.retries, b.lastErr, err)
+	}
+	return fmt.Errorf("deadline of %v exceeded: %w", b.Timeout, err)
+}
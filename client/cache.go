@@ -0,0 +1,204 @@
+package client
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	json "github.com/json-iterator/go"
+)
+
+// CacheEntry is a cached GET response body
+type CacheEntry struct {
+	ETag     string    `json:"etag,omitempty"`
+	StoredAt time.Time `json:"stored_at"`
+	Body     []byte    `json:"body"`
+}
+
+// CacheStore keeps cached responses. Keys are derived from the URL and the API token, so entries of different
+// accounts never mix.
+type CacheStore interface {
+	Get(key string) (CacheEntry, bool)
+	Set(key string, entry CacheEntry)
+	Clear()
+}
+
+type cacheState struct {
+	store CacheStore
+	ttl   time.Duration
+}
+
+// WithCache caches GET responses in the store. Entries younger than ttl are served without a request, older ones
+// are revalidated with If-None-Match when the API sent an ETag. Any successful mutating request clears the store,
+// as the client can't tell which cached responses it changed. A FileCache keeps the entries between runs, so
+// repeated short-lived invocations skip most requests.
+func WithCache(store CacheStore, ttl time.Duration) Option {
+	return func(c *BetterstackClient) {
+		c.cache = cacheState{store: store, ttl: ttl}
+	}
+}
+
+type MemoryCache struct {
+	mu      sync.RWMutex
+	entries map[string]CacheEntry
+}
+
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: map[string]CacheEntry{}}
+}
+
+func (m *MemoryCache) Get(key string) (CacheEntry, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var entry, found = m.entries[key]
+	return entry, found
+}
+
+func (m *MemoryCache) Set(key string, entry CacheEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[key] = entry
+}
+
+func (m *MemoryCache) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = map[string]CacheEntry{}
+}
+
+// FileCache keeps one JSON file per entry in a directory. Files are readable by the owner only, they hold API data.
+// Unreadable entries count as missing.
+type FileCache struct {
+	dir string
+
+	// Logger receives write failures, logrus when nil
+	Logger Logger
+}
+
+const cacheFileSuffix = ".json"
+
+func NewFileCache(dir string) (*FileCache, error) {
+	if mkdirErr := os.MkdirAll(dir, 0o700); mkdirErr != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %v", mkdirErr)
+	}
+	return &FileCache{dir: dir}, nil
+}
+
+func (f *FileCache) path(key string) string {
+	return filepath.Join(f.dir, key+cacheFileSuffix)
+}
+
+func (f *FileCache) Get(key string) (CacheEntry, bool) {
+	var entry CacheEntry
+	var data, readErr = os.ReadFile(f.path(key))
+	if readErr != nil {
+		return entry, false
+	}
+	if unmErr := json.Unmarshal(data, &entry); unmErr != nil {
+		return entry, false
+	}
+	return entry, true
+}
+
+// Set writes the entry through a temporary file, so concurrent runs never read half an entry
+func (f *FileCache) Set(key string, entry CacheEntry) {
+	var data, serErr = json.Marshal(entry)
+	if serErr != nil {
+		return
+	}
+	var temp, tempErr = os.CreateTemp(f.dir, key+".*.tmp")
+	if tempErr != nil {
+		LoggerOrDefault(f.Logger).Warn("failed to write cache entry", "key", key, "error", tempErr)
+		return
+	}
+	var _, writeErr = temp.Write(data)
+	var closeErr = temp.Close()
+	if writeErr != nil || closeErr != nil {
+		_ = os.Remove(temp.Name())
+		LoggerOrDefault(f.Logger).Warn("failed to write cache entry", "key", key, "error", writeErr)
+		return
+	}
+	if renameErr := os.Rename(temp.Name(), f.path(key)); renameErr != nil {
+		_ = os.Remove(temp.Name())
+		LoggerOrDefault(f.Logger).Warn("failed to write cache entry", "key", key, "error", renameErr)
+	}
+}
+
+func (f *FileCache) Clear() {
+	var entries, readErr = os.ReadDir(f.dir)
+	if readErr != nil {
+		return
+	}
+	for _, entry := range entries {
+		if strings.HasSuffix(entry.Name(), cacheFileSuffix) {
+			_ = os.Remove(filepath.Join(f.dir, entry.Name()))
+		}
+	}
+}
+
+func cacheKey(request *http.Request, authorization string) string {
+	var sum = sha256.Sum256([]byte(authorization + " " + request.URL.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// doCached serves a GET from the cache, revalidating or fetching it when needed
+func (c *BetterstackClient) doCached(request *http.Request) (*http.Response, error) {
+	var key = cacheKey(request, c.headers.get("Authorization"))
+	var entry, cached = c.cache.store.Get(key)
+
+	if cached && time.Since(entry.StoredAt) < c.cache.ttl {
+		return cachedResponse(request, entry.Body), nil
+	}
+
+	var headers = c.headers.clone()
+	if cached && entry.ETag != Blanc {
+		headers.Set("If-None-Match", entry.ETag)
+	}
+
+	var response, respErr = c.send(request, headers)
+	if respErr != nil {
+		return response, respErr
+	}
+
+	if cached && response.StatusCode == http.StatusNotModified {
+		_ = response.Body.Close()
+		entry.StoredAt = time.Now()
+		c.cache.store.Set(key, entry)
+		return cachedResponse(request, entry.Body), nil
+	}
+	if response.StatusCode != http.StatusOK {
+		return response, nil
+	}
+
+	var body, readErr = io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if readErr != nil {
+		return response, fmt.Errorf("failed to read response: %v", readErr)
+	}
+	c.cache.store.Set(key, CacheEntry{ETag: response.Header.Get("ETag"), StoredAt: time.Now(), Body: body})
+
+	response.Body = io.NopCloser(bytes.NewReader(body))
+	return response, nil
+}
+
+func cachedResponse(request *http.Request, body []byte) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{ContentType: []string{ApplicationJSON}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCacheServesFreshEntries(t *testing.T) {
+	var server = newScriptedServer(t, okResponse)
+	var c = NewClient("token", WithBaseURL(server.URL), WithCache(NewMemoryCache(), time.Minute))
+
+	for range 3 {
+		var monitor, getErr = c.Monitors().Get(context.Background(), "1")
+		if getErr != nil || monitor.Data.Attributes.PronounceableName != "shop" {
+			t.Fatalf("unexpected response %+v: %v", monitor, getErr)
+		}
+	}
+	if server.requests.Load() != 1 {
+		t.Errorf("expected 1 request, got %d", server.requests.Load())
+	}
+}
+
+func TestCacheRevalidatesWithETag(t *testing.T) {
+	var revalidated atomic.Int32
+	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			revalidated.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(monitorBody))
+	}))
+	defer server.Close()
+	var c = NewClient("token", WithBaseURL(server.URL), WithCache(NewMemoryCache(), 0))
+
+	for range 2 {
+		var monitor, getErr = c.Monitors().Get(context.Background(), "1")
+		if getErr != nil || monitor.Data.Attributes.PronounceableName != "shop" {
+			t.Fatalf("unexpected response %+v: %v", monitor, getErr)
+		}
+	}
+	if revalidated.Load() != 1 {
+		t.Errorf("expected the second request revalidated, got %d", revalidated.Load())
+	}
+}
+
+func TestCacheIsClearedByWrites(t *testing.T) {
+	var gets atomic.Int32
+	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		gets.Add(1)
+		_, _ = w.Write([]byte(monitorBody))
+	}))
+	defer server.Close()
+	var c = NewClient("token", WithBaseURL(server.URL), WithCache(NewMemoryCache(), time.Minute))
+
+	_, _ = c.Monitors().Get(context.Background(), "1")
+	if deleteErr := c.Monitors().Delete(context.Background(), "2"); deleteErr != nil {
+		t.Fatal(deleteErr)
+	}
+	_, _ = c.Monitors().Get(context.Background(), "1")
+	if gets.Load() != 2 {
+		t.Errorf("expected the delete to clear the cache, got %d GETs", gets.Load())
+	}
+}
+
+func TestCacheKeysSeparateAccounts(t *testing.T) {
+	var server = newScriptedServer(t, okResponse)
+	var store = NewMemoryCache()
+	for _, token := range []string{"first", "second"} {
+		var c = NewClient(token, WithBaseURL(server.URL), WithCache(store, time.Minute))
+		_, _ = c.Monitors().Get(context.Background(), "1")
+	}
+	if server.requests.Load() != 2 {
+		t.Errorf("accounts shared a cache entry, %d requests", server.requests.Load())
+	}
+}
+
+func TestFileCachePersists(t *testing.T) {
+	var server = newScriptedServer(t, okResponse)
+	var dir = t.TempDir()
+	for range 2 {
+		var store, storeErr = NewFileCache(dir)
+		if storeErr != nil {
+			t.Fatal(storeErr)
+		}
+		var c = NewClient("token", WithBaseURL(server.URL), WithCache(store, time.Minute))
+		if _, getErr := c.Monitors().Get(context.Background(), "1"); getErr != nil {
+			t.Fatal(getErr)
+		}
+	}
+	if server.requests.Load() != 1 {
+		t.Errorf("expected the second client served from the file cache, got %d requests", server.requests.Load())
+	}
+}
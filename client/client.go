@@ -2,11 +2,16 @@ package client
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"github.com/thoas/go-funk"
 	"net/http"
 	"net/url"
 	"os"
+	"sync"
+	"time"
 
 	json "github.com/json-iterator/go"
 	log "github.com/sirupsen/logrus"
@@ -17,38 +22,87 @@ const Blanc = ""
 const ContentType = "Content-Type"
 const ApplicationJSON = "application/json"
 
+// BaseURL is the default root of the endpoints below, clients send to their own base URL, see SetBaseURL
 const BaseURL = "https://uptime.betterstack.com"
 const APIV2Group = BaseURL + "/api/v2"
 const Monitors = APIV2Group + "/monitors"
 const MonitorID = APIV2Group + "/monitors/%s"
+const MonitorResponseTimesID = APIV2Group + "/monitors/%s/response-times"
+const MonitorSLAID = APIV2Group + "/monitors/%s/sla"
 const MonitorGroupID = APIV2Group + "/monitor-groups/%s"
 const MonitorGroups = APIV2Group + "/monitor-groups"
-
+const Policies = APIV2Group + "/policies"
+const Incidents = APIV2Group + "/incidents"
+const OnCalls = APIV2Group + "/on-calls"
+const IncidentID = APIV2Group + "/incidents/%s"
+const IncidentAcknowledge = APIV2Group + "/incidents/%s/acknowledge"
+const IncidentResolve = APIV2Group + "/incidents/%s/resolve"
+const IncidentComments = APIV2Group + "/incidents/%s/comments"
+const Metadata = APIV2Group + "/metadata"
+const MetadataID = APIV2Group + "/metadata/%s"
+
+var ErrReadOnlyClient = errors.New("client is read-only, mutating requests are not allowed")
+var ErrConflict = errors.New("resource was modified concurrently")
+
+// ErrEmptyUpdate is returned by UpdateMonitor for a monitor without any attribute set. Omitted zero values can't
+// express false or 0, to resume a monitor use ResumeMonitor, to set other false or 0 values use Patch.
+var ErrEmptyUpdate = errors.New("update sets no attribute, zero values like paused=false are omitted")
+
+// BetterstackClient is safe for concurrent use by multiple goroutines, every request is sent with headers of its
+// own. Options configure the client in NewClient only.
 type BetterstackClient struct {
-	headers http.Header
+	headers    headerState
+	httpClient *http.Client
+	dryRun     dryRunState
+	readOnly   bool
+
+	refreshToken func() (string, error)
+	refreshMu    sync.Mutex
+
+	defaults *DefaultsProfile
+
+	deleteGuards   []DeleteGuard
+	validationTeam string
+	cache          cacheState
+	size           sizeGuard
+	base           baseURLState
+	retry          RetryPolicy
+	rateLimit      RateLimitPolicy
+	breaker        *CircuitBreaker
+	signers        []RequestSigner
+	tlsConfig      *tls.Config
+	logger         Logger
+	debug          bool
 }
 
-func NewClient(apiToken string) *BetterstackClient {
+func NewClient(apiToken string, opts ...Option) *BetterstackClient {
 	var headers = getDefaultHeaders()
 	headers.Add("Authorization", fmt.Sprintf("Bearer %s", apiToken))
-	return &BetterstackClient{
-		headers: headers,
-	}
+	var c = &BetterstackClient{
+		headers:    headerState{values: headers},
+		httpClient: http.DefaultClient,
+		size:       sizeGuard{warn: DefaultSizeWarning},
+		rateLimit:  DefaultRateLimitPolicy(),
+		logger:     logrusLogger{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.dryRun.logger = c.logger
+	c.applyTLSConfig()
+	return c
 }
 
-func NewClientFromENV() *BetterstackClient {
+func NewClientFromENV(opts ...Option) *BetterstackClient {
 	var token = os.Getenv("BETTERSTACK_TOKEN")
 	if funk.IsEmpty(token) {
 		log.Fatal("BETTERSTACK_TOKEN environment variable not set")
 	}
-	var headers = getDefaultHeaders()
-	headers.Add("Authorization", fmt.Sprintf("Bearer %s", token))
-	return &BetterstackClient{
-		headers: headers,
-	}
+	return NewClient(token, opts...)
 }
 
-func (c *BetterstackClient) ListMonitors(page int, filterType, filterValue string) (MonitorsResponse, error) {
+func (s MonitorsService) ListPage(ctx context.Context, page int, filterType, filterValue string) (MonitorsResponse, error) {
+	var c = s.client
 	var result MonitorsResponse
 
 	if page < 1 {
@@ -70,120 +124,150 @@ func (c *BetterstackClient) ListMonitors(page int, filterType, filterValue strin
 		}
 	}
 
-	var targetURL = fmt.Sprintf("%s?%s", Monitors, params.Encode())
+	var targetURL = withQuery(c.endpoint(Monitors), params)
 
-	var monitorsRequest, monsErr = http.NewRequest(http.MethodGet, targetURL, nil)
+	var monitorsRequest, monsErr = http.NewRequestWithContext(ctx, http.MethodGet, targetURL, nil)
 	if monsErr != nil {
 		return result, fmt.Errorf("failed to create request: %v", monsErr)
 	}
 
-	monitorsRequest.Header = c.headers
-
-	var monitorsResponse, monsRespErr = http.DefaultClient.Do(monitorsRequest)
+	var monitorsResponse, monsRespErr = c.execute(monitorsRequest, &result)
 	if monsRespErr != nil {
-		return result, fmt.Errorf("failed to execute request: %v", monsRespErr)
-	}
-
-	var unmErr = json.NewDecoder(monitorsResponse.Body).Decode(&result)
-	if unmErr != nil {
-		return result, fmt.Errorf("failed to unmarshal response: %v", unmErr)
+		return result, monsRespErr
 	}
 
 	if funk.NotEmpty(result.Errors) {
-		return result, fmt.Errorf("failed to list monitors: %v", result.Errors)
+		return result, fmt.Errorf("failed to list monitors: %w", newAPIError(monitorsResponse, result.Errors))
 	}
 
+	result.client = c
+
 	return result, nil
 }
 
-func (c *BetterstackClient) ListAllMonitors() ([]Monitor, error) {
+// List returns the monitors of the account matching all filters, as they are applied page by page only
+// the matches are kept in memory
+func (s MonitorsService) List(ctx context.Context, filters ...MonitorFilter) ([]Monitor, error) {
 	var result []Monitor
-	var monitorResponses MonitorsResponse
-	var monsErr error
-	var page = 1
+	var pages = s.Pages()
 
-	monitorResponses, monsErr = c.ListMonitors(page, Blanc, Blanc)
-	if monsErr != nil {
-		return result, monsErr
+	for pages.Next(ctx) {
+		for _, mon := range pages.Page().Data {
+			mon.Attributes.ID = mon.ID
+			if matchesAll(mon.Attributes, filters) {
+				result = append(result, mon.Attributes)
+			}
+		}
 	}
 
-	var lastPage, paginationErr = monitorResponses.Pagination.GetLastPage()
-	if paginationErr != nil {
-		return result, paginationErr
+	return result, pages.Err()
+}
+
+func (s MonitorsService) Pages() *PageIterator[Monitor] {
+	return newPageIterator[Monitor](s.client, withQuery(s.client.endpoint(Monitors), url.Values{"page": {"1"}, "per_page": {"250"}}), nil)
+}
+
+func (s MonitorsService) Create(ctx context.Context, monitor Monitor) (MonitorResponse, error) {
+	var c = s.client
+	var result MonitorResponse
+	if c.readOnly {
+		return result, ErrReadOnlyClient
+	}
+	monitor.CreatedAt = nil
+	monitor.UpdatedAt = nil
+	var codes, codesErr = NormalizeStatusCodes(monitor.ExpectedStatusCodes)
+	if codesErr != nil {
+		return result, codesErr
+	}
+	monitor.ExpectedStatusCodes = codes
+	if c.defaults != nil {
+		monitor = c.defaults.Apply(monitor)
+	}
+	if keywordErr := ValidateKeyword(monitor); keywordErr != nil {
+		return result, keywordErr
+	}
+	monitor = requestBodyCompat(monitor)
+	if bodyErr := ValidateRequestBody(monitor); bodyErr != nil {
+		return result, bodyErr
 	}
 
-	for _, mon := range monitorResponses.Data {
-		mon.Attributes.ID = mon.ID
-		result = append(result, mon.Attributes)
+	var serializedBody, serErr = json.Marshal(monitor)
+	if serErr != nil {
+		return result, serErr
+	}
+	if sizeErr := c.checkSize(monitor.PronounceableName, serializedBody); sizeErr != nil {
+		return result, sizeErr
 	}
 
-	if page == lastPage {
+	if c.dryRun.enabled {
+		c.dryRun.record(http.MethodPost, c.endpoint(Monitors), serializedBody)
+		result.Data.Type = "monitor"
+		result.Data.Attributes = monitor
 		return result, nil
 	}
 
-	page++
+	var postBody = bytes.NewReader(serializedBody)
 
-	for i := page; i <= lastPage; i++ {
-		tempMonitors, tempErr := c.ListMonitors(i, Blanc, Blanc)
-		if tempErr != nil {
-			return result, tempErr
-		}
-		for _, mon := range tempMonitors.Data {
-			mon.Attributes.ID = mon.ID
-			result = append(result, mon.Attributes)
-		}
+	var monitorRequest, monsErr = http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint(Monitors), postBody)
+	if monsErr != nil {
+		return result, fmt.Errorf("failed to create request: %v", monsErr)
 	}
 
-	return result, nil
-}
-
-func (c *BetterstackClient) FindMonitor(kind, val string) ([]Monitor, error) {
-	var result []Monitor
-	var monitorResponses MonitorsResponse
-	var monsErr error
-	var page = 1
-
-	monitorResponses, monsErr = c.ListMonitors(page, kind, val)
-	if monsErr != nil {
-		return result, monsErr
+	var monitorResponse, monsRespErr = c.execute(monitorRequest, &result, http.StatusCreated)
+	if monsRespErr != nil {
+		return result, monsRespErr
 	}
 
-	for _, mon := range monitorResponses.Data {
-		mon.Attributes.ID = mon.ID
-		result = append(result, mon.Attributes)
+	if funk.NotEmpty(result.Errors) {
+		return result, fmt.Errorf("failed to create monitor: %w", newAPIError(monitorResponse, result.Errors))
 	}
 
+	result.Data.Attributes.ID = result.Data.ID
+
 	return result, nil
 }
 
-func (c *BetterstackClient) CreateMonitor(monitor Monitor) (MonitorResponse, error) {
+func (s MonitorsService) Get(ctx context.Context, id string) (MonitorResponse, error) {
+	var c = s.client
 	var result MonitorResponse
-	var serializedBody, serErr = json.Marshal(monitor)
-	if serErr != nil {
-		return result, serErr
+	var targetURL = c.endpoint(MonitorID, id)
+
+	var monitorRequest, monErr = http.NewRequestWithContext(ctx, http.MethodGet, targetURL, nil)
+	if monErr != nil {
+		return result, fmt.Errorf("failed to create request: %v", monErr)
 	}
 
-	var postBody = bytes.NewReader(serializedBody)
+	var monitorResponse, monRespErr = c.execute(monitorRequest, &result)
+	if monRespErr != nil {
+		return result, monRespErr
+	}
 
-	var monitorRequest, monsErr = http.NewRequest(http.MethodPost, Monitors, postBody)
-	if monsErr != nil {
-		return result, fmt.Errorf("failed to create request: %v", monsErr)
+	if funk.NotEmpty(result.Errors) {
+		return result, fmt.Errorf("failed to get monitor: %w", newAPIError(monitorResponse, result.Errors))
 	}
 
-	monitorRequest.Header = c.headers
+	result.Data.Attributes.ID = result.Data.ID
+
+	return result, nil
+}
+
+func (s MonitorsService) ResponseTimes(ctx context.Context, id string) (MonitorResponseTimesResponse, error) {
+	var c = s.client
+	var result MonitorResponseTimesResponse
+	var targetURL = c.endpoint(MonitorResponseTimesID, id)
 
-	var monitorResponse, monsRespErr = http.DefaultClient.Do(monitorRequest)
-	if monsRespErr != nil || monitorResponse.StatusCode != http.StatusCreated {
-		return result, fmt.Errorf("failed to execute request: %v", monsRespErr)
+	var timesRequest, timesErr = http.NewRequestWithContext(ctx, http.MethodGet, targetURL, nil)
+	if timesErr != nil {
+		return result, fmt.Errorf("failed to create request: %v", timesErr)
 	}
 
-	var unmErr = json.NewDecoder(monitorResponse.Body).Decode(&result)
-	if unmErr != nil {
-		return result, fmt.Errorf("failed to unmarshal response: %v", unmErr)
+	var timesResponse, timesRespErr = c.execute(timesRequest, &result)
+	if timesRespErr != nil {
+		return result, timesRespErr
 	}
 
 	if funk.NotEmpty(result.Errors) {
-		return result, fmt.Errorf("failed to list monitors: %v", result.Errors)
+		return result, fmt.Errorf("failed to get monitor response times: %w", newAPIError(timesResponse, result.Errors))
 	}
 
 	result.Data.Attributes.ID = result.Data.ID
@@ -191,29 +275,36 @@ func (c *BetterstackClient) CreateMonitor(monitor Monitor) (MonitorResponse, err
 	return result, nil
 }
 
-func (c *BetterstackClient) GetMonitor(id string) (MonitorResponse, error) {
-	var result MonitorResponse
-	var targetURL = fmt.Sprintf(MonitorID, id)
+// SLA returns the availability summary of the monitor. Zero from and to default to the API defaults.
+func (s MonitorsService) SLA(ctx context.Context, id string, from, to time.Time) (MonitorSLAResponse, error) {
+	var c = s.client
+	var result MonitorSLAResponse
 
-	var monitorRequest, monErr = http.NewRequest(http.MethodGet, targetURL, nil)
-	if monErr != nil {
-		return result, fmt.Errorf("failed to create request: %v", monErr)
+	params := url.Values{}
+	if !from.IsZero() {
+		params.Add("from", from.UTC().Format(IncidentDateFormat))
+	}
+	if !to.IsZero() {
+		params.Add("to", to.UTC().Format(IncidentDateFormat))
 	}
 
-	monitorRequest.Header = c.headers
+	var targetURL = c.endpoint(MonitorSLAID, id)
+	if len(params) > 0 {
+		targetURL = withQuery(targetURL, params)
+	}
 
-	var monitorResponse, monRespErr = http.DefaultClient.Do(monitorRequest)
-	if monRespErr != nil {
-		return result, fmt.Errorf("failed to execute request: %v", monRespErr)
+	var slaRequest, slaErr = http.NewRequestWithContext(ctx, http.MethodGet, targetURL, nil)
+	if slaErr != nil {
+		return result, fmt.Errorf("failed to create request: %v", slaErr)
 	}
 
-	var unmErr = json.NewDecoder(monitorResponse.Body).Decode(&result)
-	if unmErr != nil {
-		return result, fmt.Errorf("failed to unmarshal response: %v", unmErr)
+	var slaResponse, slaRespErr = c.execute(slaRequest, &result)
+	if slaRespErr != nil {
+		return result, slaRespErr
 	}
 
 	if funk.NotEmpty(result.Errors) {
-		return result, fmt.Errorf("failed to get monitor: %v", result.Errors)
+		return result, fmt.Errorf("failed to get monitor sla: %w", newAPIError(slaResponse, result.Errors))
 	}
 
 	result.Data.Attributes.ID = result.Data.ID
@@ -221,61 +312,242 @@ func (c *BetterstackClient) GetMonitor(id string) (MonitorResponse, error) {
 	return result, nil
 }
 
-func (c *BetterstackClient) UpdateMonitor(id string, monitor Monitor) (MonitorResponse, error) {
+func (s MonitorsService) Update(ctx context.Context, id string, monitor Monitor) (MonitorResponse, error) {
+	var c = s.client
 	var result MonitorResponse
+	if c.readOnly {
+		return result, ErrReadOnlyClient
+	}
+	monitor.CreatedAt = nil
+	monitor.UpdatedAt = nil
+	var codes, codesErr = NormalizeStatusCodes(monitor.ExpectedStatusCodes)
+	if codesErr != nil {
+		return result, codesErr
+	}
+	monitor.ExpectedStatusCodes = codes
+	if keywordErr := validateKeyword(monitor, true); keywordErr != nil {
+		return result, keywordErr
+	}
+	monitor = requestBodyCompat(monitor)
+	if bodyErr := validateRequestBody(monitor, true); bodyErr != nil {
+		return result, bodyErr
+	}
+
 	var serializedBody, serErr = json.Marshal(monitor)
 	if serErr != nil {
 		return result, serErr
 	}
+	if sizeErr := c.checkSize(monitor.PronounceableName, serializedBody); sizeErr != nil {
+		return result, sizeErr
+	}
+	if emptyPatch(serializedBody) {
+		return result, ErrEmptyUpdate
+	}
+
+	var targetURL = c.endpoint(MonitorID, id)
+
+	if c.dryRun.enabled {
+		c.dryRun.record(http.MethodPatch, targetURL, serializedBody)
+		monitor.ID = id
+		result.Data.ID = id
+		result.Data.Type = "monitor"
+		result.Data.Attributes = monitor
+		return result, nil
+	}
 
 	var postBody = bytes.NewReader(serializedBody)
-	var targetURL = fmt.Sprintf(MonitorID, id)
 
-	var monitorRequest, monErr = http.NewRequest(http.MethodPatch, targetURL, postBody)
+	var monitorRequest, monErr = http.NewRequestWithContext(ctx, http.MethodPatch, targetURL, postBody)
 	if monErr != nil {
 		return result, fmt.Errorf("failed to create request: %v", monErr)
 	}
 
-	monitorRequest.Header = c.headers
-
-	var monitorResponse, monRespErr = http.DefaultClient.Do(monitorRequest)
+	var monitorResponse, monRespErr = c.execute(monitorRequest, &result, http.StatusOK)
 	if monRespErr != nil {
-		return result, fmt.Errorf("failed to execute request: %v", monRespErr)
+		return result, monRespErr
+	}
+
+	if funk.NotEmpty(result.Errors) {
+		return result, fmt.Errorf("failed to update monitor: %w", newAPIError(monitorResponse, result.Errors))
+	}
+
+	result.Data.Attributes.ID = result.Data.ID
+	return result, nil
+}
+
+// UpdateIf updates the monitor only when its updated_at still equals expectedUpdatedAt, otherwise it fails
+// with ErrConflict and leaves the monitor alone. The API has no conditional requests, so the check is a read
+// right before the update: it catches edits made since the caller read the monitor, not those racing the update.
+func (s MonitorsService) UpdateIf(ctx context.Context, id string, monitor Monitor, expectedUpdatedAt time.Time) (MonitorResponse, error) {
+	var c = s.client
+	if c.readOnly {
+		return MonitorResponse{}, ErrReadOnlyClient
 	}
 
-	if monitorResponse.StatusCode != http.StatusOK {
-		return result, fmt.Errorf("failed to execute request: %v", monitorResponse.Status)
+	var current, currentErr = s.Get(ctx, id)
+	if currentErr != nil {
+		return MonitorResponse{}, currentErr
 	}
 
-	var unmErr = json.NewDecoder(monitorResponse.Body).Decode(&result)
-	if unmErr != nil {
-		return result, fmt.Errorf("failed to unmarshal response: %v", unmErr)
+	var updatedAt = current.Data.Attributes.UpdatedAt
+	if updatedAt == nil || !updatedAt.Equal(expectedUpdatedAt) {
+		return current, fmt.Errorf("monitor %s updated at %v, expected %v: %w", id, updatedAt, expectedUpdatedAt, ErrConflict)
 	}
 
-	if funk.NotEmpty(result.Errors) {
-		return result, fmt.Errorf("failed to update monitor: %v", result.Errors)
+	return s.Update(ctx, id, monitor)
+}
+
+func (s MonitorsService) Delete(ctx context.Context, id string) error {
+	var c = s.client
+	if c.readOnly {
+		return ErrReadOnlyClient
 	}
 
-	result.Data.Attributes.ID = result.Data.ID
-	return result, nil
+	var guardErr = c.guardDelete(ctx, ResourceMonitor, id, func() (any, error) {
+		var monitor, getErr = s.Get(ctx, id)
+		return monitor.Data.Attributes, getErr
+	})
+	if guardErr != nil {
+		return guardErr
+	}
+
+	return c.deleteMonitor(ctx, id)
 }
 
-func (c *BetterstackClient) DeleteMonitor(id string) error {
+// deleteMonitor deletes without consulting the delete guards
+func (c *BetterstackClient) deleteMonitor(ctx context.Context, id string) error {
+	var targetURL = c.endpoint(MonitorID, id)
 
-	var targetURL = fmt.Sprintf(MonitorID, id)
+	if c.dryRun.enabled {
+		c.dryRun.record(http.MethodDelete, targetURL, nil)
+		return nil
+	}
 
-	var monitorRequest, monErr = http.NewRequest(http.MethodDelete, targetURL, nil)
+	var monitorRequest, monErr = http.NewRequestWithContext(ctx, http.MethodDelete, targetURL, nil)
 	if monErr != nil {
 		return fmt.Errorf("failed to create request: %v", monErr)
 	}
 
-	monitorRequest.Header = c.headers
+	var _, monRespErr = c.execute(monitorRequest, nil, http.StatusNoContent)
+	return monRespErr
+}
+
+// execute sends the request, checks the status against expected (any 2xx when none are given) and decodes the body
+// into result unless it is nil. The body is always drained and closed, so the connection goes back to the pool;
+// the returned response is only good for its status and headers.
+func (c *BetterstackClient) execute(request *http.Request, result any, expected ...int) (*http.Response, error) {
+	var response, respErr = c.do(request)
+	if respErr != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", respErr)
+	}
+	defer discard(response)
+
+	if redirectErr := checkRedirect(request, response); redirectErr != nil {
+		return response, fmt.Errorf("failed to execute request: %w", redirectErr)
+	}
+	if apiErr := checkResponse(response, expected...); apiErr != nil {
+		return response, fmt.Errorf("failed to execute request: %w", apiErr)
+	}
+	if result == nil {
+		return response, nil
+	}
+	if unmErr := json.NewDecoder(response.Body).Decode(result); unmErr != nil {
+		return response, fmt.Errorf("failed to unmarshal response: %v", unmErr)
+	}
+	return response, nil
+}
+
+// do executes the request with the client headers, compressing large bodies when enabled. When the API answers 401
+// and a token refresh callback is configured, the token is refreshed and the request is retried once.
+func (c *BetterstackClient) do(request *http.Request) (*http.Response, error) {
+	var headers, compressErr = c.compress(request)
+	if compressErr != nil {
+		return nil, compressErr
+	}
+
+	if c.cache.store == nil {
+		return c.send(request, headers)
+	}
+	if request.Method == http.MethodGet {
+		return c.doCached(request)
+	}
+
+	var response, respErr = c.send(request, headers)
+	if respErr == nil && response.StatusCode < http.StatusBadRequest {
+		c.cache.store.Clear()
+	}
+	return response, respErr
+}
+
+// send executes the request unless the circuit breaker is open, retrying once with a refreshed token when it is
+// answered with 401. Rate limited and transient failures are retried according to the rate limit and retry
+// policies.
+func (c *BetterstackClient) send(request *http.Request, headers http.Header) (*http.Response, error) {
+	if c.breaker == nil {
+		return c.authorize(request, headers)
+	}
+	if allowErr := c.breaker.allow(c.logger); allowErr != nil {
+		return nil, allowErr
+	}
+	var response, respErr = c.authorize(request, headers)
+	c.breaker.record(request, response, respErr, c.logger)
+	return response, respErr
+}
+
+// authorize executes the request, retrying once with a refreshed token when it is answered with 401
+func (c *BetterstackClient) authorize(request *http.Request, headers http.Header) (*http.Response, error) {
+	request.Header = headers
+
+	var response, respErr = c.attempt(request)
+	if respErr != nil || response.StatusCode != http.StatusUnauthorized || c.refreshToken == nil {
+		return response, respErr
+	}
+
+	if request.Body != nil && request.GetBody == nil {
+		return response, nil
+	}
+
+	var usedAuthorization = request.Header.Get("Authorization")
+	if refreshErr := c.refresh(usedAuthorization); refreshErr != nil {
+		return response, fmt.Errorf("failed to refresh token: %v", refreshErr)
+	}
+
+	var retry = request.Clone(request.Context())
+	if request.GetBody != nil {
+		var body, bodyErr = request.GetBody()
+		if bodyErr != nil {
+			return response, nil
+		}
+		retry.Body = body
+	}
+	retry.Header = headers.Clone()
+	retry.Header.Set("Authorization", c.headers.get("Authorization"))
+
+	discard(response)
+	c.logger.Info("retrying request with refreshed token", "method", request.Method, "path", request.URL.Path)
+
+	return c.attempt(retry)
+}
+
+// refresh obtains a new token from the refresh callback. Concurrent requests failing with the same token trigger
+// a single refresh.
+func (c *BetterstackClient) refresh(usedAuthorization string) error {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
 
-	var monitorResponse, monRespErr = http.DefaultClient.Do(monitorRequest)
-	if monRespErr != nil || monitorResponse.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("failed to execute request: %v", monRespErr)
+	if c.headers.get("Authorization") != usedAuthorization {
+		return nil
 	}
 
+	var token, tokenErr = c.refreshToken()
+	if tokenErr != nil {
+		return tokenErr
+	}
+	if funk.IsEmpty(token) {
+		return errors.New("refresh callback returned an empty token")
+	}
+
+	c.headers.set("Authorization", fmt.Sprintf("Bearer %s", token))
 	return nil
 }
 
@@ -284,3 +556,37 @@ func getDefaultHeaders() http.Header {
 	headers.Add(ContentType, ApplicationJSON)
 	return headers
 }
+
+// emptyPatch reports whether the serialized patch carries nothing but zero values. The attributes serialized
+// without omitempty (names, channels, regions) are then blank as well and would wipe the monitor.
+func emptyPatch(serialized []byte) bool {
+	var attributes map[string]any
+	if unmErr := json.Unmarshal(serialized, &attributes); unmErr != nil {
+		return false
+	}
+	for key, value := range attributes {
+		if key == "id" {
+			continue
+		}
+		if funk.NotEmpty(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Pause stops the checks of the monitor, no incidents are created while it is paused
+func (s MonitorsService) Pause(ctx context.Context, id string) (MonitorResponse, error) {
+	var c = s.client
+	return c.setMonitorPaused(ctx, id, true)
+}
+
+func (s MonitorsService) Resume(ctx context.Context, id string) (MonitorResponse, error) {
+	var c = s.client
+	return c.setMonitorPaused(ctx, id, false)
+}
+
+// setMonitorPaused sends paused alone, UpdateMonitor omits paused=false
+func (c *BetterstackClient) setMonitorPaused(ctx context.Context, id string, paused bool) (MonitorResponse, error) {
+	return c.Monitors().Patch(ctx, id, map[string]any{"paused": paused})
+}
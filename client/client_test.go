@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// scriptedServer answers the nth request with responses[n], the last one repeating, and counts the requests
+type scriptedServer struct {
+	*httptest.Server
+	requests atomic.Int32
+}
+
+type scriptedResponse struct {
+	status  int
+	headers map[string]string
+	body    string
+}
+
+func newScriptedServer(t *testing.T, responses ...scriptedResponse) *scriptedServer {
+	var server = &scriptedServer{}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var n = int(server.requests.Add(1)) - 1
+		var response = responses[min(n, len(responses)-1)]
+		for name, value := range response.headers {
+			w.Header().Set(name, value)
+		}
+		w.WriteHeader(response.status)
+		_, _ = w.Write([]byte(response.body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+const monitorBody = `{"data":{"id":"1","attributes":{"pronounceable_name":"shop"}}}`
+
+var okResponse = scriptedResponse{status: http.StatusOK, body: monitorBody}
+
+func TestExpiredTokenIsRefreshedOnce(t *testing.T) {
+	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(monitorBody))
+	}))
+	defer server.Close()
+
+	var refreshed atomic.Int32
+	var c = NewClient("expired", WithBaseURL(server.URL), WithTokenRefresh(func() (string, error) {
+		refreshed.Add(1)
+		return "fresh", nil
+	}))
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, getErr := c.Monitors().Get(context.Background(), "1"); getErr != nil {
+				t.Error(getErr)
+			}
+		}()
+	}
+	wg.Wait()
+	if refreshed.Load() != 1 {
+		t.Errorf("expected a single refresh, got %d", refreshed.Load())
+	}
+}
+
+func TestUnauthorizedWithoutRefresh(t *testing.T) {
+	var server = newScriptedServer(t, scriptedResponse{status: http.StatusUnauthorized, body: `{"errors":"invalid token"}`})
+	var c = NewClient("token", WithBaseURL(server.URL))
+	var _, getErr = c.Monitors().Get(context.Background(), "1")
+	if StatusCode(getErr) != http.StatusUnauthorized || server.requests.Load() != 1 {
+		t.Errorf("expected a single 401, got %d requests: %v", server.requests.Load(), getErr)
+	}
+}
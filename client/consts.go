@@ -2,6 +2,7 @@ package client
 
 const MonitorTypeStatus = "status"
 const MonitorTypeStatusCode = "status_code"
+const MonitorTypeExpectedStatusCode = "expected_status_code"
 const MonitorTypeKeyword = "keyword"
 const MonitorTypeKeywordAbsence = "keyword_absence"
 const MonitorTypePing = "ping"
@@ -28,3 +29,18 @@ const Thursday = "thu"
 const Friday = "fri"
 const Saturday = "sat"
 const Sunday = "sun"
+
+const IncidentStatusStarted = "Started"
+const IncidentStatusAcknowledged = "Acknowledged"
+const IncidentStatusResolved = "Resolved"
+
+const OwnerTypeMonitor = "Monitor"
+const OwnerTypeHeartbeat = "Heartbeat"
+const OwnerTypeIncident = "Incident"
+
+const MonitorStatusUp = "up"
+const MonitorStatusDown = "down"
+const MonitorStatusPaused = "paused"
+const MonitorStatusPending = "pending"
+const MonitorStatusMaintenance = "maintenance"
+const MonitorStatusValidating = "validating"
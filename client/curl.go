@@ -0,0 +1,74 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/thoas/go-funk"
+)
+
+const CurlPasswordPlaceholder = "$MONITOR_PASSWORD"
+
+var httpMonitorTypes = []string{
+	MonitorTypeStatus,
+	MonitorTypeStatusCode,
+	MonitorTypeExpectedStatusCode,
+	MonitorTypeKeyword,
+	MonitorTypeKeywordAbsence,
+}
+
+// AsCurl renders a curl command reproducing the check of an HTTP monitor. The basic auth password is never rendered,
+// the command references the CurlPasswordPlaceholder shell variable instead.
+func (m Monitor) AsCurl() (string, error) {
+	if !funk.ContainsString(httpMonitorTypes, m.MonitorType) {
+		return Blanc, fmt.Errorf("monitor type %s is not an HTTP check", m.MonitorType)
+	}
+
+	var method = strings.ToUpper(m.HTTPMethod)
+	if funk.IsEmpty(method) {
+		method = http.MethodGet
+	}
+
+	var parts = []string{"curl", "--silent", "--show-error", "--include"}
+
+	switch method {
+	case http.MethodGet:
+	case http.MethodHead:
+		parts = append(parts, "--head")
+	default:
+		parts = append(parts, "--request", method)
+	}
+
+	for _, header := range m.RequestHeaders {
+		parts = append(parts, "--header", shellQuote(fmt.Sprintf("%s: %s", header.Name, header.Value)))
+	}
+
+	if funk.NotEmpty(m.AuthUsername) {
+		// Double quotes let the shell expand the password variable
+		parts = append(parts, "--user", fmt.Sprintf("\"%s:%s\"", doubleQuoteEscaper.Replace(m.AuthUsername),
+			CurlPasswordPlaceholder))
+	}
+
+	if funk.NotEmpty(m.RequestBody) && method != http.MethodGet && method != http.MethodHead {
+		parts = append(parts, "--data-raw", shellQuote(m.RequestBody))
+	}
+
+	if m.RequestTimeout > 0 {
+		parts = append(parts, "--max-time", fmt.Sprintf("%d", m.RequestTimeout))
+	}
+
+	if m.FollowRedirects {
+		parts = append(parts, "--location")
+	}
+
+	parts = append(parts, shellQuote(m.URL))
+
+	return strings.Join(parts, " "), nil
+}
+
+var doubleQuoteEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "$", `\$`, "`", "\\`")
+
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,152 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	json "github.com/json-iterator/go"
+	"github.com/thoas/go-funk"
+)
+
+// Redacted replaces secrets in debug dumps
+const Redacted = "[REDACTED]"
+
+// maxDebugBody caps the bodies dumped by debug mode
+const maxDebugBody = 64 << 10
+
+// redactedHeaders are dumped as Redacted, the API token and whatever a proxy may add to authenticate. Headers set
+// by request signers, e.g. the API key or signature of a gateway, are redacted as well.
+var redactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// redactedFields are credentials in JSON bodies, at any depth: basic auth of monitors and incident tokens of policies
+var redactedFields = []string{"auth_username", "auth_password", "incident_token"}
+
+// Values of these arrays of name/value objects are redacted, the request headers of monitors often carry API keys
+const redactedHeadersField = "request_headers"
+
+// Fallbacks for bodies which aren't valid JSON, e.g. cut at maxDebugBody
+var redactedFieldsPattern = regexp.MustCompile(`("(?:auth_username|auth_password|incident_token)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+var redactedValuesPattern = regexp.MustCompile(`("value"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// WithDebug logs every HTTP request and response in full: method, URL, headers, body, status and duration. The
+// API token, other credential headers and headers set by signers are redacted, so are the credentials and request
+// header values of monitors and the incident tokens of policies. Dumps are logged at info level, so they show
+// without lowering the level of the logger. Bodies are cut at 64KB.
+func WithDebug() Option {
+	return func(c *BetterstackClient) {
+		c.debug = true
+	}
+}
+
+// dumpRequest logs the request as it is about to be sent, signed are the headers the signers set
+func (c *BetterstackClient) dumpRequest(request *http.Request, signed []string) {
+	var body, bodyErr = ReadBody(request)
+	if bodyErr != nil {
+		body = []byte("<" + bodyErr.Error() + ">")
+	}
+	c.logger.Info("debug: request", "method", request.Method, "url", request.URL.String(),
+		"headers", redactHeaders(request.Header, signed), "body", debugBody(request.Header, body))
+}
+
+// dumpResponse logs the response, or the error the request failed with. Up to maxDebugBody of the body is read
+// and put back, so the response can be used as if it wasn't dumped.
+func (c *BetterstackClient) dumpResponse(request *http.Request, response *http.Response, respErr error, duration time.Duration) {
+	if respErr != nil {
+		c.logger.Info("debug: response", "method", request.Method, "url", request.URL.String(), "error", respErr,
+			"duration", duration)
+		return
+	}
+
+	var body, readErr = io.ReadAll(io.LimitReader(response.Body, maxDebugBody+1))
+	response.Body = &rereadBody{Reader: io.MultiReader(bytes.NewReader(body), response.Body), Closer: response.Body}
+	var dumped = debugBody(response.Header, body)
+	if readErr != nil {
+		dumped += "<" + readErr.Error() + ">"
+	}
+	c.logger.Info("debug: response", "method", request.Method, "url", request.URL.String(),
+		"status", response.StatusCode, "duration", duration, "headers", redactHeaders(response.Header, nil),
+		"body", dumped)
+}
+
+// rereadBody returns the part of a body read for the dump, then the rest of it
+type rereadBody struct {
+	io.Reader
+	io.Closer
+}
+
+func redactHeaders(headers http.Header, signed []string) http.Header {
+	var result = headers.Clone()
+	for _, names := range [][]string{redactedHeaders, signed} {
+		for _, name := range names {
+			if _, found := result[http.CanonicalHeaderKey(name)]; found {
+				result.Set(name, Redacted)
+			}
+		}
+	}
+	return result
+}
+
+// debugBody returns the body as text, gunzipped when it was compressed, credentials redacted
+func debugBody(headers http.Header, body []byte) string {
+	if strings.EqualFold(headers.Get("Content-Encoding"), "gzip") {
+		if reader, gzipErr := gzip.NewReader(bytes.NewReader(body)); gzipErr == nil {
+			// A body cut at maxDebugBody fails to decompress at the end, what was decompressed is still dumped
+			if plain, _ := io.ReadAll(reader); len(plain) > 0 {
+				body = plain
+			}
+		}
+	}
+	var truncated = len(body) > maxDebugBody
+	var result = RedactBody(string(body))
+	if truncated && len(result) > maxDebugBody {
+		result = result[:maxDebugBody] + "..."
+	}
+	return result
+}
+
+// RedactBody replaces the values of auth_username, auth_password and incident_token as well as the values of
+// request_headers in a JSON body with Redacted. Bodies which aren't valid JSON are redacted by pattern, then every
+// "value" is redacted once request_headers appear.
+func RedactBody(body string) string {
+	var decoder = json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	var document any
+	if decoder.Decode(&document) == nil && !decoder.More() {
+		if serialized, serErr := json.Marshal(redactValue(document, false)); serErr == nil {
+			return string(serialized)
+		}
+	}
+
+	var result = redactedFieldsPattern.ReplaceAllString(body, `$1"`+Redacted+`"`)
+	if strings.Contains(body, `"`+redactedHeadersField+`"`) {
+		result = redactedValuesPattern.ReplaceAllString(result, `$1"`+Redacted+`"`)
+	}
+	return result
+}
+
+// redactValue redacts the decoded JSON in place, inHeaders is set for the elements of request_headers
+func redactValue(value any, inHeaders bool) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, field := range typed {
+			switch {
+			case inHeaders && key == "value", funk.ContainsString(redactedFields, key):
+				if field != nil {
+					typed[key] = Redacted
+				}
+			default:
+				typed[key] = redactValue(field, key == redactedHeadersField)
+			}
+		}
+	case []any:
+		for i, element := range typed {
+			typed[i] = redactValue(element, inHeaders)
+		}
+	}
+	return value
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// recordingLogger keeps every message with its arguments, formatted as one line
+type recordingLogger struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (l *recordingLogger) record(msg string, args []any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines = append(l.lines, fmt.Sprint(append([]any{msg}, args...)...))
+}
+
+func (l *recordingLogger) Debug(msg string, args ...any) { l.record(msg, args) }
+func (l *recordingLogger) Info(msg string, args ...any)  { l.record(msg, args) }
+func (l *recordingLogger) Warn(msg string, args ...any)  { l.record(msg, args) }
+func (l *recordingLogger) Error(msg string, args ...any) { l.record(msg, args) }
+
+func (l *recordingLogger) output() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return strings.Join(l.lines, "\n")
+}
+
+func TestDebugRedactsSecrets(t *testing.T) {
+	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":{"id":"1","attributes":{"incident_token":"secret-token",
+			"request_headers":[{"name":"X-Key","value":"secret-header"}],"auth_password":"secret-password"}}}`))
+	}))
+	defer server.Close()
+
+	var logger = &recordingLogger{}
+	var signer = func(request *http.Request) error {
+		request.Header.Set("X-Gateway-Key", "secret-gateway")
+		request.Header.Set("X-Signature", "secret-signature")
+		return nil
+	}
+	var c = NewClient("secret-api-token", WithBaseURL(server.URL), WithDebug(), WithLogger(logger),
+		WithRequestSigner(signer))
+
+	var monitor = Monitor{URL: "https://example.com", PronounceableName: "shop", AuthUsername: "secret-user",
+		RequestHeaders: []RequestHeader{{Name: "X-Key", Value: "secret-header"}}}
+	if _, createErr := c.Monitors().Create(context.Background(), monitor); createErr != nil {
+		t.Fatal(createErr)
+	}
+
+	var output = logger.output()
+	if !strings.Contains(output, Redacted) || !strings.Contains(output, "X-Key") {
+		t.Fatalf("nothing dumped: %s", output)
+	}
+	if strings.Contains(output, "secret") {
+		t.Errorf("secret dumped: %s", output)
+	}
+}
+
+func TestDebugKeepsLargeResponses(t *testing.T) {
+	var large = strings.Repeat("x", 2*maxDebugBody)
+	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(large))
+	}))
+	defer server.Close()
+
+	var logger = &recordingLogger{}
+	var c = NewClient("token", WithDebug(), WithLogger(logger))
+	var request, _ = http.NewRequest(http.MethodGet, server.URL, nil)
+	var response, respErr = c.attempt(request)
+	if respErr != nil {
+		t.Fatal(respErr)
+	}
+	defer response.Body.Close()
+
+	var body, _ = io.ReadAll(response.Body)
+	if string(body) != large {
+		t.Errorf("response body changed by the dump, %d bytes", len(body))
+	}
+	if len(logger.output()) > 2*maxDebugBody {
+		t.Errorf("dump not cut, %d bytes", len(logger.output()))
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	var cases = []string{
+		`{"auth_username":"secret","nested":[{"incident_token":"secret"}]}`,
+		`{"request_headers":[{"name":"X-Key","value":"secret"}]}`,
+		// Cut at maxDebugBody, so not valid JSON
+		`{"auth_password":"secret","request_headers":[{"name":"X-Key","value":"secret"}],"url":"https://exa`,
+	}
+	for _, body := range cases {
+		if redacted := RedactBody(body); strings.Contains(redacted, "secret") || !strings.Contains(redacted, Redacted) {
+			t.Errorf("%s: redacted to %s", body, redacted)
+		}
+	}
+	if redacted := RedactBody(`{"key":"region","value":"eu"}`); !strings.Contains(redacted, "eu") {
+		t.Errorf("metadata value redacted: %s", redacted)
+	}
+}
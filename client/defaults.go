@@ -0,0 +1,38 @@
+package client
+
+// DefaultsProfile holds fleet-wide conventions for new monitors. Every attribute left unset (zero) on a monitor
+// passed to CreateMonitor is taken from the profile, attributes set on the monitor always win.
+type DefaultsProfile struct {
+	Regions            []string
+	CheckFrequency     int
+	RecoveryPeriod     int
+	ConfirmationPeriod int
+	PolicyID           string
+}
+
+// Apply returns the monitor with its unset attributes filled from the profile
+func (p DefaultsProfile) Apply(monitor Monitor) Monitor {
+	if len(monitor.Regions) == 0 && len(p.Regions) > 0 {
+		monitor.Regions = append([]string(nil), p.Regions...)
+	}
+	if monitor.CheckFrequency == 0 {
+		monitor.CheckFrequency = p.CheckFrequency
+	}
+	if monitor.RecoveryPeriod == 0 {
+		monitor.RecoveryPeriod = p.RecoveryPeriod
+	}
+	if monitor.ConfirmationPeriod == 0 {
+		monitor.ConfirmationPeriod = p.ConfirmationPeriod
+	}
+	if monitor.PolicyID == Blanc {
+		monitor.PolicyID = p.PolicyID
+	}
+	return monitor
+}
+
+// WithDefaultsProfile merges the profile into every monitor created through the client
+func WithDefaultsProfile(profile DefaultsProfile) Option {
+	return func(c *BetterstackClient) {
+		c.defaults = &profile
+	}
+}
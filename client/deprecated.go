@@ -0,0 +1,248 @@
+package client
+
+import (
+	"context"
+	"time"
+)
+
+// The flat methods below predate the resource services and stay for existing callers. Each one runs the service
+// method with context.Background().
+
+// Deprecated: use Monitors().ListPage.
+func (c *BetterstackClient) ListMonitors(page int, filterType, filterValue string) (MonitorsResponse, error) {
+	return c.Monitors().ListPage(context.Background(), page, filterType, filterValue)
+}
+
+// Deprecated: use Monitors().List.
+func (c *BetterstackClient) ListAllMonitors(filters ...MonitorFilter) ([]Monitor, error) {
+	return c.Monitors().List(context.Background(), filters...)
+}
+
+// Deprecated: use Monitors().Pages.
+func (c *BetterstackClient) MonitorPages() *PageIterator[Monitor] {
+	return c.Monitors().Pages()
+}
+
+// FindMonitor returns the monitors of the first page matching the url or pronounceable_name filter.
+//
+// Deprecated: use Monitors().ListPage.
+func (c *BetterstackClient) FindMonitor(kind, val string) ([]Monitor, error) {
+	var result []Monitor
+
+	var monitorResponses, monsErr = c.Monitors().ListPage(context.Background(), 1, kind, val)
+	if monsErr != nil {
+		return result, monsErr
+	}
+
+	for _, mon := range monitorResponses.Data {
+		mon.Attributes.ID = mon.ID
+		result = append(result, mon.Attributes)
+	}
+
+	return result, nil
+}
+
+// Deprecated: use Monitors().Create.
+func (c *BetterstackClient) CreateMonitor(monitor Monitor) (MonitorResponse, error) {
+	return c.Monitors().Create(context.Background(), monitor)
+}
+
+// Deprecated: use Monitors().Get.
+func (c *BetterstackClient) GetMonitor(id string) (MonitorResponse, error) {
+	return c.Monitors().Get(context.Background(), id)
+}
+
+// Deprecated: use Monitors().GetMany.
+func (c *BetterstackClient) GetMonitors(ids []string) (map[string]Monitor, map[string]error) {
+	return c.Monitors().GetMany(context.Background(), ids)
+}
+
+// Deprecated: use Monitors().ResponseTimes.
+func (c *BetterstackClient) GetMonitorResponseTimes(id string) (MonitorResponseTimesResponse, error) {
+	return c.Monitors().ResponseTimes(context.Background(), id)
+}
+
+// Deprecated: use Monitors().SLA.
+func (c *BetterstackClient) GetMonitorSLA(id string, from, to time.Time) (MonitorSLAResponse, error) {
+	return c.Monitors().SLA(context.Background(), id, from, to)
+}
+
+// Deprecated: use Monitors().Update.
+func (c *BetterstackClient) UpdateMonitor(id string, monitor Monitor) (MonitorResponse, error) {
+	return c.Monitors().Update(context.Background(), id, monitor)
+}
+
+// Deprecated: use Monitors().UpdateIf.
+func (c *BetterstackClient) UpdateMonitorIf(id string, monitor Monitor, expectedUpdatedAt time.Time) (MonitorResponse, error) {
+	return c.Monitors().UpdateIf(context.Background(), id, monitor, expectedUpdatedAt)
+}
+
+// Deprecated: use Monitors().Delete.
+func (c *BetterstackClient) DeleteMonitor(id string) error {
+	return c.Monitors().Delete(context.Background(), id)
+}
+
+// Deprecated: use Monitors().Pause.
+func (c *BetterstackClient) PauseMonitor(id string) (MonitorResponse, error) {
+	return c.Monitors().Pause(context.Background(), id)
+}
+
+// Deprecated: use Monitors().Resume.
+func (c *BetterstackClient) ResumeMonitor(id string) (MonitorResponse, error) {
+	return c.Monitors().Resume(context.Background(), id)
+}
+
+// Deprecated: use Monitors().ValidateRemote.
+func (c *BetterstackClient) ValidateMonitorRemote(monitor Monitor) error {
+	return c.Monitors().ValidateRemote(context.Background(), monitor)
+}
+
+// Deprecated: use Monitors().Artifacts.
+func (c *BetterstackClient) MonitorArtifacts(monitorID string, from, to time.Time) ([]Artifact, error) {
+	return c.Monitors().Artifacts(context.Background(), monitorID, from, to)
+}
+
+// Deprecated: use MonitorGroups().ListPage.
+func (c *BetterstackClient) ListMonitorGroups(page int) (MonitorGroupsResponse, error) {
+	return c.MonitorGroups().ListPage(context.Background(), page)
+}
+
+// Deprecated: use MonitorGroups().List.
+func (c *BetterstackClient) ListAllMonitorGroups() ([]MonitorGroup, error) {
+	return c.MonitorGroups().List(context.Background())
+}
+
+// Deprecated: use MonitorGroups().Pages.
+func (c *BetterstackClient) MonitorGroupPages() *PageIterator[MonitorGroup] {
+	return c.MonitorGroups().Pages()
+}
+
+// Deprecated: use MonitorGroups().Create.
+func (c *BetterstackClient) CreateMonitorGroup(group MonitorGroup) (MonitorGroupResponse, error) {
+	return c.MonitorGroups().Create(context.Background(), group)
+}
+
+// Deprecated: use MonitorGroups().Get.
+func (c *BetterstackClient) GetMonitorGroup(id string) (MonitorGroupResponse, error) {
+	return c.MonitorGroups().Get(context.Background(), id)
+}
+
+// Deprecated: use MonitorGroups().Update.
+func (c *BetterstackClient) UpdateMonitorGroup(id string, group MonitorGroup) (MonitorGroupResponse, error) {
+	return c.MonitorGroups().Update(context.Background(), id, group)
+}
+
+// Deprecated: use MonitorGroups().Delete.
+func (c *BetterstackClient) DeleteMonitorGroup(id string) error {
+	return c.MonitorGroups().Delete(context.Background(), id)
+}
+
+// Deprecated: use MonitorGroups().Pause.
+func (c *BetterstackClient) PauseGroup(id string, cascade bool) (GroupPauseResult, error) {
+	return c.MonitorGroups().Pause(context.Background(), id, cascade)
+}
+
+// Deprecated: use MonitorGroups().Resume.
+func (c *BetterstackClient) ResumeGroup(id string, cascade bool) (GroupPauseResult, error) {
+	return c.MonitorGroups().Resume(context.Background(), id, cascade)
+}
+
+// Deprecated: use Incidents().ListPage.
+func (c *BetterstackClient) ListIncidents(page int, from, to time.Time) (IncidentsResponse, error) {
+	return c.Incidents().ListPage(context.Background(), page, from, to)
+}
+
+// Deprecated: use Incidents().Pages.
+func (c *BetterstackClient) IncidentPages(from, to time.Time) *PageIterator[Incident] {
+	return c.Incidents().Pages(from, to)
+}
+
+// Deprecated: use Incidents().ListForMonitor.
+func (c *BetterstackClient) ListIncidentsForMonitor(monitorID string, from, to time.Time) ([]Incident, error) {
+	return c.Incidents().ListForMonitor(context.Background(), monitorID, from, to)
+}
+
+// Deprecated: use Incidents().Get.
+func (c *BetterstackClient) GetIncident(id string) (IncidentResponse, error) {
+	return c.Incidents().Get(context.Background(), id)
+}
+
+// Deprecated: use Incidents().Create.
+func (c *BetterstackClient) CreateIncident(incident NewIncident) (IncidentResponse, error) {
+	return c.Incidents().Create(context.Background(), incident)
+}
+
+// Deprecated: use Incidents().TestNotifications.
+func (c *BetterstackClient) TestNotifications(monitorID, requesterEmail string) (IncidentResponse, error) {
+	return c.Incidents().TestNotifications(context.Background(), monitorID, requesterEmail)
+}
+
+// Deprecated: use Incidents().Acknowledge.
+func (c *BetterstackClient) AcknowledgeIncident(id, acknowledgedBy string) (IncidentResponse, error) {
+	return c.Incidents().Acknowledge(context.Background(), id, acknowledgedBy)
+}
+
+// Deprecated: use Incidents().Resolve.
+func (c *BetterstackClient) ResolveIncident(id, resolvedBy string) (IncidentResponse, error) {
+	return c.Incidents().Resolve(context.Background(), id, resolvedBy)
+}
+
+// Deprecated: use Incidents().Comments.
+func (c *BetterstackClient) ListIncidentComments(id string) ([]IncidentComment, error) {
+	return c.Incidents().Comments(context.Background(), id)
+}
+
+// Deprecated: use Incidents().Comment.
+func (c *BetterstackClient) CreateIncidentComment(id, content string) error {
+	return c.Incidents().Comment(context.Background(), id, content)
+}
+
+// Deprecated: use Incidents().Artifacts.
+func (c *BetterstackClient) IncidentArtifacts(incidentID string) ([]Artifact, error) {
+	return c.Incidents().Artifacts(context.Background(), incidentID)
+}
+
+// Deprecated: use Metadata().List.
+func (c *BetterstackClient) ListMetadata(ownerType, ownerID string) ([]MetadataRecord, error) {
+	return c.Metadata().List(context.Background(), ownerType, ownerID)
+}
+
+// Deprecated: use Metadata().Upsert.
+func (c *BetterstackClient) UpsertMetadata(record MetadataRecord) (MetadataResponse, error) {
+	return c.Metadata().Upsert(context.Background(), record)
+}
+
+// Deprecated: use Metadata().Delete.
+func (c *BetterstackClient) DeleteMetadata(id string) error {
+	return c.Metadata().Delete(context.Background(), id)
+}
+
+// Deprecated: use OnCall().ListPage.
+func (c *BetterstackClient) ListOnCallCalendars(page int) (OnCallCalendarsResponse, error) {
+	return c.OnCall().ListPage(context.Background(), page)
+}
+
+// Deprecated: use OnCall().List.
+func (c *BetterstackClient) ListAllOnCallCalendars() ([]OnCallCalendar, error) {
+	return c.OnCall().List(context.Background())
+}
+
+// Deprecated: use OnCall().Pages.
+func (c *BetterstackClient) OnCallCalendarPages() *PageIterator[OnCallCalendar] {
+	return c.OnCall().Pages()
+}
+
+// Deprecated: use Policies().ListPage.
+func (c *BetterstackClient) ListPolicies(page int) (PoliciesResponse, error) {
+	return c.Policies().ListPage(context.Background(), page)
+}
+
+// Deprecated: use Policies().List.
+func (c *BetterstackClient) ListAllPolicies() ([]Policy, error) {
+	return c.Policies().List(context.Background())
+}
+
+// Deprecated: use Policies().Pages.
+func (c *BetterstackClient) PolicyPages() *PageIterator[Policy] {
+	return c.Policies().Pages()
+}
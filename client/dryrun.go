@@ -0,0 +1,41 @@
+package client
+
+import (
+	"sync"
+)
+
+// DryRunRequest is a mutating request that was intercepted because the client works in dry-run mode.
+type DryRunRequest struct {
+	Method string
+	URL    string
+	Body   []byte
+}
+
+type dryRunState struct {
+	enabled  bool
+	logger   Logger
+	mu       sync.Mutex
+	requests []DryRunRequest
+}
+
+func (d *dryRunState) record(method, targetURL string, body []byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	LoggerOrDefault(d.logger).Info("[dry-run] request intercepted", "method", method, "url", targetURL, "body", string(body))
+	d.requests = append(d.requests, DryRunRequest{
+		Method: method,
+		URL:    targetURL,
+		Body:   body,
+	})
+}
+
+// DryRunRequests returns a copy of all requests intercepted in dry-run mode, in the order they were issued.
+func (c *BetterstackClient) DryRunRequests() []DryRunRequest {
+	c.dryRun.mu.Lock()
+	defer c.dryRun.mu.Unlock()
+
+	var result = make([]DryRunRequest, len(c.dryRun.requests))
+	copy(result, c.dryRun.requests)
+	return result
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// LegacyBaseURL is the host the API was served from before the rename to Better Stack
+const LegacyBaseURL = "https://betteruptime.com"
+
+var ErrRedirectChangedMethod = errors.New("redirect changed the request method, set the base URL to the new location")
+
+// baseURLState holds the root the URL constants are moved to, empty means BaseURL
+type baseURLState struct {
+	mu   sync.RWMutex
+	root string
+}
+
+func (s *baseURLState) set(baseURL string) error {
+	var parsed, parseErr = url.Parse(baseURL)
+	if parseErr != nil {
+		return fmt.Errorf("invalid base URL %q: %v", baseURL, parseErr)
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == Blanc {
+		return fmt.Errorf("invalid base URL %q: an absolute http or https URL is required", baseURL)
+	}
+	if parsed.RawQuery != Blanc || parsed.Fragment != Blanc {
+		return fmt.Errorf("invalid base URL %q: query and fragment are not allowed", baseURL)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.root = strings.TrimSuffix(baseURL, "/")
+	return nil
+}
+
+func (s *baseURLState) get() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.root == Blanc {
+		return BaseURL
+	}
+	return s.root
+}
+
+// SetBaseURL makes the client send API requests to baseURL instead of BaseURL, e.g. a mock server, a proxy or
+// LegacyBaseURL. A path is kept as prefix: "http://proxy/betterstack" sends monitors to
+// "http://proxy/betterstack/api/v2/monitors".
+func (c *BetterstackClient) SetBaseURL(baseURL string) error {
+	return c.base.set(baseURL)
+}
+
+// BaseURL returns the root API requests are sent to
+func (c *BetterstackClient) BaseURL() string {
+	return c.base.get()
+}
+
+// WithBaseURL sets the base URL at construction, see SetBaseURL. An invalid URL is logged and ignored.
+func WithBaseURL(baseURL string) Option {
+	return func(c *BetterstackClient) {
+		if setErr := c.base.set(baseURL); setErr != nil {
+			c.logger.Warn("ignoring base URL", "error", setErr)
+		}
+	}
+}
+
+// endpoint formats one of the URL constants and moves it to the base URL of the client. Every URL of the client is
+// built here: the arguments, resource IDs, are path escaped, so an ID holding "/", "?" or "#" can't address another
+// resource, and a trailing slash is dropped.
+func (c *BetterstackClient) endpoint(format string, args ...any) string {
+	var target = format
+	if len(args) > 0 {
+		var escaped = make([]any, len(args))
+		for i, arg := range args {
+			escaped[i] = url.PathEscape(fmt.Sprint(arg))
+		}
+		target = fmt.Sprintf(format, escaped...)
+	}
+	return c.rebase(strings.TrimSuffix(target, "/"))
+}
+
+// withQuery appends the encoded params to the URL, which may carry a query already
+func withQuery(target string, params url.Values) string {
+	if len(params) == 0 {
+		return target
+	}
+	var separator = "?"
+	if strings.Contains(target, "?") {
+		separator = "&"
+	}
+	return target + separator + params.Encode()
+}
+
+// rebase moves an absolute URL below BaseURL, such as a pagination link, to the base URL of the client. Other
+// URLs are returned as they are.
+func (c *BetterstackClient) rebase(link string) string {
+	var root = c.base.get()
+	if root == BaseURL || !strings.HasPrefix(link, BaseURL) {
+		return link
+	}
+	var rest = strings.TrimPrefix(link, BaseURL)
+	if rest != Blanc && !strings.HasPrefix(rest, "/") && !strings.HasPrefix(rest, "?") {
+		return link
+	}
+	return root + rest
+}
+
+// checkRedirect refuses responses to a redirect which turned the request into another one. The HTTP client follows
+// 301, 302 and 303 answers to a POST with a GET, so a create would silently come back with a listing; 307 and 308
+// keep method and body.
+func checkRedirect(request *http.Request, response *http.Response) error {
+	var final = response.Request
+	if final == nil || final.Method == request.Method {
+		return nil
+	}
+	return fmt.Errorf("%s %s answered with a redirect to %s: %w", request.Method, request.URL.Redacted(), final.URL.Redacted(), ErrRedirectChangedMethod)
+}
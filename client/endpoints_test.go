@@ -0,0 +1,126 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestEndpointEscapesIDs(t *testing.T) {
+	var c = NewClient("token")
+	var cases = []struct {
+		id       string
+		expected string
+	}{
+		{"42", Monitors + "/42"},
+		{"a/b", Monitors + "/a%2Fb"},
+		{"1?page=2", Monitors + "/1%3Fpage=2"},
+		{"100%", Monitors + "/100%25"},
+		{"../monitor-groups/1", Monitors + "/..%2Fmonitor-groups%2F1"},
+		{"1#x", Monitors + "/1%23x"},
+		{"", Monitors},
+	}
+	for _, tc := range cases {
+		if endpoint := c.endpoint(MonitorID, tc.id); endpoint != tc.expected {
+			t.Errorf("ID %q: expected %s, got %s", tc.id, tc.expected, endpoint)
+		}
+	}
+}
+
+func TestWithQuery(t *testing.T) {
+	var cases = []struct {
+		target   string
+		params   url.Values
+		expected string
+	}{
+		{Monitors, nil, Monitors},
+		{Monitors, url.Values{}, Monitors},
+		{Monitors, url.Values{"page": {"2"}}, Monitors + "?page=2"},
+		{Monitors + "?per_page=50", url.Values{"page": {"2"}}, Monitors + "?per_page=50&page=2"},
+		{Monitors, url.Values{"url": {"https://example.com/?a=1&b=2"}}, Monitors + "?url=https%3A%2F%2Fexample.com%2F%3Fa%3D1%26b%3D2"},
+	}
+	for _, tc := range cases {
+		if target := withQuery(tc.target, tc.params); target != tc.expected {
+			t.Errorf("%s with %v: expected %s, got %s", tc.target, tc.params, tc.expected, target)
+		}
+	}
+}
+
+func TestRebase(t *testing.T) {
+	var c = NewClient("token")
+	if rebased := c.rebase(Monitors + "?page=2"); rebased != Monitors+"?page=2" {
+		t.Errorf("default base URL changed a link: %s", rebased)
+	}
+
+	if setErr := c.SetBaseURL("http://proxy.local/betterstack/"); setErr != nil {
+		t.Fatal(setErr)
+	}
+	var cases = []struct {
+		link     string
+		expected string
+	}{
+		{Monitors + "?page=2", "http://proxy.local/betterstack/api/v2/monitors?page=2"},
+		{BaseURL, "http://proxy.local/betterstack"},
+		{BaseURL + "?page=2", "http://proxy.local/betterstack?page=2"},
+		{"https://uptime.betterstack.com.evil.com/api/v2/monitors", "https://uptime.betterstack.com.evil.com/api/v2/monitors"},
+		{"https://example.com/api/v2/monitors", "https://example.com/api/v2/monitors"},
+	}
+	for _, tc := range cases {
+		if rebased := c.rebase(tc.link); rebased != tc.expected {
+			t.Errorf("%s: expected %s, got %s", tc.link, tc.expected, rebased)
+		}
+	}
+	if endpoint := c.endpoint(MonitorID, "a/b"); endpoint != "http://proxy.local/betterstack/api/v2/monitors/a%2Fb" {
+		t.Errorf("endpoint not moved to the base URL: %s", endpoint)
+	}
+}
+
+func TestSetBaseURLRejectsInvalidURLs(t *testing.T) {
+	var c = NewClient("token")
+	for _, baseURL := range []string{"uptime.betterstack.com", "ftp://proxy.local", "http://proxy.local?x=1", "http://proxy.local#x"} {
+		if setErr := c.SetBaseURL(baseURL); setErr == nil {
+			t.Errorf("%s: expected an error", baseURL)
+		}
+	}
+	if c.BaseURL() != BaseURL {
+		t.Errorf("invalid URL changed the base URL to %s", c.BaseURL())
+	}
+}
+
+func TestRedirectChangingTheMethodFails(t *testing.T) {
+	var cases = []struct {
+		status  int
+		changed bool
+	}{
+		{http.StatusMovedPermanently, true},
+		{http.StatusFound, true},
+		{http.StatusSeeOther, true},
+		{http.StatusTemporaryRedirect, false},
+		{http.StatusPermanentRedirect, false},
+	}
+	for _, tc := range cases {
+		var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/api/v2/monitors" {
+				http.Redirect(w, r, "/moved/api/v2/monitors", tc.status)
+				return
+			}
+			if r.Method == http.MethodPost {
+				w.WriteHeader(http.StatusCreated)
+			}
+			_, _ = w.Write([]byte(`{"data":{"id":"1","attributes":{}}}`))
+		}))
+
+		var c = NewClient("token", WithBaseURL(server.URL))
+		var _, createErr = c.Monitors().Create(context.Background(), Monitor{URL: "https://example.com", PronounceableName: "shop"})
+		if changed := errors.Is(createErr, ErrRedirectChangedMethod); changed != tc.changed {
+			t.Errorf("redirect %d: expected ErrRedirectChangedMethod %v, got %v", tc.status, tc.changed, createErr)
+		}
+		if !tc.changed && createErr != nil {
+			t.Errorf("redirect %d: %v", tc.status, createErr)
+		}
+		server.Close()
+	}
+}
@@ -0,0 +1,153 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	json "github.com/json-iterator/go"
+	"github.com/thoas/go-funk"
+)
+
+// Bytes of an error body decoded, characters of a non-JSON error body kept as message
+const maxErrorBody = 64 * 1024
+const maxErrorText = 200
+
+// APIError is returned, wrapped, for requests the API answered with an error status or an errors payload. Use
+// errors.As to branch on the status:
+//
+//	var apiErr *client.APIError
+//	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound { ... }
+type APIError struct {
+	Method string
+	URL    string
+
+	StatusCode int
+	Status     string
+
+	// Decoded errors payload, a message, a list of messages or messages by attribute; nil without payload
+	Errors any
+
+	// Wait asked for by the Retry-After header, 0 without one
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	var message = fmt.Sprintf("%s %s: %s", e.Method, e.URL, e.Status)
+	if messages := e.Messages(); len(messages) > 0 {
+		message = fmt.Sprintf("%s: %s", message, strings.Join(messages, "; "))
+	}
+	return message
+}
+
+// Messages flattens the errors payload, messages about an attribute are prefixed with its name
+func (e *APIError) Messages() []string {
+	return errorMessages(Blanc, e.Errors)
+}
+
+func errorMessages(prefix string, payload any) []string {
+	var label = func(message string) string {
+		if funk.IsEmpty(prefix) {
+			return message
+		}
+		return fmt.Sprintf("%s %s", prefix, message)
+	}
+
+	switch typed := payload.(type) {
+	case nil:
+		return nil
+	case string:
+		return []string{label(typed)}
+	case []any:
+		var result []string
+		for _, item := range typed {
+			result = append(result, errorMessages(prefix, item)...)
+		}
+		return result
+	case map[string]any:
+		// JSON:API error objects carry the message in detail or title
+		for _, key := range []string{"detail", "title"} {
+			if message, ok := typed[key].(string); ok {
+				return []string{label(message)}
+			}
+		}
+		var keys = make([]string, 0, len(typed))
+		for key := range typed {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		var result []string
+		for _, key := range keys {
+			result = append(result, errorMessages(strings.TrimSpace(prefix+" "+key), typed[key])...)
+		}
+		return result
+	default:
+		return []string{label(fmt.Sprint(typed))}
+	}
+}
+
+// RetryAfter returns how long the API asked to wait before the next request, for requests it rate limited. It is
+// 0 for other errors and when the API didn't say.
+func RetryAfter(err error) time.Duration {
+	var retryErr *RetryError
+	if errors.As(err, &retryErr) && retryErr.RetryAfter > 0 {
+		return retryErr.RetryAfter
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.RetryAfter
+	}
+	return 0
+}
+
+// StatusCode returns the status of the APIError wrapped by err, 0 for other errors
+func StatusCode(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+func newAPIError(response *http.Response, payload any) *APIError {
+	var apiErr = &APIError{StatusCode: response.StatusCode, Status: response.Status, Errors: payload}
+	if funk.IsEmpty(apiErr.Status) {
+		apiErr.Status = fmt.Sprintf("%d %s", response.StatusCode, http.StatusText(response.StatusCode))
+	}
+	if wait, found := retryAfter(response.Header, time.Now()); found {
+		apiErr.RetryAfter = wait
+	}
+	if response.Request != nil {
+		apiErr.Method = response.Request.Method
+		apiErr.URL = response.Request.URL.String()
+	}
+	return apiErr
+}
+
+// checkResponse returns an *APIError unless the response has one of the expected statuses, any 2xx status when
+// none are given. The errors payload of a rejected request is decoded and its body closed.
+func checkResponse(response *http.Response, expected ...int) error {
+	var accepted = response.StatusCode >= 200 && response.StatusCode < 300
+	if len(expected) > 0 {
+		accepted = funk.ContainsInt(expected, response.StatusCode)
+	}
+	if accepted {
+		return nil
+	}
+
+	var payload struct {
+		Errors any `json:"errors"`
+	}
+	var body, _ = io.ReadAll(io.LimitReader(response.Body, maxErrorBody))
+	_ = response.Body.Close()
+	if unmErr := json.Unmarshal(body, &payload); unmErr != nil && len(body) > 0 {
+		// Not JSON, e.g. the error page of a proxy
+		var text = []rune(strings.TrimSpace(string(body)))
+		payload.Errors = string(text[:min(len(text), maxErrorText)])
+	}
+	return newAPIError(response, payload.Errors)
+}
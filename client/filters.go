@@ -0,0 +1,68 @@
+package client
+
+import (
+	"strings"
+	"time"
+)
+
+// MonitorFilter selects monitors while they are listed, monitors it rejects are never collected
+type MonitorFilter func(monitor Monitor) bool
+
+func OnlyPaused() MonitorFilter {
+	return func(monitor Monitor) bool {
+		return monitor.Paused
+	}
+}
+
+func InGroup(groupID string) MonitorFilter {
+	return func(monitor Monitor) bool {
+		return monitor.GroupID() == groupID
+	}
+}
+
+func WithMonitorType(monitorType string) MonitorFilter {
+	return func(monitor Monitor) bool {
+		return monitor.MonitorType == monitorType
+	}
+}
+
+func URLContains(substr string) MonitorFilter {
+	return func(monitor Monitor) bool {
+		return strings.Contains(monitor.URL, substr)
+	}
+}
+
+// Not inverts a filter
+func Not(filter MonitorFilter) MonitorFilter {
+	return func(monitor Monitor) bool {
+		return !filter(monitor)
+	}
+}
+
+// AnyOf matches monitors matching at least one of the filters, ListAllMonitors combines its filters with AND
+func AnyOf(filters ...MonitorFilter) MonitorFilter {
+	return func(monitor Monitor) bool {
+		for _, filter := range filters {
+			if filter(monitor) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func matchesAll(monitor Monitor, filters []MonitorFilter) bool {
+	for _, filter := range filters {
+		if !filter(monitor) {
+			return false
+		}
+	}
+	return true
+}
+
+// UpdatedSince matches monitors updated at or after t, and monitors the API reports no updated_at for
+func UpdatedSince(t time.Time) MonitorFilter {
+	return func(monitor Monitor) bool {
+		return monitor.UpdatedAt == nil || !monitor.UpdatedAt.Before(t)
+	}
+}
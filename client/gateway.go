@@ -0,0 +1,124 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+
+	"github.com/thoas/go-funk"
+)
+
+// RequestSigner adds the credentials an authenticating API gateway expects, e.g. a signature header. Signers run
+// right before every attempt, after all other headers are set, and should only add headers of their own. ReadBody
+// returns the body to sign. Returning an error fails the request without sending it.
+//
+// Retries are signed again from the headers the request had before signing, so a signer never sees the output of
+// an earlier attempt. Signing must still be idempotent: a signer runs once per attempt and may not count on state
+// it kept from the previous one.
+type RequestSigner func(request *http.Request) error
+
+// WithRequestSigner registers a signer for every request to the API, signers run in registration order
+func WithRequestSigner(signer RequestSigner) Option {
+	return func(c *BetterstackClient) {
+		c.signers = append(c.signers, signer)
+	}
+}
+
+// WithTLSConfig sends requests with the TLS settings of config, e.g. the client certificate a gateway requires for
+// mutual TLS, see LoadTLSConfig. The transport of the configured http.Client is copied, not modified; a client
+// whose transport isn't an *http.Transport keeps its own TLS settings.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(c *BetterstackClient) {
+		c.tlsConfig = config
+	}
+}
+
+// LoadTLSConfig reads a PEM encoded client certificate and key for mutual TLS. The optional caFile replaces the
+// system roots for verifying the server, for gateways presenting a certificate of a private CA.
+func LoadTLSConfig(certFile, keyFile, caFile string) (*tls.Config, error) {
+	var certificate, certErr = tls.LoadX509KeyPair(certFile, keyFile)
+	if certErr != nil {
+		return nil, fmt.Errorf("failed to load client certificate: %v", certErr)
+	}
+	var config = &tls.Config{Certificates: []tls.Certificate{certificate}, MinVersion: tls.VersionTLS12}
+	if funk.IsEmpty(caFile) {
+		return config, nil
+	}
+
+	var ca, readErr = os.ReadFile(caFile)
+	if readErr != nil {
+		return nil, fmt.Errorf("failed to read CA file: %v", readErr)
+	}
+	var roots = x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("no certificate found in CA file %s", caFile)
+	}
+	config.RootCAs = roots
+	return config, nil
+}
+
+// ReadBody returns the body of the request without consuming it, nil for requests without body
+func ReadBody(request *http.Request) ([]byte, error) {
+	if request.Body == nil || request.Body == http.NoBody {
+		return nil, nil
+	}
+	if request.GetBody == nil {
+		return nil, errors.New("request body can't be read twice")
+	}
+	var body, bodyErr = request.GetBody()
+	if bodyErr != nil {
+		return nil, bodyErr
+	}
+	defer body.Close()
+	return io.ReadAll(body)
+}
+
+// applyTLSConfig swaps the http.Client for one whose transport uses the TLS config, once all options are applied
+func (c *BetterstackClient) applyTLSConfig() {
+	if c.tlsConfig == nil {
+		return
+	}
+	var transport = c.httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	var httpTransport, ok = transport.(*http.Transport)
+	if !ok {
+		c.logger.Warn("ignoring TLS config, transport is not an *http.Transport", "transport", fmt.Sprintf("%T", transport))
+		return
+	}
+
+	httpTransport = httpTransport.Clone()
+	httpTransport.TLSClientConfig = c.tlsConfig.Clone()
+	var httpClient = *c.httpClient
+	httpClient.Transport = httpTransport
+	c.httpClient = &httpClient
+}
+
+// sign replaces the headers of the request with a copy of unsigned and runs the signers on it. It returns the names
+// of the headers the signers added or changed.
+func (c *BetterstackClient) sign(request *http.Request, unsigned http.Header) ([]string, error) {
+	if len(c.signers) == 0 {
+		return nil, nil
+	}
+
+	request.Header = unsigned.Clone()
+	for _, signer := range c.signers {
+		if signErr := signer(request); signErr != nil {
+			return nil, fmt.Errorf("failed to sign request: %v", signErr)
+		}
+	}
+
+	var signed []string
+	for name, values := range request.Header {
+		if !slices.Equal(values, unsigned[name]) {
+			signed = append(signed, name)
+		}
+	}
+	return signed, nil
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetriesAreSignedFromScratch(t *testing.T) {
+	var attempts int
+	var signatures [][]string
+	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		signatures = append(signatures, r.Header.Values("X-Signature"))
+		if attempts < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"id":"1","attributes":{}}}`))
+	}))
+	defer server.Close()
+
+	var signed int
+	var signer = func(request *http.Request) error {
+		signed++
+		request.Header.Add("X-Signature", fmt.Sprintf("signature-%d", signed))
+		return nil
+	}
+	var c = NewClient("token", WithBaseURL(server.URL), WithRequestSigner(signer),
+		WithRetry(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+
+	if _, getErr := c.Monitors().Get(context.Background(), "1"); getErr != nil {
+		t.Fatal(getErr)
+	}
+	for i, values := range signatures {
+		if len(values) != 1 || values[0] != fmt.Sprintf("signature-%d", i+1) {
+			t.Errorf("attempt %d sent signatures %v", i+1, values)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var ErrGroupNotFound = errors.New("monitor group not found")
+
+// GroupOrderResult reports what Reorder, MoveBefore and MoveAfter changed
+type GroupOrderResult struct {
+	// All groups in their new order, SortIndex set
+	Groups []MonitorGroup
+
+	// Groups whose sort_index was updated
+	Updated []MonitorGroup
+
+	// Groups the update failed on, by group ID
+	Failed map[string]error
+}
+
+// SortGroups returns the groups in display order, by sort_index and then by name
+func SortGroups(groups []MonitorGroup) []MonitorGroup {
+	var result = append([]MonitorGroup{}, groups...)
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].SortIndex != result[j].SortIndex {
+			return result[i].SortIndex < result[j].SortIndex
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// ReorderGroups puts the named groups first, in the given order, followed by the other groups in their current
+// order. The result is numbered from 0.
+func ReorderGroups(groups []MonitorGroup, names []string) ([]MonitorGroup, error) {
+	var current = SortGroups(groups)
+	var positions, lookupErr = groupPositions(current, names...)
+	if lookupErr != nil {
+		return nil, lookupErr
+	}
+
+	var picked = map[int]bool{}
+	var result = make([]MonitorGroup, 0, len(current))
+	for _, name := range names {
+		var position = positions[name]
+		if picked[position] {
+			return nil, fmt.Errorf("monitor group %q is listed more than once", name)
+		}
+		picked[position] = true
+		result = append(result, current[position])
+	}
+	for i, group := range current {
+		if !picked[i] {
+			result = append(result, group)
+		}
+	}
+	return numberGroups(result), nil
+}
+
+// MoveGroupBefore moves the group right before anchor, the others keep their order. The result is numbered from 0.
+func MoveGroupBefore(groups []MonitorGroup, name, anchor string) ([]MonitorGroup, error) {
+	return moveGroup(groups, name, anchor, false)
+}
+
+// MoveGroupAfter moves the group right after anchor, the others keep their order. The result is numbered from 0.
+func MoveGroupAfter(groups []MonitorGroup, name, anchor string) ([]MonitorGroup, error) {
+	return moveGroup(groups, name, anchor, true)
+}
+
+func moveGroup(groups []MonitorGroup, name, anchor string, after bool) ([]MonitorGroup, error) {
+	if name == anchor {
+		return nil, fmt.Errorf("monitor group %q can't be moved relative to itself", name)
+	}
+	var current = SortGroups(groups)
+	var positions, lookupErr = groupPositions(current, name, anchor)
+	if lookupErr != nil {
+		return nil, lookupErr
+	}
+
+	var moved = current[positions[name]]
+	var result = make([]MonitorGroup, 0, len(current))
+	for i, group := range current {
+		if i == positions[name] {
+			continue
+		}
+		if i == positions[anchor] && !after {
+			result = append(result, moved)
+		}
+		result = append(result, group)
+		if i == positions[anchor] && after {
+			result = append(result, moved)
+		}
+	}
+	return numberGroups(result), nil
+}
+
+// groupPositions finds the named groups, names shared by several groups (of different teams) are refused
+func groupPositions(groups []MonitorGroup, names ...string) (map[string]int, error) {
+	var result = map[string]int{}
+	for _, name := range names {
+		var found = -1
+		for i, group := range groups {
+			if group.Name != name {
+				continue
+			}
+			if found >= 0 {
+				return nil, fmt.Errorf("monitor group name %q is ambiguous, %s and %s carry it", name, groups[found].ID, group.ID)
+			}
+			found = i
+		}
+		if found < 0 {
+			return nil, fmt.Errorf("%q: %w", name, ErrGroupNotFound)
+		}
+		result[name] = found
+	}
+	return result, nil
+}
+
+func numberGroups(groups []MonitorGroup) []MonitorGroup {
+	for i := range groups {
+		groups[i].SortIndex = i
+	}
+	return groups
+}
+
+// Reorder applies ReorderGroups to the groups of the account, see rearrange
+func (s MonitorGroupsService) Reorder(ctx context.Context, names []string) (GroupOrderResult, error) {
+	return s.rearrange(ctx, func(groups []MonitorGroup) ([]MonitorGroup, error) {
+		return ReorderGroups(groups, names)
+	})
+}
+
+// MoveBefore applies MoveGroupBefore to the groups of the account, see rearrange
+func (s MonitorGroupsService) MoveBefore(ctx context.Context, name, anchor string) (GroupOrderResult, error) {
+	return s.rearrange(ctx, func(groups []MonitorGroup) ([]MonitorGroup, error) {
+		return MoveGroupBefore(groups, name, anchor)
+	})
+}
+
+// MoveAfter applies MoveGroupAfter to the groups of the account, see rearrange
+func (s MonitorGroupsService) MoveAfter(ctx context.Context, name, anchor string) (GroupOrderResult, error) {
+	return s.rearrange(ctx, func(groups []MonitorGroup) ([]MonitorGroup, error) {
+		return MoveGroupAfter(groups, name, anchor)
+	})
+}
+
+// rearrange lists the groups, computes the new order and updates the sort_index of the groups whose index
+// changed. Nothing is updated when the order can't be computed; a failing update doesn't stop the others, the
+// failures are keyed in Failed.
+func (s MonitorGroupsService) rearrange(ctx context.Context, order func(groups []MonitorGroup) ([]MonitorGroup, error)) (GroupOrderResult, error) {
+	var c = s.client
+	var result = GroupOrderResult{Failed: map[string]error{}}
+	if c.readOnly {
+		return result, ErrReadOnlyClient
+	}
+
+	var groups, listErr = s.List(ctx)
+	if listErr != nil {
+		return result, fmt.Errorf("failed to list monitor groups: %w", listErr)
+	}
+	var previous = map[string]int{}
+	for _, group := range groups {
+		previous[group.ID] = group.SortIndex
+	}
+
+	var ordered, orderErr = order(groups)
+	if orderErr != nil {
+		return result, orderErr
+	}
+	result.Groups = ordered
+
+	for _, group := range ordered {
+		if previous[group.ID] == group.SortIndex {
+			continue
+		}
+		var updated, updateErr = s.Update(ctx, group.ID, group)
+		if updateErr != nil {
+			result.Failed[group.ID] = updateErr
+			continue
+		}
+		result.Updated = append(result.Updated, updated.Data.Attributes)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,279 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	json "github.com/json-iterator/go"
+	"github.com/thoas/go-funk"
+)
+
+func (s MonitorGroupsService) ListPage(ctx context.Context, page int) (MonitorGroupsResponse, error) {
+	var c = s.client
+	var result MonitorGroupsResponse
+
+	if page < 1 {
+		page = 1
+	}
+
+	params := url.Values{}
+	params.Add("per_page", "250")
+	params.Add("page", fmt.Sprintf("%d", page))
+
+	var targetURL = withQuery(c.endpoint(MonitorGroups), params)
+
+	var groupsRequest, groupsErr = http.NewRequestWithContext(ctx, http.MethodGet, targetURL, nil)
+	if groupsErr != nil {
+		return result, fmt.Errorf("failed to create request: %v", groupsErr)
+	}
+
+	var groupsResponse, groupsRespErr = c.execute(groupsRequest, &result)
+	if groupsRespErr != nil {
+		return result, groupsRespErr
+	}
+
+	if funk.NotEmpty(result.Errors) {
+		return result, fmt.Errorf("failed to list monitor groups: %w", newAPIError(groupsResponse, result.Errors))
+	}
+
+	result.client = c
+
+	return result, nil
+}
+
+func (s MonitorGroupsService) List(ctx context.Context) ([]MonitorGroup, error) {
+	var result []MonitorGroup
+	var pages = s.Pages()
+
+	for pages.Next(ctx) {
+		for _, group := range pages.Page().Data {
+			group.Attributes.ID = group.ID
+			result = append(result, group.Attributes)
+		}
+	}
+
+	return result, pages.Err()
+}
+
+func (s MonitorGroupsService) Pages() *PageIterator[MonitorGroup] {
+	return newPageIterator[MonitorGroup](s.client, withQuery(s.client.endpoint(MonitorGroups), url.Values{"page": {"1"}, "per_page": {"250"}}), nil)
+}
+
+func (s MonitorGroupsService) Create(ctx context.Context, group MonitorGroup) (MonitorGroupResponse, error) {
+	var c = s.client
+	var result MonitorGroupResponse
+	if c.readOnly {
+		return result, ErrReadOnlyClient
+	}
+
+	var serializedBody, serErr = json.Marshal(group)
+	if serErr != nil {
+		return result, serErr
+	}
+
+	if c.dryRun.enabled {
+		c.dryRun.record(http.MethodPost, c.endpoint(MonitorGroups), serializedBody)
+		result.Data.Type = "monitor_group"
+		result.Data.Attributes = group
+		return result, nil
+	}
+
+	var postBody = bytes.NewReader(serializedBody)
+
+	var groupRequest, groupErr = http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint(MonitorGroups), postBody)
+	if groupErr != nil {
+		return result, fmt.Errorf("failed to create request: %v", groupErr)
+	}
+
+	var groupResponse, groupRespErr = c.execute(groupRequest, &result, http.StatusCreated)
+	if groupRespErr != nil {
+		return result, groupRespErr
+	}
+
+	if funk.NotEmpty(result.Errors) {
+		return result, fmt.Errorf("failed to create monitor group: %w", newAPIError(groupResponse, result.Errors))
+	}
+
+	result.Data.Attributes.ID = result.Data.ID
+
+	return result, nil
+}
+
+func (s MonitorGroupsService) Get(ctx context.Context, id string) (MonitorGroupResponse, error) {
+	var c = s.client
+	var result MonitorGroupResponse
+	var targetURL = c.endpoint(MonitorGroupID, id)
+
+	var groupRequest, groupErr = http.NewRequestWithContext(ctx, http.MethodGet, targetURL, nil)
+	if groupErr != nil {
+		return result, fmt.Errorf("failed to create request: %v", groupErr)
+	}
+
+	var groupResponse, groupRespErr = c.execute(groupRequest, &result)
+	if groupRespErr != nil {
+		return result, groupRespErr
+	}
+
+	if funk.NotEmpty(result.Errors) {
+		return result, fmt.Errorf("failed to get monitor group: %w", newAPIError(groupResponse, result.Errors))
+	}
+
+	result.Data.Attributes.ID = result.Data.ID
+
+	return result, nil
+}
+
+// Update replaces the attributes of the group. Name, paused and sort_index are always sent, so start from
+// the current group (GetMonitorGroup) when changing a single attribute.
+func (s MonitorGroupsService) Update(ctx context.Context, id string, group MonitorGroup) (MonitorGroupResponse, error) {
+	var c = s.client
+	var result MonitorGroupResponse
+	if c.readOnly {
+		return result, ErrReadOnlyClient
+	}
+
+	group.ID = Blanc
+	group.CreatedAt = nil
+	group.UpdatedAt = nil
+
+	var serializedBody, serErr = json.Marshal(group)
+	if serErr != nil {
+		return result, serErr
+	}
+
+	var targetURL = c.endpoint(MonitorGroupID, id)
+
+	if c.dryRun.enabled {
+		c.dryRun.record(http.MethodPatch, targetURL, serializedBody)
+		group.ID = id
+		result.Data.ID = id
+		result.Data.Type = "monitor_group"
+		result.Data.Attributes = group
+		return result, nil
+	}
+
+	var groupRequest, groupErr = http.NewRequestWithContext(ctx, http.MethodPatch, targetURL, bytes.NewReader(serializedBody))
+	if groupErr != nil {
+		return result, fmt.Errorf("failed to create request: %v", groupErr)
+	}
+
+	var groupResponse, groupRespErr = c.execute(groupRequest, &result, http.StatusOK)
+	if groupRespErr != nil {
+		return result, groupRespErr
+	}
+
+	if funk.NotEmpty(result.Errors) {
+		return result, fmt.Errorf("failed to update monitor group: %w", newAPIError(groupResponse, result.Errors))
+	}
+
+	result.Data.Attributes.ID = result.Data.ID
+
+	return result, nil
+}
+
+func (s MonitorGroupsService) Delete(ctx context.Context, id string) error {
+	var c = s.client
+	if c.readOnly {
+		return ErrReadOnlyClient
+	}
+
+	var guardErr = c.guardDelete(ctx, ResourceMonitorGroup, id, func() (any, error) {
+		var group, getErr = s.Get(ctx, id)
+		return group.Data.Attributes, getErr
+	})
+	if guardErr != nil {
+		return guardErr
+	}
+
+	return c.deleteGroup(ctx, id)
+}
+
+// deleteGroup deletes without consulting the delete guards
+func (c *BetterstackClient) deleteGroup(ctx context.Context, id string) error {
+	var targetURL = c.endpoint(MonitorGroupID, id)
+
+	if c.dryRun.enabled {
+		c.dryRun.record(http.MethodDelete, targetURL, nil)
+		return nil
+	}
+
+	var groupRequest, groupErr = http.NewRequestWithContext(ctx, http.MethodDelete, targetURL, nil)
+	if groupErr != nil {
+		return fmt.Errorf("failed to create request: %v", groupErr)
+	}
+
+	var _, groupRespErr = c.execute(groupRequest, nil, http.StatusNoContent)
+	return groupRespErr
+}
+
+// GroupPauseResult reports what PauseGroup and ResumeGroup changed
+type GroupPauseResult struct {
+	Group MonitorGroup
+
+	// Member monitors paused or resumed by the cascade
+	Affected []Monitor
+
+	// Member monitors already in the requested state
+	Unchanged []Monitor
+
+	// Member monitors the cascade failed on, by monitor ID
+	Failed map[string]error
+}
+
+// Pause pauses the group. The API applies the group flag to the monitors in the group at that time only,
+// and their own paused flag does not necessarily follow. With cascade every member monitor is paused explicitly,
+// so each one reports paused and Affected lists them.
+func (s MonitorGroupsService) Pause(ctx context.Context, id string, cascade bool) (GroupPauseResult, error) {
+	var c = s.client
+	return c.setGroupPaused(ctx, id, true, cascade)
+}
+
+// Resume resumes the group. With cascade every paused member monitor is resumed explicitly, including those
+// paused on their own before the group was.
+func (s MonitorGroupsService) Resume(ctx context.Context, id string, cascade bool) (GroupPauseResult, error) {
+	var c = s.client
+	return c.setGroupPaused(ctx, id, false, cascade)
+}
+
+func (c *BetterstackClient) setGroupPaused(ctx context.Context, id string, paused, cascade bool) (GroupPauseResult, error) {
+	var result = GroupPauseResult{Failed: map[string]error{}}
+
+	var current, getErr = c.MonitorGroups().Get(ctx, id)
+	if getErr != nil {
+		return result, fmt.Errorf("failed to get monitor group: %w", getErr)
+	}
+
+	var group = current.Data.Attributes
+	group.Paused = paused
+	var updated, updateErr = c.MonitorGroups().Update(ctx, id, group)
+	if updateErr != nil {
+		return result, fmt.Errorf("failed to update monitor group: %w", updateErr)
+	}
+	result.Group = updated.Data.Attributes
+
+	if !cascade {
+		return result, nil
+	}
+
+	var members, listErr = c.Monitors().List(ctx, InGroup(id))
+	if listErr != nil {
+		return result, fmt.Errorf("failed to list monitors of group: %w", listErr)
+	}
+	for _, monitor := range members {
+		if monitor.Paused == paused {
+			result.Unchanged = append(result.Unchanged, monitor)
+			continue
+		}
+		var changed, pauseErr = c.setMonitorPaused(ctx, monitor.ID, paused)
+		if pauseErr != nil {
+			result.Failed[monitor.ID] = pauseErr
+			continue
+		}
+		result.Affected = append(result.Affected, changed.Data.Attributes)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+const ResourceMonitor = "monitor"
+const ResourceMonitorGroup = "monitor_group"
+const ResourceMetadata = "metadata"
+
+var ErrDeleteVetoed = errors.New("delete vetoed")
+
+// DeleteTarget is the resource a delete is about to remove. Resource holds the Monitor or MonitorGroup as currently
+// stored; metadata records can't be fetched one by one, their Resource is nil.
+type DeleteTarget struct {
+	Type     string
+	ID       string
+	Resource any
+}
+
+// DeleteGuard is consulted before every delete, returning an error vetoes it. The delete then fails with
+// ErrDeleteVetoed wrapping the reason.
+type DeleteGuard func(ctx context.Context, target DeleteTarget) error
+
+// WithDeleteGuard registers a guard for every delete of the client, guards run in registration order and the first
+// veto wins. Guards also run in dry-run mode, so dry runs show which deletes would be refused.
+func WithDeleteGuard(guard DeleteGuard) Option {
+	return func(c *BetterstackClient) {
+		c.deleteGuards = append(c.deleteGuards, guard)
+	}
+}
+
+// ProtectTagged vetoes deleting monitors carrying any of the tags
+func ProtectTagged(tags *Tags, protected ...string) DeleteGuard {
+	return func(ctx context.Context, target DeleteTarget) error {
+		if target.Type != ResourceMonitor {
+			return nil
+		}
+		var monitorTags, tagsErr = tags.MonitorTags(ctx, target.ID)
+		if tagsErr != nil {
+			return fmt.Errorf("failed to get tags: %w", tagsErr)
+		}
+		for _, tag := range protected {
+			for _, monitorTag := range monitorTags {
+				if monitorTag == normalizeTag(tag) {
+					return fmt.Errorf("monitor is tagged %s", monitorTag)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// guardDelete runs the guards against the resource load returns. Failing to load the resource fails the delete,
+// a guard must never be skipped.
+func (c *BetterstackClient) guardDelete(ctx context.Context, resourceType, id string, load func() (any, error)) error {
+	if len(c.deleteGuards) == 0 {
+		return nil
+	}
+
+	var target = DeleteTarget{Type: resourceType, ID: id}
+	if load != nil {
+		var resource, loadErr = load()
+		if loadErr != nil {
+			return fmt.Errorf("failed to load %s %s for the delete guard: %w", resourceType, id, loadErr)
+		}
+		target.Resource = resource
+	}
+
+	for _, guard := range c.deleteGuards {
+		if vetoErr := guard(ctx, target); vetoErr != nil {
+			return fmt.Errorf("%w: %s %s: %v", ErrDeleteVetoed, resourceType, id, vetoErr)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package client
+
+import (
+	"net/http"
+	"sync"
+)
+
+// headerState holds the headers every request is sent with. Requests get their own copy, the token refresh
+// replaces Authorization while other requests may be in flight.
+type headerState struct {
+	mu     sync.RWMutex
+	values http.Header
+}
+
+// clone returns a copy for a single request, free to modify
+func (h *headerState) clone() http.Header {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.values.Clone()
+}
+
+func (h *headerState) get(name string) string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.values.Get(name)
+}
+
+func (h *headerState) set(name, value string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.values.Set(name, value)
+}
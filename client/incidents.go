@@ -0,0 +1,289 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	json "github.com/json-iterator/go"
+	"github.com/thoas/go-funk"
+)
+
+const IncidentDateFormat = "2006-01-02"
+
+// ListPage returns a page of incidents. Zero from and to leave the time range open.
+func (s IncidentsService) ListPage(ctx context.Context, page int, from, to time.Time) (IncidentsResponse, error) {
+	var c = s.client
+	var result IncidentsResponse
+
+	if page < 1 {
+		page = 1
+	}
+
+	params := url.Values{}
+	params.Add("per_page", "50")
+	params.Add("page", fmt.Sprintf("%d", page))
+	if !from.IsZero() {
+		params.Add("from", from.UTC().Format(IncidentDateFormat))
+	}
+	if !to.IsZero() {
+		params.Add("to", to.UTC().Format(IncidentDateFormat))
+	}
+
+	var targetURL = withQuery(c.endpoint(Incidents), params)
+
+	var incidentsRequest, incidentsErr = http.NewRequestWithContext(ctx, http.MethodGet, targetURL, nil)
+	if incidentsErr != nil {
+		return result, fmt.Errorf("failed to create request: %v", incidentsErr)
+	}
+
+	var incidentsResponse, incidentsRespErr = c.execute(incidentsRequest, &result)
+	if incidentsRespErr != nil {
+		return result, incidentsRespErr
+	}
+
+	if funk.NotEmpty(result.Errors) {
+		return result, fmt.Errorf("failed to list incidents: %w", newAPIError(incidentsResponse, result.Errors))
+	}
+
+	for i := range result.Data {
+		result.Data[i].Attributes.ID = result.Data[i].ID
+	}
+
+	return result, nil
+}
+
+// Pages iterates over the incidents in the time range, zero from and to leave it open
+func (s IncidentsService) Pages(from, to time.Time) *PageIterator[Incident] {
+	return s.client.incidentPages(incidentParams(from, to))
+}
+
+// ListForMonitor returns the incidents of one monitor in the time range, zero from and to leave it open.
+// The monitor filter is sent along and checked on every incident, so it holds even where the API ignores it.
+func (s IncidentsService) ListForMonitor(ctx context.Context, monitorID string, from, to time.Time) ([]Incident, error) {
+	var c = s.client
+	var result []Incident
+	var params = incidentParams(from, to)
+	params.Add("monitor_id", monitorID)
+
+	var pages = c.incidentPages(params)
+	for pages.Next(ctx) {
+		for _, incident := range pages.Page().Data {
+			if incident.Relationships["monitor"].Data.ID != monitorID {
+				continue
+			}
+			incident.Attributes.ID = incident.ID
+			result = append(result, incident.Attributes)
+		}
+	}
+
+	return result, pages.Err()
+}
+
+func incidentParams(from, to time.Time) url.Values {
+	params := url.Values{}
+	params.Add("per_page", "50")
+	params.Add("page", "1")
+	if !from.IsZero() {
+		params.Add("from", from.UTC().Format(IncidentDateFormat))
+	}
+	if !to.IsZero() {
+		params.Add("to", to.UTC().Format(IncidentDateFormat))
+	}
+	return params
+}
+
+func (c *BetterstackClient) incidentPages(params url.Values) *PageIterator[Incident] {
+	return newPageIterator[Incident](c, withQuery(c.endpoint(Incidents), params), nil)
+}
+
+func (s IncidentsService) Get(ctx context.Context, id string) (IncidentResponse, error) {
+	var c = s.client
+	var result IncidentResponse
+	var targetURL = c.endpoint(IncidentID, id)
+
+	var incidentRequest, incidentErr = http.NewRequestWithContext(ctx, http.MethodGet, targetURL, nil)
+	if incidentErr != nil {
+		return result, fmt.Errorf("failed to create request: %v", incidentErr)
+	}
+
+	var incidentResponse, incidentRespErr = c.execute(incidentRequest, &result)
+	if incidentRespErr != nil {
+		return result, incidentRespErr
+	}
+
+	if funk.NotEmpty(result.Errors) {
+		return result, fmt.Errorf("failed to get incident: %w", newAPIError(incidentResponse, result.Errors))
+	}
+
+	result.Data.Attributes.ID = result.Data.ID
+
+	return result, nil
+}
+
+// Create reports an incident manually, notifying through the channels enabled on it
+func (s IncidentsService) Create(ctx context.Context, incident NewIncident) (IncidentResponse, error) {
+	var c = s.client
+	var result IncidentResponse
+	if c.readOnly {
+		return result, ErrReadOnlyClient
+	}
+
+	var serializedBody, serErr = json.Marshal(incident)
+	if serErr != nil {
+		return result, serErr
+	}
+
+	if c.dryRun.enabled {
+		c.dryRun.record(http.MethodPost, c.endpoint(Incidents), serializedBody)
+		result.Data.Type = "incident"
+		result.Data.Attributes.Name = incident.Name
+		result.Data.Attributes.Call = incident.Call
+		result.Data.Attributes.SMS = incident.SMS
+		result.Data.Attributes.Email = incident.Email
+		result.Data.Attributes.Push = incident.Push
+		return result, nil
+	}
+
+	var incidentRequest, incidentErr = http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint(Incidents), bytes.NewReader(serializedBody))
+	if incidentErr != nil {
+		return result, fmt.Errorf("failed to create request: %v", incidentErr)
+	}
+
+	var incidentResponse, incidentRespErr = c.execute(incidentRequest, &result, http.StatusCreated, http.StatusOK)
+	if incidentRespErr != nil {
+		return result, incidentRespErr
+	}
+
+	if funk.NotEmpty(result.Errors) {
+		return result, fmt.Errorf("failed to create incident: %w", newAPIError(incidentResponse, result.Errors))
+	}
+
+	result.Data.Attributes.ID = result.Data.ID
+
+	return result, nil
+}
+
+// TestNotifications verifies the alerting of a monitor. The API has no test alert, so a manual incident is
+// created with the channels (call, SMS, email, push) and escalation policy of the monitor, which notifies the
+// same people the same way a real outage would. Resolve the returned incident once the alerts arrived.
+func (s IncidentsService) TestNotifications(ctx context.Context, monitorID, requesterEmail string) (IncidentResponse, error) {
+	var c = s.client
+	var monitor, monitorErr = c.Monitors().Get(ctx, monitorID)
+	if monitorErr != nil {
+		return IncidentResponse{}, fmt.Errorf("failed to get monitor: %w", monitorErr)
+	}
+
+	var attributes = monitor.Data.Attributes
+	return s.Create(ctx, NewIncident{
+		RequesterEmail: requesterEmail,
+		Name:           "Test: " + attributes.PronounceableName,
+		Summary:        fmt.Sprintf("Test of the notifications of monitor %s, no action needed", attributes.PronounceableName),
+		Description:    fmt.Sprintf("Triggered to verify on-call wiring of %s (%s).", attributes.PronounceableName, attributes.URL),
+		Call:           attributes.Call,
+		SMS:            attributes.SMS,
+		Email:          attributes.Email,
+		Push:           attributes.Push,
+		TeamWait:       attributes.TeamWait,
+		PolicyID:       attributes.PolicyID,
+	})
+}
+
+// Acknowledge acknowledges the incident on behalf of acknowledgedBy (user email or a free-form name)
+func (s IncidentsService) Acknowledge(ctx context.Context, id, acknowledgedBy string) (IncidentResponse, error) {
+	var c = s.client
+	return c.incidentAction(ctx, IncidentAcknowledge, id, map[string]string{"acknowledged_by": acknowledgedBy})
+}
+
+// Resolve resolves the incident on behalf of resolvedBy (user email or a free-form name)
+func (s IncidentsService) Resolve(ctx context.Context, id, resolvedBy string) (IncidentResponse, error) {
+	var c = s.client
+	return c.incidentAction(ctx, IncidentResolve, id, map[string]string{"resolved_by": resolvedBy})
+}
+
+// Comments returns the comments of the incident timeline, oldest first
+func (s IncidentsService) Comments(ctx context.Context, id string) ([]IncidentComment, error) {
+	var c = s.client
+	var result []IncidentComment
+	var pages = newPageIterator[IncidentComment](c, c.endpoint(IncidentComments, id), nil)
+
+	for pages.Next(ctx) {
+		for _, comment := range pages.Page().Data {
+			comment.Attributes.ID = comment.ID
+			result = append(result, comment.Attributes)
+		}
+	}
+
+	return result, pages.Err()
+}
+
+// Comment adds a comment to the incident timeline, content supports Markdown
+func (s IncidentsService) Comment(ctx context.Context, id, content string) error {
+	var c = s.client
+	if c.readOnly {
+		return ErrReadOnlyClient
+	}
+
+	var serializedBody, serErr = json.Marshal(map[string]string{"content": content})
+	if serErr != nil {
+		return serErr
+	}
+
+	var targetURL = c.endpoint(IncidentComments, id)
+
+	if c.dryRun.enabled {
+		c.dryRun.record(http.MethodPost, targetURL, serializedBody)
+		return nil
+	}
+
+	var commentRequest, commentErr = http.NewRequestWithContext(ctx, http.MethodPost, targetURL, bytes.NewReader(serializedBody))
+	if commentErr != nil {
+		return fmt.Errorf("failed to create request: %v", commentErr)
+	}
+
+	var _, commentRespErr = c.execute(commentRequest, nil, http.StatusCreated, http.StatusOK)
+	return commentRespErr
+}
+
+func (c *BetterstackClient) incidentAction(ctx context.Context, endpoint, id string, body map[string]string) (IncidentResponse, error) {
+	var result IncidentResponse
+	if c.readOnly {
+		return result, ErrReadOnlyClient
+	}
+
+	var serializedBody, serErr = json.Marshal(body)
+	if serErr != nil {
+		return result, serErr
+	}
+
+	var targetURL = c.endpoint(endpoint, id)
+
+	if c.dryRun.enabled {
+		c.dryRun.record(http.MethodPost, targetURL, serializedBody)
+		result.Data.ID = id
+		result.Data.Type = "incident"
+		result.Data.Attributes.ID = id
+		return result, nil
+	}
+
+	var actionRequest, actionErr = http.NewRequestWithContext(ctx, http.MethodPost, targetURL, bytes.NewReader(serializedBody))
+	if actionErr != nil {
+		return result, fmt.Errorf("failed to create request: %v", actionErr)
+	}
+
+	var actionResponse, actionRespErr = c.execute(actionRequest, &result, http.StatusOK)
+	if actionRespErr != nil {
+		return result, actionRespErr
+	}
+
+	if funk.NotEmpty(result.Errors) {
+		return result, fmt.Errorf("failed to update incident: %w", newAPIError(actionResponse, result.Errors))
+	}
+
+	result.Data.Attributes.ID = result.Data.ID
+
+	return result, nil
+}
@@ -0,0 +1,132 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/thoas/go-funk"
+)
+
+var ErrKeywordRequired = errors.New("monitor type requires required_keyword")
+var ErrKeywordUnsupported = errors.New("monitor type does not support required_keyword")
+
+// keywordPattern matches constructs which are common in regular expressions but rare in page content
+var keywordPattern = regexp.MustCompile(`\.\*|\.\+|\\[dwsbDWS]|^\^|\$$|\[[^\]]*-[^\]]*\]|\([^)]*\|[^)]*\)`)
+
+// UsesKeyword returns true for the monitor types checking required_keyword: keyword, keyword_absence and udp
+func UsesKeyword(monitorType string) bool {
+	switch monitorType {
+	case MonitorTypeKeyword, MonitorTypeKeywordAbsence, MonitorTypeUDP:
+		return true
+	}
+	return false
+}
+
+// LooksLikePattern returns true when the keyword looks like a regular expression. The API matches required_keyword
+// literally, so such a keyword is most likely a mistake.
+func LooksLikePattern(keyword string) bool {
+	return keywordPattern.MatchString(keyword)
+}
+
+// ValidateKeyword checks required_keyword against monitor_type: keyword types require it, other types must not
+// set it. Monitors without monitor_type are not checked. Create validates monitors before sending them, update
+// only rejects keywords on types which don't support them.
+func ValidateKeyword(monitor Monitor) error {
+	return validateKeyword(monitor, false)
+}
+
+func validateKeyword(monitor Monitor, partial bool) error {
+	if funk.IsEmpty(monitor.MonitorType) {
+		return nil
+	}
+	var keyword = funk.NotEmpty(monitor.RequiredKeyword)
+	if UsesKeyword(monitor.MonitorType) && !keyword && !partial {
+		return fmt.Errorf("%s: %w", monitor.MonitorType, ErrKeywordRequired)
+	}
+	if !UsesKeyword(monitor.MonitorType) && keyword {
+		return fmt.Errorf("%s: %w, use %s or %s", monitor.MonitorType, ErrKeywordUnsupported, MonitorTypeKeyword, MonitorTypeKeywordAbsence)
+	}
+	return nil
+}
+
+// KeywordExpectation describes the content expected on a page. The API checks a single literal keyword per
+// monitor, so an expectation of several keywords becomes one monitor per keyword; see Monitors.
+type KeywordExpectation struct {
+	// Keywords which must all be on the page
+	Present []string
+
+	// Keywords none of which may be on the page
+	Absent []string
+}
+
+// ExpectKeywords expects all keywords on the page
+func ExpectKeywords(keywords ...string) KeywordExpectation {
+	return KeywordExpectation{Present: keywords}
+}
+
+// ExpectNoKeywords expects none of the keywords on the page
+func ExpectNoKeywords(keywords ...string) KeywordExpectation {
+	return KeywordExpectation{Absent: keywords}
+}
+
+// And combines two expectations
+func (e KeywordExpectation) And(other KeywordExpectation) KeywordExpectation {
+	return KeywordExpectation{
+		Present: append(append([]string{}, e.Present...), other.Present...),
+		Absent:  append(append([]string{}, e.Absent...), other.Absent...),
+	}
+}
+
+// Monitors derives one keyword or keyword_absence monitor per keyword from base, named after base and the keyword.
+// Keywords which are empty, duplicated, expected both present and absent, or look like regular expressions are
+// rejected.
+func (e KeywordExpectation) Monitors(base Monitor) ([]Monitor, error) {
+	if len(e.Present)+len(e.Absent) == 0 {
+		return nil, errors.New("keyword expectation without keywords")
+	}
+
+	var seen = map[string]bool{}
+	var errs []error
+	var check = func(keyword string) {
+		switch {
+		case funk.IsEmpty(strings.TrimSpace(keyword)):
+			errs = append(errs, errors.New("empty keyword"))
+		case seen[keyword]:
+			errs = append(errs, fmt.Errorf("keyword %q is expected more than once", keyword))
+		case LooksLikePattern(keyword):
+			errs = append(errs, fmt.Errorf("keyword %q looks like a regular expression, the API matches keywords literally", keyword))
+		}
+		seen[keyword] = true
+	}
+	for _, keyword := range e.Present {
+		check(keyword)
+	}
+	for _, keyword := range e.Absent {
+		check(keyword)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	var result = make([]Monitor, 0, len(e.Present)+len(e.Absent))
+	var derive = func(monitorType, keyword, label string) {
+		var monitor = base
+		monitor.ID = Blanc
+		monitor.MonitorType = monitorType
+		monitor.RequiredKeyword = keyword
+		monitor.ExpectedStatusCodes = nil
+		if funk.NotEmpty(base.PronounceableName) {
+			monitor.PronounceableName = fmt.Sprintf("%s (%s %q)", base.PronounceableName, label, keyword)
+		}
+		result = append(result, monitor)
+	}
+	for _, keyword := range e.Present {
+		derive(MonitorTypeKeyword, keyword, "contains")
+	}
+	for _, keyword := range e.Absent {
+		derive(MonitorTypeKeywordAbsence, keyword, "lacks")
+	}
+	return result, nil
+}
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"fmt"
+	"log/slog"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Logger receives the logs of the client: requests at debug level, retries at info, degraded operation like an
+// open circuit breaker at warn. Arguments are alternating keys and values. *slog.Logger implements it, other
+// loggers need a small adapter, e.g. around the Debugw family of zap's SugaredLogger.
+type Logger interface {
+	Debug(msg string, args ...any)
+	Info(msg string, args ...any)
+	Warn(msg string, args ...any)
+	Error(msg string, args ...any)
+}
+
+// WithLogger routes the logs of the client to logger instead of logrus. Warnings of options applied before it
+// still go to logrus, pass it first.
+func WithLogger(logger Logger) Option {
+	return func(c *BetterstackClient) {
+		if logger == nil {
+			c.logger.Warn("ignoring nil logger")
+			return
+		}
+		c.logger = logger
+	}
+}
+
+// logrusLogger is the default Logger, keys and values become logrus fields
+type logrusLogger struct{}
+
+func (logrusLogger) Debug(msg string, args ...any) { log.WithFields(logFields(args)).Debug(msg) }
+func (logrusLogger) Info(msg string, args ...any)  { log.WithFields(logFields(args)).Info(msg) }
+func (logrusLogger) Warn(msg string, args ...any)  { log.WithFields(logFields(args)).Warn(msg) }
+func (logrusLogger) Error(msg string, args ...any) { log.WithFields(logFields(args)).Error(msg) }
+
+// logFields pairs the arguments up, as slog does: slog.Attr values stand for themselves, a key without value is
+// kept under !BADKEY
+func logFields(args []any) log.Fields {
+	var fields = log.Fields{}
+	for len(args) > 0 {
+		switch key := args[0].(type) {
+		case slog.Attr:
+			fields[key.Key] = key.Value.Any()
+			args = args[1:]
+		case string:
+			if len(args) == 1 {
+				fields["!BADKEY"] = key
+				return fields
+			}
+			fields[key] = args[1]
+			args = args[2:]
+		default:
+			fields["!BADKEY"] = fmt.Sprint(key)
+			args = args[1:]
+		}
+	}
+	return fields
+}
+
+// Logger returns the logger of the client, for packages built on the client to log alongside it
+func (c *BetterstackClient) Logger() Logger {
+	return c.logger
+}
+
+// LoggerOrDefault returns logger, the logrus adapter when nil
+func LoggerOrDefault(logger Logger) Logger {
+	if logger == nil {
+		return logrusLogger{}
+	}
+	return logger
+}
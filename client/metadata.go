@@ -0,0 +1,105 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	json "github.com/json-iterator/go"
+	"github.com/thoas/go-funk"
+)
+
+// List returns the metadata records of one owner. An empty ownerID lists the records of every owner of
+// the type, empty ownerType and ownerID list all records.
+func (s MetadataService) List(ctx context.Context, ownerType, ownerID string) ([]MetadataRecord, error) {
+	var c = s.client
+	var result []MetadataRecord
+
+	params := url.Values{}
+	params.Add("per_page", "250")
+	if funk.NotEmpty(ownerType) {
+		params.Add("owner_type", ownerType)
+	}
+	if funk.NotEmpty(ownerID) {
+		params.Add("owner_id", ownerID)
+	}
+
+	var pages = newPageIterator[MetadataRecord](c, withQuery(c.endpoint(Metadata), params), nil)
+	for pages.Next(ctx) {
+		for _, record := range pages.Page().Data {
+			record.Attributes.ID = record.ID
+			result = append(result, record.Attributes)
+		}
+	}
+
+	return result, pages.Err()
+}
+
+// Upsert creates the record, or updates the value of the record with the same key and owner
+func (s MetadataService) Upsert(ctx context.Context, record MetadataRecord) (MetadataResponse, error) {
+	var c = s.client
+	var result MetadataResponse
+	if c.readOnly {
+		return result, ErrReadOnlyClient
+	}
+
+	record.ID = Blanc
+	record.TeamName = Blanc
+	var serializedBody, serErr = json.Marshal(record)
+	if serErr != nil {
+		return result, serErr
+	}
+
+	if c.dryRun.enabled {
+		c.dryRun.record(http.MethodPost, c.endpoint(Metadata), serializedBody)
+		result.Data.Type = "metadata"
+		result.Data.Attributes = record
+		return result, nil
+	}
+
+	var metadataRequest, metadataErr = http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint(Metadata), bytes.NewReader(serializedBody))
+	if metadataErr != nil {
+		return result, fmt.Errorf("failed to create request: %v", metadataErr)
+	}
+
+	var metadataResponse, metadataRespErr = c.execute(metadataRequest, &result, http.StatusCreated, http.StatusOK)
+	if metadataRespErr != nil {
+		return result, metadataRespErr
+	}
+
+	if funk.NotEmpty(result.Errors) {
+		return result, fmt.Errorf("failed to upsert metadata: %w", newAPIError(metadataResponse, result.Errors))
+	}
+
+	result.Data.Attributes.ID = result.Data.ID
+
+	return result, nil
+}
+
+func (s MetadataService) Delete(ctx context.Context, id string) error {
+	var c = s.client
+	if c.readOnly {
+		return ErrReadOnlyClient
+	}
+
+	if guardErr := c.guardDelete(ctx, ResourceMetadata, id, nil); guardErr != nil {
+		return guardErr
+	}
+
+	var targetURL = c.endpoint(MetadataID, id)
+
+	if c.dryRun.enabled {
+		c.dryRun.record(http.MethodDelete, targetURL, nil)
+		return nil
+	}
+
+	var metadataRequest, metadataErr = http.NewRequestWithContext(ctx, http.MethodDelete, targetURL, nil)
+	if metadataErr != nil {
+		return fmt.Errorf("failed to create request: %v", metadataErr)
+	}
+
+	var _, metadataRespErr = c.execute(metadataRequest, nil, http.StatusNoContent, http.StatusOK)
+	return metadataRespErr
+}
@@ -1,11 +1,13 @@
 package client
 
 import (
+	"bytes"
 	"fmt"
 	"net/url"
 	"strconv"
 	"time"
 
+	json "github.com/json-iterator/go"
 	"github.com/thoas/go-funk"
 )
 
@@ -120,8 +122,12 @@ type Monitor struct {
 	RequestTimeout int `json:"request_timeout,omitempty"`
 
 	// Request body for POST, PUT, PATCH requests. Required if monitor_type is set to dns
-	// (domain to query the DNS server with).
-	RequestMethod string `json:"request_method,omitempty"`
+	// (domain to query the DNS server with). Set the Content-Type in RequestHeaders, see SetJSONBody.
+	RequestBody string `json:"request_body,omitempty"`
+
+	// Deprecated: the field was sent as request_method, which the API ignores. Create and Update send it as
+	// RequestBody when that is blank, use RequestBody.
+	RequestMethod string `json:"-"`
 
 	// Basic HTTP authentication username to include with the request.
 	AuthUsername string `json:"auth_username,omitempty"`
@@ -154,11 +160,43 @@ type Monitor struct {
 
 	// Status represents the current status of the monitor, indicating its operational state or health.
 	Status string `json:"status,omitempty"`
+
+	// Set by the API, do not use on creation or update
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// GroupID returns MonitorGroupID as a string, empty when the monitor is not in a group
+func (m Monitor) GroupID() string {
+	return StringID(m.MonitorGroupID)
+}
+
+// Monitor SLA
+
+type MonitorSLA struct {
+	// Do not use on creation
+	ID string `json:"id,omitempty"`
+
+	// Availability in percent over the requested period
+	Availability float64 `json:"availability"`
+
+	// In seconds
+	TotalDowntime int `json:"total_downtime"`
+
+	NumberOfIncidents int `json:"number_of_incidents"`
+
+	// In seconds
+	LongestIncident int `json:"longest_incident"`
+
+	// In seconds
+	AverageIncident int `json:"average_incident"`
 }
 
 // Monitor Groups
 
 type MonitorGroup struct {
+	// Do not use on creation
+	ID        string     `json:"id,omitempty"`
 	Name      string     `json:"name"`
 	TeamName  string     `json:"team_name"`
 	SortIndex int        `json:"sort_index"`
@@ -167,31 +205,294 @@ type MonitorGroup struct {
 	Paused    bool       `json:"paused"`
 }
 
+// Monitor Response Times
+
+type MonitorResponseTimes struct {
+	// Do not use on creation
+	ID string `json:"id,omitempty"`
+
+	Regions []RegionResponseTimes `json:"regions"`
+}
+
+type RegionResponseTimes struct {
+	Region        string         `json:"region"`
+	ResponseTimes []ResponseTime `json:"response_times"`
+}
+
+type ResponseTime struct {
+	At time.Time `json:"at"`
+
+	// In seconds
+	ResponseTime float64 `json:"response_time"`
+}
+
+// Latest returns the most recent response time of the region, false when the region has no data
+func (r RegionResponseTimes) Latest() (ResponseTime, bool) {
+	var latest ResponseTime
+	for _, responseTime := range r.ResponseTimes {
+		if responseTime.At.After(latest.At) {
+			latest = responseTime
+		}
+	}
+	return latest, !latest.At.IsZero()
+}
+
+// Incidents
+
+type Incident struct {
+	// Do not use on creation
+	ID string `json:"id,omitempty"`
+
+	// The name of the incident, usually the name of the affected monitor
+	Name string `json:"name"`
+
+	// The URL of the checked website or host
+	URL string `json:"url,omitempty"`
+
+	// HTTP method used by the check
+	HTTPMethod string `json:"http_method,omitempty"`
+
+	// What caused the incident, e.g. "Status 500"
+	Cause string `json:"cause,omitempty"`
+
+	IncidentGroupID any `json:"incident_group_id,omitempty"`
+
+	StartedAt      *time.Time `json:"started_at,omitempty"`
+	AcknowledgedAt *time.Time `json:"acknowledged_at,omitempty"`
+	AcknowledgedBy string     `json:"acknowledged_by,omitempty"`
+	ResolvedAt     *time.Time `json:"resolved_at,omitempty"`
+	ResolvedBy     string     `json:"resolved_by,omitempty"`
+
+	// Valid values: Started, Acknowledged, Resolved
+	Status string `json:"status,omitempty"`
+
+	TeamName        string   `json:"team_name,omitempty"`
+	ResponseContent string   `json:"response_content,omitempty"`
+	ResponseOptions string   `json:"response_options,omitempty"`
+	Regions         []string `json:"regions,omitempty"`
+	ResponseURL     string   `json:"response_url,omitempty"`
+	ScreenshotURL   string   `json:"screenshot_url,omitempty"`
+	OriginURL       string   `json:"origin_url,omitempty"`
+
+	EscalationPolicyID any `json:"escalation_policy_id,omitempty"`
+
+	Call  bool `json:"call"`
+	SMS   bool `json:"sms"`
+	Email bool `json:"email"`
+	Push  bool `json:"push"`
+}
+
+// NewIncident is the payload of a manually created incident
+type NewIncident struct {
+	// Email of the user reporting the incident, must belong to the team
+	RequesterEmail string `json:"requester_email"`
+
+	Name        string `json:"name,omitempty"`
+	Summary     string `json:"summary"`
+	Description string `json:"description,omitempty"`
+
+	Call  bool `json:"call"`
+	SMS   bool `json:"sms"`
+	Email bool `json:"email"`
+	Push  bool `json:"push"`
+
+	// Seconds before escalating to the entire team
+	TeamWait int `json:"team_wait,omitempty"`
+
+	// Escalation policy notified instead of the current on-call person
+	PolicyID string `json:"policy_id,omitempty"`
+}
+
+type IncidentComment struct {
+	ID string `json:"id,omitempty"`
+
+	// Markdown
+	Content string `json:"content"`
+
+	UserID    any        `json:"user_id,omitempty"`
+	UserEmail string     `json:"user_email,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// On-call Calendars
+
+type OnCallCalendar struct {
+	// Do not use on creation
+	ID string `json:"id,omitempty"`
+
+	Name string `json:"name"`
+
+	// Whether this is the default calendar of the team, used when a policy step does not name a calendar
+	DefaultCalendar bool `json:"default_calendar"`
+
+	// Users currently on call, resolved from the on_call_users relationship
+	OnCallUsers []User `json:"-"`
+}
+
+type User struct {
+	ID           string   `json:"id,omitempty"`
+	FirstName    string   `json:"first_name"`
+	LastName     string   `json:"last_name"`
+	Email        string   `json:"email"`
+	PhoneNumbers []string `json:"phone_numbers,omitempty"`
+}
+
+// Escalation Policies
+
+type Policy struct {
+	// Do not use on creation
+	ID string `json:"id,omitempty"`
+
+	// The name of the escalation policy
+	Name string `json:"name"`
+
+	// How many times the policy should be repeated if nobody acknowledges the incident
+	RepeatCount int `json:"repeat_count,omitempty"`
+
+	// How long to wait before repeating the policy. In seconds.
+	RepeatDelay int `json:"repeat_delay,omitempty"`
+
+	// Incident token used to trigger incidents via the policy
+	IncidentToken string `json:"incident_token,omitempty"`
+
+	// Set this attribute if the policy belongs to a policy group
+	PolicyGroupID any `json:"policy_group_id,omitempty"`
+
+	// Required if using global API token to specify the team which should own the resource
+	TeamName string `json:"team_name,omitempty"`
+
+	// Ordered steps of the policy
+	Steps []PolicyStep `json:"steps,omitempty"`
+}
+
+type PolicyStep struct {
+	// Valid values: escalation, time_branching, metadata_branching
+	Type string `json:"type"`
+
+	// How long to wait before executing this step since previous step. In seconds.
+	WaitBefore int `json:"wait_before"`
+
+	// Urgency of the step, used when the step type is escalation
+	UrgencyID any `json:"urgency_id,omitempty"`
+
+	// Who should be notified in the escalation step
+	StepMembers []PolicyStepMember `json:"step_members,omitempty"`
+}
+
+type PolicyStepMember struct {
+	// Valid values: user, team, current_on_call, entire_team, all_slack_integrations, all_microsoft_teams_integrations,
+	// all_zapier_integrations, all_webhook_integrations, all_splunk_on_call_integrations
+	Type string `json:"type"`
+
+	// ID of the user, team or on-call calendar, for member types which require it
+	ID any `json:"id,omitempty"`
+}
+
 // REST Models
 
 type MonitorResponse ResponseWrapper[Monitor]
 type MonitorsResponse ListWrapper[Monitor]
 type MonitorGroupResponse ResponseWrapper[MonitorGroup]
 type MonitorGroupsResponse ListWrapper[MonitorGroup]
+type PolicyResponse ResponseWrapper[Policy]
+type PoliciesResponse ListWrapper[Policy]
+type MonitorResponseTimesResponse ResponseWrapper[MonitorResponseTimes]
+type MonitorSLAResponse ResponseWrapper[MonitorSLA]
+type IncidentResponse ResponseWrapper[Incident]
+type IncidentsResponse ListWrapper[Incident]
+type IncidentCommentsResponse ListWrapper[IncidentComment]
+type OnCallCalendarsResponse ListWrapper[OnCallCalendar]
+type MetadataResponse ResponseWrapper[MetadataRecord]
+type MetadataListResponse ListWrapper[MetadataRecord]
+
+// Metadata
+
+// MetadataRecord is a key-value pair attached to a resource
+type MetadataRecord struct {
+	ID    string `json:"id,omitempty"`
+	Key   string `json:"key"`
+	Value string `json:"value"`
+
+	OwnerID any `json:"owner_id"`
+
+	// Valid values: Monitor, Heartbeat, Incident, WebhookIntegration, EmailIntegration, IncomingWebhook, CallRouting
+	OwnerType string `json:"owner_type"`
+
+	TeamName string `json:"team_name,omitempty"`
+}
 
 // Commons
 
-type ResponseWrapper[T Monitor | MonitorGroup] struct {
+// Entity lists every resource the generic REST wrappers can carry
+type Entity interface {
+	Monitor | MonitorGroup | Policy | MonitorResponseTimes | MonitorSLA | Incident | IncidentComment | OnCallCalendar | MetadataRecord
+}
+
+type ResponseWrapper[T Entity] struct {
 	Data       EntityWrapper[T] `json:"data,omitempty"`
 	Errors     any              `json:"errors,omitempty"`
 	Pagination Pagination       `json:"pagination,omitempty"`
 }
 
-type ListWrapper[T Monitor | MonitorGroup] struct {
+type ListWrapper[T Entity] struct {
 	Data       []EntityWrapper[T] `json:"data,omitempty"`
+	Included   []IncludedEntity   `json:"included,omitempty"`
 	Errors     any                `json:"errors,omitempty"`
 	Pagination Pagination         `json:"pagination,omitempty"`
+
+	// Client the page was fetched with, follows the pagination links
+	client *BetterstackClient
 }
 
-type EntityWrapper[T Monitor | MonitorGroup] struct {
-	ID         string `json:"id,omitempty"`
-	Type       string `json:"type,omitempty"`
-	Attributes T      `json:"attributes,omitempty"`
+type EntityWrapper[T Entity] struct {
+	ID            string                  `json:"id,omitempty"`
+	Type          string                  `json:"type,omitempty"`
+	Attributes    T                       `json:"attributes,omitempty"`
+	Relationships map[string]Relationship `json:"relationships,omitempty"`
+}
+
+// Relationship of a JSON:API entity. To-one relationships are decoded into Data, to-many into Many.
+type Relationship struct {
+	Data RelationshipData   `json:"-"`
+	Many []RelationshipData `json:"-"`
+}
+
+func (r *Relationship) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if unmErr := json.Unmarshal(data, &raw); unmErr != nil {
+		return unmErr
+	}
+
+	var trimmed = bytes.TrimSpace(raw.Data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil
+	}
+	if trimmed[0] == '[' {
+		return json.Unmarshal(trimmed, &r.Many)
+	}
+	return json.Unmarshal(trimmed, &r.Data)
+}
+
+func (r Relationship) MarshalJSON() ([]byte, error) {
+	if r.Many != nil {
+		return json.Marshal(map[string]any{"data": r.Many})
+	}
+	return json.Marshal(map[string]any{"data": r.Data})
+}
+
+// IncludedEntity is a related entity side-loaded into the included section of a response
+type IncludedEntity struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Attributes json.RawMessage `json:"attributes"`
+}
+
+type RelationshipData struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
 }
 
 type Pagination struct {
@@ -209,6 +510,22 @@ func (p *Pagination) HasPrevious() bool {
 	return funk.NotEmpty(p.Previous)
 }
 
+// StringID normalizes an ID attribute to a string. The API reports IDs of related resources (monitor groups,
+// policies, calendars) as numbers while entity IDs are strings everywhere else.
+func StringID(id any) string {
+	switch typed := id.(type) {
+	case nil:
+		return Blanc
+	case string:
+		return typed
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(typed)
+	}
+	return fmt.Sprintf("%v", id)
+}
+
 // Useful when you need to iterate over all pages collecting entities
 
 func (p *Pagination) GetLastPage() (int, error) {
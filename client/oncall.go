@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	json "github.com/json-iterator/go"
+	"github.com/thoas/go-funk"
+)
+
+func (s OnCallService) ListPage(ctx context.Context, page int) (OnCallCalendarsResponse, error) {
+	var c = s.client
+	var result OnCallCalendarsResponse
+
+	if page < 1 {
+		page = 1
+	}
+
+	params := url.Values{}
+	params.Add("page", fmt.Sprintf("%d", page))
+
+	var targetURL = withQuery(c.endpoint(OnCalls), params)
+
+	var onCallsRequest, onCallsErr = http.NewRequestWithContext(ctx, http.MethodGet, targetURL, nil)
+	if onCallsErr != nil {
+		return result, fmt.Errorf("failed to create request: %v", onCallsErr)
+	}
+
+	var onCallsResponse, onCallsRespErr = c.execute(onCallsRequest, &result)
+	if onCallsRespErr != nil {
+		return result, onCallsRespErr
+	}
+
+	if funk.NotEmpty(result.Errors) {
+		return result, fmt.Errorf("failed to list on-call calendars: %w", newAPIError(onCallsResponse, result.Errors))
+	}
+
+	if resolveErr := resolveOnCallUsers((*ListWrapper[OnCallCalendar])(&result)); resolveErr != nil {
+		return result, resolveErr
+	}
+
+	return result, nil
+}
+
+func (s OnCallService) List(ctx context.Context) ([]OnCallCalendar, error) {
+	var result []OnCallCalendar
+	var pages = s.Pages()
+
+	for pages.Next(ctx) {
+		for _, calendar := range pages.Page().Data {
+			result = append(result, calendar.Attributes)
+		}
+	}
+
+	return result, pages.Err()
+}
+
+// Pages iterates over all on-call calendars, with on-call users resolved
+func (s OnCallService) Pages() *PageIterator[OnCallCalendar] {
+	return newPageIterator(s.client, withQuery(s.client.endpoint(OnCalls), url.Values{"page": {"1"}}), resolveOnCallUsers)
+}
+
+// resolveOnCallUsers fills OnCallUsers of the calendars from the users included in the page
+func resolveOnCallUsers(result *ListWrapper[OnCallCalendar]) error {
+	var users = map[string]User{}
+	for _, included := range result.Included {
+		if included.Type != "user" {
+			continue
+		}
+		var user User
+		if userErr := json.Unmarshal(included.Attributes, &user); userErr != nil {
+			return fmt.Errorf("failed to unmarshal user %s: %v", included.ID, userErr)
+		}
+		user.ID = included.ID
+		users[included.ID] = user
+	}
+
+	for i := range result.Data {
+		var calendar = &result.Data[i]
+		calendar.Attributes.ID = calendar.ID
+		for _, member := range calendar.Relationships["on_call_users"].Many {
+			var user, found = users[member.ID]
+			if !found {
+				user = User{ID: member.ID}
+			}
+			calendar.Attributes.OnCallUsers = append(calendar.Attributes.OnCallUsers, user)
+		}
+	}
+
+	return nil
+}
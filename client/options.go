@@ -0,0 +1,50 @@
+package client
+
+import "net/http"
+
+// Option configures optional behaviour of the BetterstackClient. Options are applied in order by NewClient,
+// NewClientFromENV and NewClientFromSettings.
+type Option func(c *BetterstackClient)
+
+// WithDryRun makes every mutating method (create, update, delete) log and record the request it would have sent
+// and return a synthesized result instead of calling the API. Recorded requests are available via DryRunRequests.
+func WithDryRun() Option {
+	return func(c *BetterstackClient) {
+		c.dryRun.enabled = true
+	}
+}
+
+// WithReadOnly makes every mutating method return ErrReadOnlyClient without sending anything, regardless of the
+// scopes of the API token. Takes precedence over WithDryRun.
+func WithReadOnly() Option {
+	return func(c *BetterstackClient) {
+		c.readOnly = true
+	}
+}
+
+// WithTokenRefresh registers a callback returning a fresh API token. When a request is answered with 401 the
+// callback is invoked and the request is retried once with the new token, so long-running processes survive token
+// rotation.
+func WithTokenRefresh(refresh func() (string, error)) Option {
+	return func(c *BetterstackClient) {
+		c.refreshToken = refresh
+	}
+}
+
+// WithHTTPClient makes the client send requests through the given http.Client instead of http.DefaultClient
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *BetterstackClient) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithTransportOptions sends requests through a dedicated transport tuned by opts, see DefaultTransportOptions for
+// settings suited to bulk workloads
+func WithTransportOptions(opts TransportOptions) Option {
+	return func(c *BetterstackClient) {
+		c.httpClient = &http.Client{
+			Transport: NewTransport(opts),
+			Timeout:   opts.Timeout,
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/thoas/go-funk"
+)
+
+var ErrNoPage = errors.New("no such page")
+
+// NextPage fetches the page the pagination links to as next. It fails with ErrNoPage on the last page.
+func (r MonitorsResponse) NextPage(ctx context.Context) (MonitorsResponse, error) {
+	var page, pageErr = followPage[Monitor](ctx, r.client, r.Pagination.Next)
+	return MonitorsResponse(page), pageErr
+}
+
+// PrevPage fetches the page the pagination links to as previous. It fails with ErrNoPage on the first page.
+func (r MonitorsResponse) PrevPage(ctx context.Context) (MonitorsResponse, error) {
+	var page, pageErr = followPage[Monitor](ctx, r.client, r.Pagination.Previous)
+	return MonitorsResponse(page), pageErr
+}
+
+func (r MonitorGroupsResponse) NextPage(ctx context.Context) (MonitorGroupsResponse, error) {
+	var page, pageErr = followPage[MonitorGroup](ctx, r.client, r.Pagination.Next)
+	return MonitorGroupsResponse(page), pageErr
+}
+
+func (r MonitorGroupsResponse) PrevPage(ctx context.Context) (MonitorGroupsResponse, error) {
+	var page, pageErr = followPage[MonitorGroup](ctx, r.client, r.Pagination.Previous)
+	return MonitorGroupsResponse(page), pageErr
+}
+
+// followPage fetches a raw pagination URL. Only responses returned by the client can follow their links.
+func followPage[T Entity](ctx context.Context, c *BetterstackClient, link string) (ListWrapper[T], error) {
+	if funk.IsEmpty(link) {
+		return ListWrapper[T]{}, ErrNoPage
+	}
+	if c == nil {
+		return ListWrapper[T]{}, errors.New("response was not fetched by a client, pages can't be followed")
+	}
+	return fetchPage[T](ctx, c, link)
+}
+
+func fetchPage[T Entity](ctx context.Context, c *BetterstackClient, link string) (ListWrapper[T], error) {
+	var result ListWrapper[T]
+
+	var pageRequest, pageErr = http.NewRequestWithContext(ctx, http.MethodGet, c.rebase(link), nil)
+	if pageErr != nil {
+		return result, fmt.Errorf("failed to create request: %v", pageErr)
+	}
+
+	var pageResponse, pageRespErr = c.execute(pageRequest, &result)
+	if pageRespErr != nil {
+		return result, pageRespErr
+	}
+
+	if funk.NotEmpty(result.Errors) {
+		return result, fmt.Errorf("failed to fetch page: %w", newAPIError(pageResponse, result.Errors))
+	}
+
+	result.client = c
+
+	return result, nil
+}
+
+// Cursor locates the page following the one it came with. Pagination, the links the API serves, implements it;
+// should the API move to another scheme only a new implementation is needed, iterators and the ListAll helpers
+// stay unchanged.
+type Cursor interface {
+	NextURL(current *url.URL) (string, bool)
+}
+
+// NextURL resolves the next link against the URL of the current page, so relative links and links carrying a
+// cursor instead of a page number work as well as absolute page links
+func (p Pagination) NextURL(current *url.URL) (string, bool) {
+	if funk.IsEmpty(p.Next) {
+		return Blanc, false
+	}
+	var next, parseErr = url.Parse(p.Next)
+	if parseErr != nil {
+		return Blanc, false
+	}
+	if current != nil {
+		next = current.ResolveReference(next)
+	}
+	return next.String(), true
+}
+
+// PageIterator walks a list page by page following the cursor of each page:
+//
+//	var pages = s.Pages()
+//	for pages.Next(ctx) {
+//		... pages.Page().Data
+//	}
+//	if pages.Err() != nil {...}
+type PageIterator[T Entity] struct {
+	client  *BetterstackClient
+	next    string
+	page    ListWrapper[T]
+	err     error
+	visited map[string]bool
+	prepare func(page *ListWrapper[T]) error
+}
+
+func newPageIterator[T Entity](c *BetterstackClient, first string, prepare func(page *ListWrapper[T]) error) *PageIterator[T] {
+	return &PageIterator[T]{client: c, next: first, visited: map[string]bool{}, prepare: prepare}
+}
+
+// Next fetches the next page, it returns false after the last page or on error
+func (it *PageIterator[T]) Next(ctx context.Context) bool {
+	if it.err != nil || funk.IsEmpty(it.next) {
+		return false
+	}
+
+	var current, parseErr = url.Parse(it.next)
+	if parseErr != nil {
+		it.err = fmt.Errorf("invalid page URL: %v", parseErr)
+		return false
+	}
+	it.visited[it.next] = true
+
+	var page, pageErr = fetchPage[T](ctx, it.client, it.next)
+	if pageErr != nil {
+		it.err = pageErr
+		return false
+	}
+	if it.prepare != nil {
+		if prepareErr := it.prepare(&page); prepareErr != nil {
+			it.err = prepareErr
+			return false
+		}
+	}
+	it.page = page
+
+	var cursor Cursor = page.Pagination
+	var next, found = cursor.NextURL(current)
+	// A link back to a visited page would loop forever
+	if !found || it.visited[next] {
+		next = Blanc
+	}
+	it.next = next
+
+	return true
+}
+
+func (it *PageIterator[T]) Page() ListWrapper[T] {
+	return it.page
+}
+
+func (it *PageIterator[T]) Err() error {
+	return it.err
+}
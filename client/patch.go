@@ -0,0 +1,90 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	json "github.com/json-iterator/go"
+	"github.com/thoas/go-funk"
+)
+
+// Patch sends exactly the given attributes, keyed by their JSON names, zero values included. Unlike Update it can
+// set false and 0, e.g. verify_ssl=false, or clear monitor_group_id with nil. The attributes are not validated.
+func (s MonitorsService) Patch(ctx context.Context, id string, attributes map[string]any) (MonitorResponse, error) {
+	var c = s.client
+	var result MonitorResponse
+	if c.readOnly {
+		return result, ErrReadOnlyClient
+	}
+	if len(attributes) == 0 {
+		return result, ErrEmptyUpdate
+	}
+
+	var serializedBody, serErr = json.Marshal(attributes)
+	if serErr != nil {
+		return result, serErr
+	}
+
+	var targetURL = c.endpoint(MonitorID, id)
+
+	if c.dryRun.enabled {
+		c.dryRun.record(http.MethodPatch, targetURL, serializedBody)
+		_ = json.Unmarshal(serializedBody, &result.Data.Attributes)
+		result.Data.ID = id
+		result.Data.Type = "monitor"
+		result.Data.Attributes.ID = id
+		return result, nil
+	}
+
+	var monitorRequest, monErr = http.NewRequestWithContext(ctx, http.MethodPatch, targetURL, bytes.NewReader(serializedBody))
+	if monErr != nil {
+		return result, fmt.Errorf("failed to create request: %v", monErr)
+	}
+
+	var monitorResponse, monRespErr = c.execute(monitorRequest, &result, http.StatusOK)
+	if monRespErr != nil {
+		return result, monRespErr
+	}
+
+	if funk.NotEmpty(result.Errors) {
+		return result, fmt.Errorf("failed to update monitor: %w", newAPIError(monitorResponse, result.Errors))
+	}
+
+	result.Data.Attributes.ID = result.Data.ID
+	return result, nil
+}
+
+// Attributes returns every attribute of the monitor by its JSON name, zero values included, e.g. to build a Patch
+func (m Monitor) Attributes() map[string]any {
+	var result = map[string]any{}
+	var value = reflect.ValueOf(m)
+	for i := 0; i < value.NumField(); i++ {
+		var name, _, _ = strings.Cut(value.Type().Field(i).Tag.Get("json"), ",")
+		if name == Blanc || name == "-" {
+			continue
+		}
+		result[name] = value.Field(i).Interface()
+	}
+	return result
+}
+
+// SameAttribute reports whether two attribute values are equal as the API sees them: zero, nil and empty values
+// are all the same, IDs match whether they are numbers or strings
+func SameAttribute(a, b any) bool {
+	if funk.IsEmpty(a) && funk.IsEmpty(b) {
+		return true
+	}
+	if funk.IsEmpty(a) || funk.IsEmpty(b) {
+		return false
+	}
+	var serializedA, errA = json.Marshal(a)
+	var serializedB, errB = json.Marshal(b)
+	if errA == nil && errB == nil && bytes.Equal(serializedA, serializedB) {
+		return true
+	}
+	return strings.Trim(string(serializedA), `"`) == strings.Trim(string(serializedB), `"`)
+}
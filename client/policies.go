@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/thoas/go-funk"
+)
+
+func (s PoliciesService) ListPage(ctx context.Context, page int) (PoliciesResponse, error) {
+	var c = s.client
+	var result PoliciesResponse
+
+	if page < 1 {
+		page = 1
+	}
+
+	params := url.Values{}
+	params.Add("per_page", "250")
+	params.Add("page", fmt.Sprintf("%d", page))
+
+	var targetURL = withQuery(c.endpoint(Policies), params)
+
+	var policiesRequest, policiesErr = http.NewRequestWithContext(ctx, http.MethodGet, targetURL, nil)
+	if policiesErr != nil {
+		return result, fmt.Errorf("failed to create request: %v", policiesErr)
+	}
+
+	var policiesResponse, policiesRespErr = c.execute(policiesRequest, &result)
+	if policiesRespErr != nil {
+		return result, policiesRespErr
+	}
+
+	if funk.NotEmpty(result.Errors) {
+		return result, fmt.Errorf("failed to list policies: %w", newAPIError(policiesResponse, result.Errors))
+	}
+
+	return result, nil
+}
+
+func (s PoliciesService) List(ctx context.Context) ([]Policy, error) {
+	var result []Policy
+	var pages = s.Pages()
+
+	for pages.Next(ctx) {
+		for _, policy := range pages.Page().Data {
+			policy.Attributes.ID = policy.ID
+			result = append(result, policy.Attributes)
+		}
+	}
+
+	return result, pages.Err()
+}
+
+func (s PoliciesService) Pages() *PageIterator[Policy] {
+	return newPageIterator[Policy](s.client, withQuery(s.client.endpoint(Policies), url.Values{"page": {"1"}, "per_page": {"250"}}), nil)
+}
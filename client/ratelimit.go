@@ -0,0 +1,75 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const DefaultRateLimitRetries = 3
+const DefaultRateLimitMaxWait = time.Minute
+
+// Wait before retrying a 429 without Retry-After, doubling with every further 429
+const defaultRateLimitWait = time.Second
+
+var ErrRateLimited = errors.New("rate limited by the API")
+
+// RateLimitPolicy controls how 429 responses are handled. The API rejected the request without processing it,
+// so every method is retried, POST included.
+type RateLimitPolicy struct {
+	// Retries after a 429, 0 hands the 429 response to the caller
+	MaxRetries int
+
+	// Longest Retry-After honored, a longer one fails with ErrRateLimited right away
+	MaxWait time.Duration
+}
+
+func DefaultRateLimitPolicy() RateLimitPolicy {
+	return RateLimitPolicy{MaxRetries: DefaultRateLimitRetries, MaxWait: DefaultRateLimitMaxWait}
+}
+
+// WithRateLimitRetry replaces DefaultRateLimitPolicy, which clients start with
+func WithRateLimitRetry(policy RateLimitPolicy) Option {
+	return func(c *BetterstackClient) {
+		if policy.MaxWait <= 0 {
+			policy.MaxWait = DefaultRateLimitMaxWait
+		}
+		c.rateLimit = policy
+	}
+}
+
+// WithoutRateLimitRetry hands 429 responses to the caller instead of waiting for Retry-After
+func WithoutRateLimitRetry() Option {
+	return func(c *BetterstackClient) {
+		c.rateLimit = RateLimitPolicy{}
+	}
+}
+
+// retryAfter parses the Retry-After header, either seconds or an HTTP date
+func retryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	var value = strings.TrimSpace(header.Get("Retry-After"))
+	if value == Blanc {
+		return 0, false
+	}
+	if seconds, convErr := strconv.Atoi(value); convErr == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+	if at, parseErr := http.ParseTime(value); parseErr == nil {
+		return max(at.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// wait returns the wait before retrying the given 429 response, the first retry being 1
+func (p RateLimitPolicy) wait(response *http.Response, retry int) time.Duration {
+	if wait, found := retryAfter(response.Header, time.Now()); found {
+		return wait
+	}
+	var wait = defaultRateLimitWait
+	for i := 1; i < retry && wait < p.MaxWait; i++ {
+		wait *= 2
+	}
+	return min(wait, p.MaxWait)
+}
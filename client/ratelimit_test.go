@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimitedRequestsWaitAndRetry(t *testing.T) {
+	var server = newScriptedServer(t, scriptedResponse{status: http.StatusTooManyRequests, headers: map[string]string{"Retry-After": "0"}}, okResponse)
+	var c = NewClient("token", WithBaseURL(server.URL))
+
+	if _, getErr := c.Monitors().Get(context.Background(), "1"); getErr != nil {
+		t.Fatal(getErr)
+	}
+	if server.requests.Load() != 2 {
+		t.Errorf("expected 2 requests, got %d", server.requests.Load())
+	}
+}
+
+func TestRateLimitBeyondMaxWaitFails(t *testing.T) {
+	var server = newScriptedServer(t, scriptedResponse{status: http.StatusTooManyRequests, headers: map[string]string{"Retry-After": "3600"}})
+	var c = NewClient("token", WithBaseURL(server.URL), WithRateLimitRetry(RateLimitPolicy{MaxRetries: 3, MaxWait: time.Second}))
+
+	var _, getErr = c.Monitors().Get(context.Background(), "1")
+	if !errors.Is(getErr, ErrRateLimited) || server.requests.Load() != 1 {
+		t.Errorf("expected ErrRateLimited after 1 request, got %d: %v", server.requests.Load(), getErr)
+	}
+}
+
+func TestWithoutRateLimitRetryReturns429(t *testing.T) {
+	var server = newScriptedServer(t, scriptedResponse{status: http.StatusTooManyRequests, headers: map[string]string{"Retry-After": "0"}})
+	var c = NewClient("token", WithBaseURL(server.URL), WithoutRateLimitRetry())
+
+	var _, getErr = c.Monitors().Get(context.Background(), "1")
+	if StatusCode(getErr) != http.StatusTooManyRequests || server.requests.Load() != 1 {
+		t.Errorf("expected the 429 returned, got %d requests: %v", server.requests.Load(), getErr)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	var now = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	var cases = []struct {
+		value    string
+		expected time.Duration
+		found    bool
+	}{
+		{"", 0, false},
+		{"30", 30 * time.Second, true},
+		{"-5", 0, true},
+		{now.Add(time.Minute).Format(http.TimeFormat), time.Minute, true},
+		{now.Add(-time.Minute).Format(http.TimeFormat), 0, true},
+		{"soon", 0, false},
+	}
+	for _, tc := range cases {
+		var wait, found = retryAfter(http.Header{"Retry-After": {tc.value}}, now)
+		if wait != tc.expected || found != tc.found {
+			t.Errorf("%q: expected %v %v, got %v %v", tc.value, tc.expected, tc.found, wait, found)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	json "github.com/json-iterator/go"
+	"github.com/thoas/go-funk"
+)
+
+const ContentTypeForm = "application/x-www-form-urlencoded"
+
+var ErrBodyNotAllowed = errors.New("request body requires http_method POST, PUT or PATCH")
+var ErrInvalidHTTPMethod = errors.New("invalid http_method, valid options are GET, HEAD, POST, PUT, PATCH")
+
+// HTTPMethods are the values the API accepts for http_method
+var HTTPMethods = []string{http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch}
+
+// GraphQLRequest is the body of a GraphQL query sent over HTTP
+type GraphQLRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// ValidateRequestBody checks request_body against http_method: the method must be one of HTTPMethods and a body
+// requires POST, PUT or PATCH. dns monitors require a body, the domain to query, and ignore the method. Create
+// validates monitors before sending them, update doesn't require what may be set already.
+func ValidateRequestBody(monitor Monitor) error {
+	return validateRequestBody(monitor, false)
+}
+
+func validateRequestBody(monitor Monitor, partial bool) error {
+	if monitor.MonitorType == MonitorTypeDNS {
+		if funk.IsEmpty(strings.TrimSpace(monitor.RequestBody)) && !partial {
+			return errors.New("dns monitors require request_body, the domain to query")
+		}
+		return nil
+	}
+
+	var method = strings.ToUpper(monitor.HTTPMethod)
+	if funk.NotEmpty(method) && !funk.ContainsString(HTTPMethods, method) {
+		return fmt.Errorf("%q: %w", monitor.HTTPMethod, ErrInvalidHTTPMethod)
+	}
+	if funk.IsEmpty(monitor.RequestBody) {
+		return nil
+	}
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		return nil
+	case Blanc:
+		// The API defaults to GET, an update may keep a method set before
+		if partial {
+			return nil
+		}
+		return fmt.Errorf("http_method not set: %w", ErrBodyNotAllowed)
+	}
+	return fmt.Errorf("%s: %w", monitor.HTTPMethod, ErrBodyNotAllowed)
+}
+
+// SetJSONBody sends value serialized as JSON with the check. Without http_method the check turns into a POST.
+func (m *Monitor) SetJSONBody(value any) error {
+	var serialized, serErr = json.Marshal(value)
+	if serErr != nil {
+		return fmt.Errorf("failed to serialize request body: %v", serErr)
+	}
+	m.setBody(string(serialized), ApplicationJSON)
+	return nil
+}
+
+// SetFormBody sends the values URL encoded with the check. Without http_method the check turns into a POST.
+func (m *Monitor) SetFormBody(values url.Values) {
+	m.setBody(values.Encode(), ContentTypeForm)
+}
+
+// SetGraphQLBody sends a GraphQL query with the check, as JSON per GraphQL over HTTP. Without http_method the check
+// turns into a POST.
+func (m *Monitor) SetGraphQLBody(request GraphQLRequest) error {
+	if funk.IsEmpty(strings.TrimSpace(request.Query)) {
+		return errors.New("GraphQL request without query")
+	}
+	return m.SetJSONBody(request)
+}
+
+// ContentType returns the Content-Type request header of the check, blank when not set
+func (m Monitor) ContentType() string {
+	for _, header := range m.RequestHeaders {
+		if strings.EqualFold(header.Name, ContentType) {
+			return header.Value
+		}
+	}
+	return Blanc
+}
+
+// SetContentType sets the Content-Type request header of the check, replacing the current one
+func (m *Monitor) SetContentType(contentType string) {
+	var headers = make([]RequestHeader, 0, len(m.RequestHeaders)+1)
+	for _, header := range m.RequestHeaders {
+		if !strings.EqualFold(header.Name, ContentType) {
+			headers = append(headers, header)
+		}
+	}
+	m.RequestHeaders = append(headers, RequestHeader{Name: ContentType, Value: contentType})
+}
+
+func (m *Monitor) setBody(body, contentType string) {
+	m.RequestBody = body
+	m.HTTPMethod = strings.ToUpper(m.HTTPMethod)
+	if funk.IsEmpty(m.HTTPMethod) {
+		m.HTTPMethod = http.MethodPost
+	}
+	m.SetContentType(contentType)
+}
+
+// requestBodyCompat moves a body set through the deprecated RequestMethod field to RequestBody
+func requestBodyCompat(monitor Monitor) Monitor {
+	if funk.IsEmpty(monitor.RequestBody) {
+		monitor.RequestBody = monitor.RequestMethod
+	}
+	monitor.RequestMethod = Blanc
+	return monitor
+}
@@ -0,0 +1,160 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/thoas/go-funk"
+)
+
+var ErrNameNotResolved = errors.New("name could not be resolved")
+
+// Resolver translates human-readable monitor group and escalation policy names into their IDs. Lookup tables are
+// fetched lazily on first use and cached until Invalidate is called. Safe for concurrent use.
+type Resolver struct {
+	client *BetterstackClient
+
+	mu       sync.Mutex
+	groups   map[string]string
+	policies map[string]string
+
+	// Held while a group is looked up and created, so concurrent callers create each name once
+	creating map[string]*sync.Mutex
+}
+
+func NewResolver(client *BetterstackClient) *Resolver {
+	return &Resolver{
+		client: client,
+	}
+}
+
+// GroupID returns the ID of the monitor group with the given name
+func (r *Resolver) GroupID(ctx context.Context, name string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.groups == nil {
+		var groups, groupsErr = r.client.MonitorGroups().List(ctx)
+		if groupsErr != nil {
+			return Blanc, fmt.Errorf("failed to load monitor groups: %w", groupsErr)
+		}
+		r.groups = make(map[string]string, len(groups))
+		for _, group := range groups {
+			r.groups[group.Name] = group.ID
+		}
+	}
+
+	var id, found = r.groups[name]
+	if !found {
+		return Blanc, fmt.Errorf("monitor group %q: %w", name, ErrNameNotResolved)
+	}
+
+	return id, nil
+}
+
+// EnsureGroupID returns the ID of the monitor group with the given name, creating the group when it does not exist.
+// Concurrent calls for the same name create the group once.
+func (r *Resolver) EnsureGroupID(ctx context.Context, name string) (string, error) {
+	var lock = r.createLock(name)
+	lock.Lock()
+	defer lock.Unlock()
+
+	// Checked while holding the lock, a caller before may just have created the group
+	var id, idErr = r.GroupID(ctx, name)
+	if idErr == nil || !errors.Is(idErr, ErrNameNotResolved) {
+		return id, idErr
+	}
+
+	var created, createErr = r.client.MonitorGroups().Create(ctx, MonitorGroup{Name: name})
+	if createErr != nil {
+		return Blanc, fmt.Errorf("failed to create monitor group %q: %w", name, createErr)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// IDs are not known in dry-run mode, nothing worth caching then
+	if funk.NotEmpty(created.Data.ID) && r.groups != nil {
+		r.groups[name] = created.Data.ID
+	}
+
+	return created.Data.ID, nil
+}
+
+func (r *Resolver) createLock(name string) *sync.Mutex {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.creating == nil {
+		r.creating = map[string]*sync.Mutex{}
+	}
+	var lock, found = r.creating[name]
+	if !found {
+		lock = &sync.Mutex{}
+		r.creating[name] = lock
+	}
+	return lock
+}
+
+// PolicyID returns the ID of the escalation policy with the given name
+func (r *Resolver) PolicyID(ctx context.Context, name string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.policies == nil {
+		var policies, policiesErr = r.client.Policies().List(ctx)
+		if policiesErr != nil {
+			return Blanc, fmt.Errorf("failed to load policies: %w", policiesErr)
+		}
+		r.policies = make(map[string]string, len(policies))
+		for _, policy := range policies {
+			r.policies[policy.Name] = policy.ID
+		}
+	}
+
+	var id, found = r.policies[name]
+	if !found {
+		return Blanc, fmt.Errorf("policy %q: %w", name, ErrNameNotResolved)
+	}
+
+	return id, nil
+}
+
+// ResolveReferences fills MonitorGroupID and PolicyID of the monitor from group and policy names. Empty names are
+// left untouched. With createGroups set, missing monitor groups are created instead of failing the resolution.
+func (r *Resolver) ResolveReferences(ctx context.Context, monitor Monitor, groupName, policyName string, createGroups bool) (Monitor, error) {
+	if funk.NotEmpty(groupName) {
+		var groupID string
+		var groupErr error
+		if createGroups {
+			groupID, groupErr = r.EnsureGroupID(ctx, groupName)
+		} else {
+			groupID, groupErr = r.GroupID(ctx, groupName)
+		}
+		if groupErr != nil {
+			return monitor, groupErr
+		}
+		monitor.MonitorGroupID = groupID
+	}
+
+	if funk.NotEmpty(policyName) {
+		var policyID, policyErr = r.PolicyID(ctx, policyName)
+		if policyErr != nil {
+			return monitor, policyErr
+		}
+		monitor.PolicyID = policyID
+	}
+
+	return monitor, nil
+}
+
+// Invalidate drops cached lookup tables, the next lookup fetches them again
+func (r *Resolver) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.groups = nil
+	r.policies = nil
+}
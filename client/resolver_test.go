@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestEnsureGroupIDCreatesOnce(t *testing.T) {
+	var created atomic.Int32
+	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			created.Add(1)
+			time.Sleep(10 * time.Millisecond)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"data":{"id":"7","attributes":{"name":"shop"}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+	var resolver = NewResolver(NewClient("token", WithBaseURL(server.URL)))
+
+	var wg sync.WaitGroup
+	var ids = make([]string, 10)
+	for i := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var id, ensureErr = resolver.EnsureGroupID(context.Background(), "shop")
+			if ensureErr != nil {
+				t.Error(ensureErr)
+			}
+			ids[i] = id
+		}()
+	}
+	wg.Wait()
+
+	if created.Load() != 1 {
+		t.Errorf("expected the group created once, got %d", created.Load())
+	}
+	for _, id := range ids {
+		if id != "7" {
+			t.Errorf("expected ID 7, got %q", id)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const DefaultRetryAttempts = 3
+const DefaultRetryBaseDelay = 500 * time.Millisecond
+const DefaultRetryMaxDelay = 10 * time.Second
+const DefaultRetryJitter = 0.2
+
+// RetryPolicy retries requests failing with a network error or a 500, 502, 503 or 504 response. The delay doubles
+// with every retry, starting at BaseDelay and capped at MaxDelay. Every method but POST is retried, updates setting
+// attributes are safe to repeat.
+type RetryPolicy struct {
+	// Attempts including the first one, 1 or less turns retries off
+	MaxAttempts int
+
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+
+	// Fraction of each delay which is randomized, between 0 and 1, so clients failing together don't retry together
+	Jitter float64
+
+	// Retry POST requests too. A POST which reached the API before failing may be applied twice, e.g. create a
+	// duplicate monitor.
+	RetryPOST bool
+}
+
+// DefaultRetryPolicy retries idempotent requests up to DefaultRetryAttempts times
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: DefaultRetryAttempts,
+		BaseDelay:   DefaultRetryBaseDelay,
+		MaxDelay:    DefaultRetryMaxDelay,
+		Jitter:      DefaultRetryJitter,
+	}
+}
+
+// WithRetry retries transient failures according to policy. Without it network errors and 5xx responses surface
+// immediately, 429 responses are handled by the RateLimitPolicy either way.
+func WithRetry(policy RetryPolicy) Option {
+	return func(c *BetterstackClient) {
+		if policy.BaseDelay <= 0 {
+			policy.BaseDelay = DefaultRetryBaseDelay
+		}
+		if policy.MaxDelay < policy.BaseDelay {
+			policy.MaxDelay = max(DefaultRetryMaxDelay, policy.BaseDelay)
+		}
+		policy.Jitter = min(max(policy.Jitter, 0), 1)
+		c.retry = policy
+	}
+}
+
+// retryable returns true for failures worth another attempt of the request
+func (p RetryPolicy) retryable(request *http.Request, response *http.Response, respErr error) bool {
+	if request.Method == http.MethodPost && !p.RetryPOST {
+		return false
+	}
+	if respErr != nil {
+		return request.Context().Err() == nil
+	}
+	switch response.StatusCode {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// delay returns the wait before the given retry, the first retry being 1
+func (p RetryPolicy) delay(retry int) time.Duration {
+	var delay = p.BaseDelay
+	for i := 1; i < retry && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, p.MaxDelay)
+	if p.Jitter > 0 {
+		var spread = float64(delay) * p.Jitter
+		delay = time.Duration(float64(delay) - spread + rand.Float64()*2*spread)
+	}
+	return delay
+}
+
+// Attempt is one try of a request which was retried, StatusCode is 0 for network errors
+type Attempt struct {
+	StatusCode int
+	Err        error
+
+	// The request was answered with 429
+	RateLimited bool
+
+	// Wait before the next attempt, 0 for the last one
+	Wait time.Duration
+}
+
+// RetryError is returned for requests which failed after being retried or rate limited. Err is the failure of the
+// last attempt: the network error, the 5xx status, ErrRateLimited or the context error when the wait was cut short.
+// Use errors.As to inspect the attempts.
+type RetryError struct {
+	Method   string
+	Path     string
+	Attempts []Attempt
+	Err      error
+
+	// Wait asked for by the last 429 when Err is ErrRateLimited
+	RetryAfter time.Duration
+}
+
+func (e *RetryError) Error() string {
+	var attempts = make([]string, 0, len(e.Attempts))
+	for _, attempt := range e.Attempts {
+		// The details of the last failure are in Err
+		var outcome = "network error"
+		if attempt.Err == nil {
+			outcome = strconv.Itoa(attempt.StatusCode)
+		}
+		if attempt.Wait > 0 {
+			outcome = fmt.Sprintf("%s, waited %v", outcome, attempt.Wait.Round(time.Millisecond))
+		}
+		attempts = append(attempts, outcome)
+	}
+	return fmt.Sprintf("%s %s failed after %d attempts (%s): %v", e.Method, e.Path, len(e.Attempts), strings.Join(attempts, "; "), e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// Throttled returns true when every attempt was answered with 429, i.e. the API was never down
+func (e *RetryError) Throttled() bool {
+	for _, attempt := range e.Attempts {
+		if !attempt.RateLimited {
+			return false
+		}
+	}
+	return len(e.Attempts) > 0
+}
+
+// Waited returns the overall wait between the attempts
+func (e *RetryError) Waited() time.Duration {
+	var waited time.Duration
+	for _, attempt := range e.Attempts {
+		waited += attempt.Wait
+	}
+	return waited
+}
+
+// attempt executes the request, waiting out 429 responses according to the rate limit policy and retrying
+// transient failures according to the retry policy of the client. Requests whose body can't be read again are
+// sent once. A request which still fails after retries returns a *RetryError, its last 5xx response included.
+// Every attempt is logged at debug level, retries at info and requests failing after retries at error.
+func (c *BetterstackClient) attempt(request *http.Request) (*http.Response, error) {
+	var attempt, limited = 1, 0
+	var history []Attempt
+	var unsigned = request.Header
+	var rateLimitWait time.Duration
+	var fail = func(lastErr error) error {
+		var retryErr = &RetryError{Method: request.Method, Path: request.URL.Path, Attempts: history, Err: lastErr,
+			RetryAfter: rateLimitWait}
+		c.logger.Error("request failed", "method", request.Method, "path", request.URL.Path, "attempts", len(history),
+			"error", retryErr)
+		return retryErr
+	}
+	for {
+		var signed, signErr = c.sign(request, unsigned)
+		if signErr != nil {
+			return nil, signErr
+		}
+		if c.debug {
+			c.dumpRequest(request, signed)
+		}
+		var started = time.Now()
+		var response, respErr = c.httpClient.Do(request)
+		if c.debug {
+			c.dumpResponse(request, response, respErr, time.Since(started))
+		}
+		var outcome = Attempt{Err: respErr}
+		if respErr == nil {
+			outcome.StatusCode = response.StatusCode
+			c.logger.Debug("request sent", "method", request.Method, "path", request.URL.Path, "status", response.StatusCode,
+				"duration", time.Since(started))
+		} else {
+			c.logger.Debug("request sent", "method", request.Method, "path", request.URL.Path, "error", respErr,
+				"duration", time.Since(started))
+		}
+
+		var delay time.Duration
+		switch {
+		case respErr == nil && response.StatusCode == http.StatusTooManyRequests && c.rateLimit.MaxRetries > 0:
+			limited++
+			delay = c.rateLimit.wait(response, limited)
+			outcome.RateLimited = true
+			if limited > c.rateLimit.MaxRetries || delay > c.rateLimit.MaxWait {
+				discard(response)
+				history = append(history, outcome)
+				rateLimitWait = delay
+				return nil, fail(fmt.Errorf("%w, retry after %v", ErrRateLimited, delay))
+			}
+		case attempt < c.retry.MaxAttempts && c.retry.retryable(request, response, respErr):
+			delay = c.retry.delay(attempt)
+			attempt++
+		case len(history) > 0 && respErr != nil:
+			history = append(history, outcome)
+			return nil, fail(respErr)
+		case len(history) > 0 && response.StatusCode >= http.StatusInternalServerError:
+			history = append(history, outcome)
+			return nil, fail(checkResponse(response))
+		default:
+			return response, respErr
+		}
+
+		var body io.ReadCloser
+		if request.Body != nil {
+			if request.GetBody == nil {
+				return response, respErr
+			}
+			var bodyErr error
+			if body, bodyErr = request.GetBody(); bodyErr != nil {
+				return response, respErr
+			}
+		}
+
+		var reason string
+		if respErr != nil {
+			reason = respErr.Error()
+		} else {
+			reason = response.Status
+			discard(response)
+		}
+		c.logger.Info("retrying request", "method", request.Method, "path", request.URL.Path, "attempt", len(history)+1,
+			"delay", delay, "reason", reason)
+		outcome.Wait = delay
+		history = append(history, outcome)
+
+		var timer = time.NewTimer(delay)
+		select {
+		case <-request.Context().Done():
+			timer.Stop()
+			return nil, fail(request.Context().Err())
+		case <-timer.C:
+		}
+
+		request = request.Clone(request.Context())
+		request.Body = body
+	}
+}
+
+// discard drains and closes the body so the connection can be reused
+func discard(response *http.Response) {
+	_, _ = io.Copy(io.Discard, response.Body)
+	_ = response.Body.Close()
+}
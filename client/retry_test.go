@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+var fastRetry = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+func TestTransientFailuresAreRetried(t *testing.T) {
+	var server = newScriptedServer(t, scriptedResponse{status: http.StatusBadGateway},
+		scriptedResponse{status: http.StatusServiceUnavailable}, okResponse)
+	var c = NewClient("token", WithBaseURL(server.URL), WithRetry(fastRetry))
+
+	var monitor, getErr = c.Monitors().Get(context.Background(), "1")
+	if getErr != nil || monitor.Data.Attributes.PronounceableName != "shop" {
+		t.Fatalf("expected the third attempt to succeed: %v", getErr)
+	}
+	if server.requests.Load() != 3 {
+		t.Errorf("expected 3 requests, got %d", server.requests.Load())
+	}
+}
+
+func TestRetryErrorReportsAttempts(t *testing.T) {
+	var server = newScriptedServer(t, scriptedResponse{status: http.StatusServiceUnavailable, body: `{"errors":"down"}`})
+	var c = NewClient("token", WithBaseURL(server.URL), WithRetry(fastRetry))
+
+	var _, getErr = c.Monitors().Get(context.Background(), "1")
+	var retryErr *RetryError
+	if !errors.As(getErr, &retryErr) {
+		t.Fatalf("expected a RetryError, got %v", getErr)
+	}
+	if len(retryErr.Attempts) != 3 || retryErr.Attempts[2].StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("unexpected attempts: %+v", retryErr.Attempts)
+	}
+	if StatusCode(getErr) != http.StatusServiceUnavailable {
+		t.Errorf("expected the last status in the error, got %d", StatusCode(getErr))
+	}
+}
+
+func TestRetriesSkipPOSTAndClientErrors(t *testing.T) {
+	var cases = []struct {
+		name   string
+		status int
+		call   func(c *BetterstackClient) error
+	}{
+		{"POST", http.StatusBadGateway, func(c *BetterstackClient) error {
+			var _, createErr = c.Monitors().Create(context.Background(), Monitor{URL: "https://example.com", PronounceableName: "shop"})
+			return createErr
+		}},
+		{"404", http.StatusNotFound, func(c *BetterstackClient) error {
+			var _, getErr = c.Monitors().Get(context.Background(), "1")
+			return getErr
+		}},
+	}
+	for _, tc := range cases {
+		var server = newScriptedServer(t, scriptedResponse{status: tc.status})
+		var c = NewClient("token", WithBaseURL(server.URL), WithRetry(fastRetry))
+		if callErr := tc.call(c); callErr == nil || server.requests.Load() != 1 {
+			t.Errorf("%s: expected a single failed request, got %d: %v", tc.name, server.requests.Load(), callErr)
+		}
+	}
+}
+
+func TestRetryDelayBacksOff(t *testing.T) {
+	var policy = RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: 400 * time.Millisecond}
+	for retry, expected := range []time.Duration{100, 200, 400, 400} {
+		if delay := policy.delay(retry + 1); delay != expected*time.Millisecond {
+			t.Errorf("retry %d: expected %v, got %v", retry+1, expected*time.Millisecond, delay)
+		}
+	}
+
+	policy.Jitter = 0.5
+	for range 100 {
+		if delay := policy.delay(1); delay < 50*time.Millisecond || delay > 150*time.Millisecond {
+			t.Fatalf("jittered delay %v outside 50ms-150ms", delay)
+		}
+	}
+}
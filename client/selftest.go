@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/thoas/go-funk"
+)
+
+const CapabilityGranted = "granted"
+const CapabilityDenied = "denied"
+const CapabilityFailed = "failed"
+const CapabilitySkipped = "skipped"
+
+// Token scopes as far as the API tells, global tokens may pick the team of new resources
+const ScopeTeam = "team"
+const ScopeGlobal = "global"
+const ScopeUnknown = "unknown"
+
+// Capability is the outcome of one self-test check, e.g. "monitors:list". Err holds why it was denied, failed
+// or skipped.
+type Capability struct {
+	Name   string
+	Access string
+	Err    error
+}
+
+// SelfTestReport is the capability matrix of the token
+type SelfTestReport struct {
+	Capabilities []Capability
+	Scope        string
+
+	// Leftovers the cleanup failed on, e.g. "monitor 123"; to be deleted by hand
+	Leftovers []string
+}
+
+// Granted returns true when the check passed
+func (r SelfTestReport) Granted(name string) bool {
+	for _, capability := range r.Capabilities {
+		if capability.Name == name {
+			return capability.Access == CapabilityGranted
+		}
+	}
+	return false
+}
+
+// ReadOnly returns true when a read passed and no write did
+func (r SelfTestReport) ReadOnly() bool {
+	var reads, writes bool
+	for _, capability := range r.Capabilities {
+		if capability.Access != CapabilityGranted {
+			continue
+		}
+		if strings.HasSuffix(capability.Name, ":create") || strings.HasSuffix(capability.Name, ":delete") {
+			writes = true
+		} else {
+			reads = true
+		}
+	}
+	return reads && !writes
+}
+
+// SelfTest exercises the read endpoints and a create/delete cycle to report what the API token may do, e.g. as
+// bootstrap check in CI. The cycle creates a paused group named with ValidationNamePrefix, a paused monitor without
+// notifications in it, and deletes both right away; with WithValidationTeam they are created in that sandbox team.
+// Read-only and dry-run clients skip the cycle. Delete guards don't apply to the probes.
+func (c *BetterstackClient) SelfTest(ctx context.Context) SelfTestReport {
+	var report = SelfTestReport{Scope: ScopeUnknown}
+	var check = func(name string, err error) bool {
+		var capability = Capability{Name: name, Access: CapabilityGranted, Err: err}
+		switch status := StatusCode(err); {
+		case err == nil:
+		case status == http.StatusUnauthorized || status == http.StatusForbidden:
+			capability.Access = CapabilityDenied
+		default:
+			capability.Access = CapabilityFailed
+		}
+		report.Capabilities = append(report.Capabilities, capability)
+		return err == nil
+	}
+
+	var now = time.Now()
+	var _, monsErr = c.Monitors().ListPage(ctx, 1, Blanc, Blanc)
+	check("monitors:list", monsErr)
+	var _, groupsErr = c.MonitorGroups().ListPage(ctx, 1)
+	check("monitor_groups:list", groupsErr)
+	var _, incidentsErr = c.Incidents().ListPage(ctx, 1, now.Add(-24*time.Hour), now)
+	check("incidents:list", incidentsErr)
+	var _, policiesErr = c.Policies().ListPage(ctx, 1)
+	check("policies:list", policiesErr)
+	var _, onCallsErr = c.OnCall().ListPage(ctx, 1)
+	check("on_call:list", onCallsErr)
+
+	var writes = []string{"monitor_groups:create", "monitors:create", "monitors:delete", "monitor_groups:delete"}
+	var skip = func(names []string, reason error) {
+		for _, name := range names {
+			report.Capabilities = append(report.Capabilities, Capability{Name: name, Access: CapabilitySkipped, Err: reason})
+		}
+	}
+	if c.readOnly {
+		skip(writes, ErrReadOnlyClient)
+		return report
+	}
+	if c.dryRun.enabled {
+		skip(writes, errors.New("client is in dry-run mode"))
+		return report
+	}
+
+	var name = fmt.Sprintf("%sself-test %s", ValidationNamePrefix, now.UTC().Format(time.RFC3339))
+	var group, groupErr = c.MonitorGroups().Create(ctx, MonitorGroup{Name: name, TeamName: c.validationTeam, Paused: true})
+	report.Scope = tokenScope(c.validationTeam, groupErr)
+	if !check(writes[0], groupErr) {
+		skip(writes[1:], errors.New("no sandbox group"))
+		return report
+	}
+	var groupID = group.Data.ID
+
+	var monitor = Monitor{
+		MonitorType:       MonitorTypeStatus,
+		URL:               "https://example.com",
+		PronounceableName: name,
+		TeamName:          c.validationTeam,
+		MonitorGroupID:    groupID,
+		Paused:            true,
+	}
+	var created, createErr = c.Monitors().Create(ctx, monitor)
+	if check(writes[1], createErr) {
+		var deleteErr = c.deleteMonitor(ctx, created.Data.ID)
+		if !check(writes[2], deleteErr) {
+			report.Leftovers = append(report.Leftovers, fmt.Sprintf("monitor %s", created.Data.ID))
+		}
+	} else {
+		skip(writes[2:3], errors.New("no probe monitor"))
+	}
+
+	if !check(writes[3], c.deleteGroup(ctx, groupID)) {
+		report.Leftovers = append(report.Leftovers, fmt.Sprintf("monitor group %s", groupID))
+	}
+	return report
+}
+
+// tokenScope tells team and global tokens apart by how the API treats team_name on create. Global tokens must
+// name the team, team tokens are bound to theirs.
+func tokenScope(team string, createErr error) string {
+	var apiErr *APIError
+	var teamRejected = errors.As(createErr, &apiErr) && apiErr.StatusCode == http.StatusUnprocessableEntity &&
+		strings.Contains(strings.ToLower(fmt.Sprint(apiErr.Messages())), "team")
+	switch {
+	case createErr == nil && funk.NotEmpty(team):
+		return ScopeGlobal
+	case createErr == nil:
+		return ScopeTeam
+	case teamRejected && funk.NotEmpty(team):
+		return ScopeTeam
+	case teamRejected:
+		return ScopeGlobal
+	}
+	return ScopeUnknown
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"time"
+)
+
+// The API is grouped by resource, each service covers the endpoints of one:
+//
+//	var monitors, listErr = c.Monitors().List(ctx, client.InGroup(groupID))
+//	var incident, getErr = c.Incidents().Get(ctx, id)
+//
+// The flat methods of the client predate the services and wrap them with context.Background().
+
+type MonitorsService struct {
+	client *BetterstackClient
+}
+
+type MonitorGroupsService struct {
+	client *BetterstackClient
+}
+
+type IncidentsService struct {
+	client *BetterstackClient
+}
+
+type MetadataService struct {
+	client *BetterstackClient
+}
+
+type OnCallService struct {
+	client *BetterstackClient
+}
+
+type PoliciesService struct {
+	client *BetterstackClient
+}
+
+func (c *BetterstackClient) Monitors() MonitorsService {
+	return MonitorsService{client: c}
+}
+
+func (c *BetterstackClient) MonitorGroups() MonitorGroupsService {
+	return MonitorGroupsService{client: c}
+}
+
+func (c *BetterstackClient) Incidents() IncidentsService {
+	return IncidentsService{client: c}
+}
+
+func (c *BetterstackClient) Metadata() MetadataService {
+	return MetadataService{client: c}
+}
+
+func (c *BetterstackClient) OnCall() OnCallService {
+	return OnCallService{client: c}
+}
+
+func (c *BetterstackClient) Policies() PoliciesService {
+	return PoliciesService{client: c}
+}
+
+// List returns the incidents in the time range, zero from and to leave it open
+func (s IncidentsService) List(ctx context.Context, from, to time.Time) ([]Incident, error) {
+	var result []Incident
+	var pages = s.Pages(from, to)
+
+	for pages.Next(ctx) {
+		for _, incident := range pages.Page().Data {
+			incident.Attributes.ID = incident.ID
+			result = append(result, incident.Attributes)
+		}
+	}
+
+	return result, pages.Err()
+}
@@ -0,0 +1,204 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/thoas/go-funk"
+	"gopkg.in/yaml.v3"
+)
+
+// EnvConfig names the settings file of the Default client, SettingsFile is used when not set
+const EnvConfig = "BETTERSTACK_CONFIG"
+const EnvToken = "BETTERSTACK_TOKEN"
+const EnvBaseURL = "BETTERSTACK_BASE_URL"
+const EnvDryRun = "BETTERSTACK_DRY_RUN"
+const EnvReadOnly = "BETTERSTACK_READ_ONLY"
+const EnvValidationTeam = "BETTERSTACK_VALIDATION_TEAM"
+const EnvRetryAttempts = "BETTERSTACK_RETRY_ATTEMPTS"
+const EnvTimeout = "BETTERSTACK_TIMEOUT"
+const EnvCacheDir = "BETTERSTACK_CACHE_DIR"
+const EnvCacheTTL = "BETTERSTACK_CACHE_TTL"
+
+// SettingsFile is the settings file below the user config directory, e.g. ~/.config/betterstack/client.yaml
+const SettingsFile = "betterstack/client.yaml"
+
+var ErrTokenNotSet = errors.New("API token not set, neither in BETTERSTACK_TOKEN nor in the settings file")
+var ErrDefaultInitialized = errors.New("default client is initialized already")
+
+// Settings configure a client from a YAML file and environment variables, zero values keep the defaults of
+// NewClient. Durations are written like 30s or 5m.
+type Settings struct {
+	Token          string        `yaml:"token"`
+	BaseURL        string        `yaml:"base_url"`
+	DryRun         bool          `yaml:"dry_run"`
+	ReadOnly       bool          `yaml:"read_only"`
+	ValidationTeam string        `yaml:"validation_team"`
+	RetryAttempts  int           `yaml:"retry_attempts"`
+	Timeout        time.Duration `yaml:"timeout"`
+
+	// Responses are cached in a FileCache in the directory when set, for CacheTTL
+	CacheDir string        `yaml:"cache_dir"`
+	CacheTTL time.Duration `yaml:"cache_ttl"`
+}
+
+// LoadSettings reads the settings file named by BETTERSTACK_CONFIG, or SettingsFile when it exists, and applies
+// the BETTERSTACK_* environment variables on top
+func LoadSettings() (Settings, error) {
+	var settings Settings
+	var path = os.Getenv(EnvConfig)
+	if funk.IsEmpty(path) {
+		if dir, dirErr := os.UserConfigDir(); dirErr == nil {
+			if _, statErr := os.Stat(filepath.Join(dir, SettingsFile)); statErr == nil {
+				path = filepath.Join(dir, SettingsFile)
+			}
+		}
+	}
+	if funk.NotEmpty(path) {
+		var data, readErr = os.ReadFile(path)
+		if readErr != nil {
+			return settings, fmt.Errorf("failed to read settings: %v", readErr)
+		}
+		if unmErr := yaml.Unmarshal(data, &settings); unmErr != nil {
+			return settings, fmt.Errorf("failed to parse settings %s: %v", path, unmErr)
+		}
+	}
+	return settings, settings.applyEnv()
+}
+
+func (s *Settings) applyEnv() error {
+	var texts = map[string]*string{
+		EnvToken:          &s.Token,
+		EnvBaseURL:        &s.BaseURL,
+		EnvValidationTeam: &s.ValidationTeam,
+		EnvCacheDir:       &s.CacheDir,
+	}
+	for name, target := range texts {
+		if value, found := os.LookupEnv(name); found {
+			*target = value
+		}
+	}
+
+	for name, target := range map[string]*bool{EnvDryRun: &s.DryRun, EnvReadOnly: &s.ReadOnly} {
+		if value, found := os.LookupEnv(name); found {
+			var parsed, parseErr = strconv.ParseBool(value)
+			if parseErr != nil {
+				return fmt.Errorf("invalid %s %q, expected true or false", name, value)
+			}
+			*target = parsed
+		}
+	}
+
+	for name, target := range map[string]*time.Duration{EnvTimeout: &s.Timeout, EnvCacheTTL: &s.CacheTTL} {
+		if value, found := os.LookupEnv(name); found {
+			var parsed, parseErr = time.ParseDuration(value)
+			if parseErr != nil {
+				return fmt.Errorf("invalid %s %q: %v", name, value, parseErr)
+			}
+			*target = parsed
+		}
+	}
+
+	if value, found := os.LookupEnv(EnvRetryAttempts); found {
+		var parsed, parseErr = strconv.Atoi(value)
+		if parseErr != nil {
+			return fmt.Errorf("invalid %s %q, expected a number", EnvRetryAttempts, value)
+		}
+		s.RetryAttempts = parsed
+	}
+	return nil
+}
+
+// Options returns the options the settings stand for, the token excluded
+func (s Settings) Options() ([]Option, error) {
+	var opts []Option
+	if funk.NotEmpty(s.BaseURL) {
+		if urlErr := new(baseURLState).set(s.BaseURL); urlErr != nil {
+			return nil, urlErr
+		}
+		opts = append(opts, WithBaseURL(s.BaseURL))
+	}
+	if s.DryRun {
+		opts = append(opts, WithDryRun())
+	}
+	if s.ReadOnly {
+		opts = append(opts, WithReadOnly())
+	}
+	if funk.NotEmpty(s.ValidationTeam) {
+		opts = append(opts, WithValidationTeam(s.ValidationTeam))
+	}
+	if s.RetryAttempts > 0 {
+		var policy = DefaultRetryPolicy()
+		policy.MaxAttempts = s.RetryAttempts
+		opts = append(opts, WithRetry(policy))
+	}
+	if s.Timeout > 0 {
+		opts = append(opts, WithHTTPClient(&http.Client{Timeout: s.Timeout}))
+	}
+	if funk.NotEmpty(s.CacheDir) {
+		var cache, cacheErr = NewFileCache(s.CacheDir)
+		if cacheErr != nil {
+			return nil, cacheErr
+		}
+		opts = append(opts, WithCache(cache, s.CacheTTL))
+	}
+	return opts, nil
+}
+
+// NewClientFromSettings creates a client configured by the settings, opts are applied after their options
+func NewClientFromSettings(settings Settings, opts ...Option) (*BetterstackClient, error) {
+	if funk.IsEmpty(settings.Token) {
+		return nil, ErrTokenNotSet
+	}
+	var settingsOpts, optsErr = settings.Options()
+	if optsErr != nil {
+		return nil, optsErr
+	}
+	return NewClient(settings.Token, append(settingsOpts, opts...)...), nil
+}
+
+// defaultState is the shared client of Default, created once
+type defaultState struct {
+	mu     sync.Mutex
+	once   sync.Once
+	opts   []Option
+	client *BetterstackClient
+	err    error
+}
+
+var shared defaultState
+
+// SetDefaultOptions adds options to the Default client which can't be expressed as settings, e.g. WithLogger or
+// WithRequestSigner. They are applied after the settings, so they win. Fails with ErrDefaultInitialized once
+// Default was called.
+func SetDefaultOptions(opts ...Option) error {
+	shared.mu.Lock()
+	defer shared.mu.Unlock()
+	if shared.client != nil || shared.err != nil {
+		return ErrDefaultInitialized
+	}
+	shared.opts = append(shared.opts, opts...)
+	return nil
+}
+
+// Default returns the shared client, configured by LoadSettings and SetDefaultOptions. It is created on the first
+// call, concurrent first calls wait for it; later calls return the same client, or the same error.
+func Default() (*BetterstackClient, error) {
+	shared.once.Do(func() {
+		shared.mu.Lock()
+		defer shared.mu.Unlock()
+		var settings, settingsErr = LoadSettings()
+		if settingsErr != nil {
+			shared.err = settingsErr
+			return
+		}
+		shared.client, shared.err = NewClientFromSettings(settings, shared.opts...)
+	})
+	return shared.client, shared.err
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	json "github.com/json-iterator/go"
+)
+
+// DefaultSizeWarning is the monitor payload size logged as a warning unless WithSizeWarning changes it. The API
+// does not publish its limits, large Playwright scripts are the usual reason to get close to them.
+const DefaultSizeWarning = 256 << 10
+
+var ErrRequestTooLarge = errors.New("request body exceeds the size limit")
+
+type sizeGuard struct {
+	// Zero values disable the guard
+	warn          int
+	limit         int
+	compressAbove int
+}
+
+// WithSizeWarning logs a warning for monitor payloads above size bytes, zero disables the warning
+func WithSizeWarning(size int) Option {
+	return func(c *BetterstackClient) {
+		c.size.warn = size
+	}
+}
+
+// WithSizeLimit fails creates and updates of monitors whose payload exceeds size bytes with ErrRequestTooLarge,
+// before anything is sent
+func WithSizeLimit(size int) Option {
+	return func(c *BetterstackClient) {
+		c.size.limit = size
+	}
+}
+
+// WithCompression gzips request bodies above threshold bytes and sends them with Content-Encoding: gzip. Only
+// enable it when the API or the proxy in front of it accepts compressed requests.
+func WithCompression(threshold int) Option {
+	return func(c *BetterstackClient) {
+		c.size.compressAbove = threshold
+	}
+}
+
+// checkSize applies the size guards to a serialized monitor, naming the largest attribute so the culprit is
+// obvious in bulk runs
+func (c *BetterstackClient) checkSize(name string, serialized []byte) error {
+	var size = len(serialized)
+	if c.size.limit > 0 && size > c.size.limit {
+		return fmt.Errorf("monitor %q is %d bytes, the limit is %d, largest attribute %s: %w", name, size, c.size.limit,
+			largestAttribute(serialized), ErrRequestTooLarge)
+	}
+	if c.size.warn > 0 && size > c.size.warn {
+		c.logger.Warn("monitor is large, the API may refuse it", "monitor", name, "bytes", size, "largest_attribute", largestAttribute(serialized))
+	}
+	return nil
+}
+
+func largestAttribute(serialized []byte) string {
+	var attributes map[string]json.RawMessage
+	if unmErr := json.Unmarshal(serialized, &attributes); unmErr != nil {
+		return "unknown"
+	}
+	var result = "unknown"
+	var largest = -1
+	for name, value := range attributes {
+		if len(value) > largest {
+			result, largest = fmt.Sprintf("%s (%d bytes)", name, len(value)), len(value)
+		}
+	}
+	return result
+}
+
+// compress gzips the body of the request when it exceeds the compression threshold. It returns the headers to send
+// the request with, a copy carrying Content-Encoding when the body was compressed.
+func (c *BetterstackClient) compress(request *http.Request) (http.Header, error) {
+	if c.size.compressAbove <= 0 || request.GetBody == nil || request.ContentLength <= int64(c.size.compressAbove) {
+		return c.headers.clone(), nil
+	}
+
+	var body, bodyErr = request.GetBody()
+	if bodyErr != nil {
+		return nil, fmt.Errorf("failed to read request body: %v", bodyErr)
+	}
+	defer body.Close()
+
+	var buffer bytes.Buffer
+	var writer = gzip.NewWriter(&buffer)
+	if _, copyErr := io.Copy(writer, body); copyErr != nil {
+		return nil, fmt.Errorf("failed to compress request body: %v", copyErr)
+	}
+	if closeErr := writer.Close(); closeErr != nil {
+		return nil, fmt.Errorf("failed to compress request body: %v", closeErr)
+	}
+
+	var compressed = buffer.Bytes()
+	request.Body = io.NopCloser(bytes.NewReader(compressed))
+	request.ContentLength = int64(len(compressed))
+	request.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(compressed)), nil
+	}
+
+	var headers = c.headers.clone()
+	headers.Set("Content-Encoding", "gzip")
+	return headers, nil
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Status codes the API accepts in expected_status_codes
+const MinStatusCode = 100
+const MaxStatusCode = 599
+
+var ErrInvalidStatusCode = errors.New("invalid status code")
+
+// ExpectStatusRange returns the codes from through to, e.g. ExpectStatusRange(200, 299) for every success code
+func ExpectStatusRange(from, to int) ([]int, error) {
+	if from > to {
+		return nil, fmt.Errorf("status code range %d-%d is reversed: %w", from, to, ErrInvalidStatusCode)
+	}
+	if from < MinStatusCode || to > MaxStatusCode {
+		return nil, fmt.Errorf("status code range %d-%d exceeds %d-%d: %w", from, to, MinStatusCode, MaxStatusCode, ErrInvalidStatusCode)
+	}
+	var result = make([]int, 0, to-from+1)
+	for code := from; code <= to; code++ {
+		result = append(result, code)
+	}
+	return result, nil
+}
+
+// ExpectStatuses returns the codes validated, sorted and without duplicates
+func ExpectStatuses(codes ...int) ([]int, error) {
+	return NormalizeStatusCodes(codes)
+}
+
+// NormalizeStatusCodes validates the codes against the range the API accepts, then sorts and deduplicates them.
+// Create and update normalize ExpectedStatusCodes before sending a monitor.
+func NormalizeStatusCodes(codes []int) ([]int, error) {
+	if len(codes) == 0 {
+		return codes, nil
+	}
+	var seen = map[int]bool{}
+	var result = make([]int, 0, len(codes))
+	for _, code := range codes {
+		if code < MinStatusCode || code > MaxStatusCode {
+			return nil, fmt.Errorf("status code %d is outside %d-%d: %w", code, MinStatusCode, MaxStatusCode, ErrInvalidStatusCode)
+		}
+		if !seen[code] {
+			seen[code] = true
+			result = append(result, code)
+		}
+	}
+	sort.Ints(result)
+	return result, nil
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/thoas/go-funk"
+)
+
+// TagKeyPrefix marks metadata records which are tags, the tag follows the prefix
+const TagKeyPrefix = "tag:"
+
+// TagValue is the value of every tag record, the key alone carries the tag
+const TagValue = "true"
+
+// Tags emulates monitor tags over the Metadata API: tag "prod" is the record "tag:prod" = "true" owned by the
+// monitor. Tags are case-insensitive and stored lowercase.
+type Tags struct {
+	client *BetterstackClient
+}
+
+func NewTags(client *BetterstackClient) *Tags {
+	return &Tags{
+		client: client,
+	}
+}
+
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+func tagKey(tag string) string {
+	return TagKeyPrefix + normalizeTag(tag)
+}
+
+func (t *Tags) AddTag(ctx context.Context, monitorID, tag string) error {
+	var _, upsertErr = t.client.Metadata().Upsert(ctx, MetadataRecord{
+		Key:       tagKey(tag),
+		Value:     TagValue,
+		OwnerID:   monitorID,
+		OwnerType: OwnerTypeMonitor,
+	})
+	return upsertErr
+}
+
+// RemoveTag removes the tag, removing a tag the monitor doesn't have is not an error
+func (t *Tags) RemoveTag(ctx context.Context, monitorID, tag string) error {
+	var records, listErr = t.client.Metadata().List(ctx, OwnerTypeMonitor, monitorID)
+	if listErr != nil {
+		return listErr
+	}
+	for _, record := range records {
+		if record.Key == tagKey(tag) {
+			if deleteErr := t.client.Metadata().Delete(ctx, record.ID); deleteErr != nil {
+				return deleteErr
+			}
+		}
+	}
+	return nil
+}
+
+// MonitorTags returns the tags of a monitor, sorted
+func (t *Tags) MonitorTags(ctx context.Context, monitorID string) ([]string, error) {
+	var records, listErr = t.client.Metadata().List(ctx, OwnerTypeMonitor, monitorID)
+	if listErr != nil {
+		return nil, listErr
+	}
+	var result []string
+	for _, record := range records {
+		if strings.HasPrefix(record.Key, TagKeyPrefix) {
+			result = append(result, strings.TrimPrefix(record.Key, TagKeyPrefix))
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// ListMonitorsByTag returns the monitors having the tag
+func (t *Tags) ListMonitorsByTag(ctx context.Context, tag string) ([]Monitor, error) {
+	var records, listErr = t.client.Metadata().List(ctx, OwnerTypeMonitor, Blanc)
+	if listErr != nil {
+		return nil, listErr
+	}
+
+	var ids []string
+	for _, record := range records {
+		if record.Key == tagKey(tag) {
+			ids = append(ids, StringID(record.OwnerID))
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	return t.client.Monitors().List(ctx, func(monitor Monitor) bool {
+		return funk.ContainsString(ids, monitor.ID)
+	})
+}
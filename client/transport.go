@@ -0,0 +1,68 @@
+package client
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"time"
+)
+
+// TransportOptions tune connection handling for bulk workloads. Listing a large account issues many sequential
+// requests to the same host, so keeping connections alive and pooled matters more than for the occasional call.
+type TransportOptions struct {
+	// Idle connections kept per host. Go defaults to 2, which forces new TLS handshakes as soon as more than two
+	// requests run in parallel.
+	MaxIdleConnsPerHost int
+
+	// Idle connections kept across all hosts
+	MaxIdleConns int
+
+	// How long an idle connection is kept in the pool
+	IdleConnTimeout time.Duration
+
+	// TCP keep-alive probe interval, negative disables probes
+	KeepAlive time.Duration
+
+	// Disable HTTP keep-alive, every request opens a new connection
+	DisableKeepAlives bool
+
+	// Disable HTTP/2, requests are sent over HTTP/1.1
+	DisableHTTP2 bool
+
+	// Overall timeout of a single request including reading the body, 0 means no timeout
+	Timeout time.Duration
+}
+
+func DefaultTransportOptions() TransportOptions {
+	return TransportOptions{
+		MaxIdleConnsPerHost: 16,
+		MaxIdleConns:        100,
+		IdleConnTimeout:     90 * time.Second,
+		KeepAlive:           30 * time.Second,
+		Timeout:             60 * time.Second,
+	}
+}
+
+// NewTransport builds an http.Transport out of the options, starting from the settings of http.DefaultTransport
+func NewTransport(opts TransportOptions) *http.Transport {
+	var transport = http.DefaultTransport.(*http.Transport).Clone()
+
+	transport.MaxIdleConnsPerHost = opts.MaxIdleConnsPerHost
+	transport.MaxIdleConns = opts.MaxIdleConns
+	transport.IdleConnTimeout = opts.IdleConnTimeout
+	transport.DisableKeepAlives = opts.DisableKeepAlives
+	transport.DialContext = (&net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: opts.KeepAlive,
+	}).DialContext
+
+	if opts.DisableHTTP2 {
+		transport.ForceAttemptHTTP2 = false
+		// A non-nil empty map disables the HTTP/2 upgrade during the TLS handshake
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	} else {
+		transport.ForceAttemptHTTP2 = true
+	}
+
+	return transport
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ValidationNamePrefix marks monitors created by ValidateMonitorRemote, so leftovers are easy to spot
+const ValidationNamePrefix = "[validation] "
+
+var ErrValidationUnavailable = errors.New("remote validation needs a client which may create monitors")
+
+// WithValidationTeam makes ValidateMonitorRemote create its probe monitors in the team, e.g. a sandbox team, so
+// they never appear next to production monitors. Needs a global API token, and group and policy IDs of validated
+// monitors must exist in that team.
+func WithValidationTeam(team string) Option {
+	return func(c *BetterstackClient) {
+		c.validationTeam = team
+	}
+}
+
+// ValidateRemote confirms the API accepts the monitor definition. The API has no validation-only mode, so
+// the monitor is created paused and without notifications, then deleted right away; the returned error carries
+// the reasons of a rejection. The DefaultsProfile applies as it would on CreateMonitor. Dry-run and read-only
+// clients can't validate and fail with ErrValidationUnavailable.
+func (s MonitorsService) ValidateRemote(ctx context.Context, monitor Monitor) error {
+	var c = s.client
+	if c.readOnly || c.dryRun.enabled {
+		return ErrValidationUnavailable
+	}
+
+	monitor.ID = Blanc
+	monitor.PronounceableName = ValidationNamePrefix + monitor.PronounceableName
+	monitor.Paused = true
+	monitor.Email, monitor.SMS, monitor.Call, monitor.Push = false, false, false, false
+	if c.validationTeam != Blanc {
+		monitor.TeamName = c.validationTeam
+	}
+
+	var created, createErr = s.Create(ctx, monitor)
+	if createErr != nil {
+		return createErr
+	}
+
+	// The probe is ours, delete guards protect user monitors
+	if deleteErr := c.deleteMonitor(ctx, created.Data.ID); deleteErr != nil {
+		return fmt.Errorf("monitor is valid, but the probe monitor %s could not be deleted: %v", created.Data.ID, deleteErr)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package cmdb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/qameta/betterstack/report"
+	"github.com/thoas/go-funk"
+)
+
+// DefaultServiceKeys are the metadata keys naming the service a monitor belongs to, the first one set wins
+var DefaultServiceKeys = []string{"service", "application"}
+
+// Record is the configuration item a monitor maps to
+type Record struct {
+	// Monitor ID, correlates the CI with the monitor
+	Key string `json:"key"`
+
+	Name    string `json:"name"`
+	URL     string `json:"url"`
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	GroupID string `json:"group_id,omitempty"`
+	Owner   string `json:"owner,omitempty"`
+	Service string `json:"service,omitempty"`
+
+	// Every metadata record of the monitor by key
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// Exporter writes records to an asset inventory. It owns the comparison with what the inventory holds, as only
+// the exporter knows how records are stored there.
+type Exporter interface {
+	Name() string
+
+	// Export creates and updates the records, with retire the CIs of monitors not among records are retired
+	Export(ctx context.Context, records []Record, retire bool) (Result, error)
+}
+
+// Result lists CIs by monitor ID
+type Result struct {
+	Created   []string
+	Updated   []string
+	Retired   []string
+	Unchanged int
+	Failed    map[string]error
+}
+
+type Options struct {
+	// Metadata keys naming owner and service, default to report.DefaultOwnerKeys and DefaultServiceKeys
+	OwnerKeys   []string
+	ServiceKeys []string
+
+	// Only monitors matching all filters are exported
+	Filters []client.MonitorFilter
+
+	// Retire CIs whose monitor is gone or filtered out
+	Retire bool
+}
+
+// Sync maps the monitors of the account with their metadata to records and hands them to the exporter
+func Sync(ctx context.Context, c *client.BetterstackClient, exporter Exporter, opts Options) (Result, error) {
+	var monitors, monsErr = c.Monitors().List(ctx, opts.Filters...)
+	if monsErr != nil {
+		return Result{}, fmt.Errorf("failed to list monitors: %v", monsErr)
+	}
+	var metadata, metadataErr = c.Metadata().List(ctx, client.OwnerTypeMonitor, client.Blanc)
+	if metadataErr != nil {
+		return Result{}, fmt.Errorf("failed to list metadata: %v", metadataErr)
+	}
+
+	var result, exportErr = exporter.Export(ctx, Records(monitors, metadata, opts), opts.Retire)
+	if exportErr != nil {
+		return result, fmt.Errorf("failed to export to %s: %v", exporter.Name(), exportErr)
+	}
+	return result, nil
+}
+
+// Records maps monitors to records, sorted by key
+func Records(monitors []client.Monitor, metadata []client.MetadataRecord, opts Options) []Record {
+	var ownerKeys = opts.OwnerKeys
+	if len(ownerKeys) == 0 {
+		ownerKeys = report.DefaultOwnerKeys
+	}
+	var serviceKeys = opts.ServiceKeys
+	if len(serviceKeys) == 0 {
+		serviceKeys = DefaultServiceKeys
+	}
+
+	var byMonitor = map[string]map[string]string{}
+	for _, record := range metadata {
+		if record.OwnerType != client.OwnerTypeMonitor {
+			continue
+		}
+		var id = client.StringID(record.OwnerID)
+		if byMonitor[id] == nil {
+			byMonitor[id] = map[string]string{}
+		}
+		byMonitor[id][record.Key] = strings.TrimSpace(record.Value)
+	}
+
+	var result = make([]Record, 0, len(monitors))
+	for _, monitor := range monitors {
+		var status = monitor.Status
+		if monitor.Paused {
+			status = client.MonitorStatusPaused
+		}
+		result = append(result, Record{
+			Key:      monitor.ID,
+			Name:     monitor.PronounceableName,
+			URL:      monitor.URL,
+			Type:     monitor.MonitorType,
+			Status:   status,
+			GroupID:  monitor.GroupID(),
+			Owner:    first(byMonitor[monitor.ID], ownerKeys),
+			Service:  first(byMonitor[monitor.ID], serviceKeys),
+			Metadata: byMonitor[monitor.ID],
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Key < result[j].Key })
+	return result
+}
+
+// first returns the value of the first key set, keys compare case-insensitively
+func first(metadata map[string]string, keys []string) string {
+	for _, key := range keys {
+		for name, value := range metadata {
+			if strings.EqualFold(name, key) && funk.NotEmpty(value) {
+				return value
+			}
+		}
+	}
+	return client.Blanc
+}
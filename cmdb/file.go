@@ -0,0 +1,98 @@
+package cmdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+
+	json "github.com/json-iterator/go"
+)
+
+// File exports records as a JSON array, the format generic CMDB importers pick up. The previous file is the
+// current state: records are compared against it and, without retire, records of vanished monitors are kept.
+type File struct {
+	Path string
+}
+
+func (f *File) Name() string {
+	return f.Path
+}
+
+func (f *File) Export(_ context.Context, records []Record, retire bool) (Result, error) {
+	var result = Result{Failed: map[string]error{}}
+
+	var current = map[string]Record{}
+	var content, readErr = os.ReadFile(f.Path)
+	switch {
+	case errors.Is(readErr, os.ErrNotExist):
+	case readErr != nil:
+		return result, fmt.Errorf("failed to read %s: %v", f.Path, readErr)
+	default:
+		var previous []Record
+		if unmErr := json.Unmarshal(content, &previous); unmErr != nil {
+			return result, fmt.Errorf("failed to unmarshal %s: %v", f.Path, unmErr)
+		}
+		for _, record := range previous {
+			current[record.Key] = record
+		}
+	}
+
+	var next = map[string]Record{}
+	for _, record := range records {
+		next[record.Key] = record
+		var existing, found = current[record.Key]
+		switch {
+		case !found:
+			result.Created = append(result.Created, record.Key)
+		case reflect.DeepEqual(existing, record):
+			result.Unchanged++
+		default:
+			result.Updated = append(result.Updated, record.Key)
+		}
+	}
+	for key, record := range current {
+		if _, found := next[key]; found {
+			continue
+		}
+		if retire {
+			result.Retired = append(result.Retired, key)
+			continue
+		}
+		next[key] = record
+	}
+	sort.Strings(result.Retired)
+
+	var output = make([]Record, 0, len(next))
+	for _, record := range next {
+		output = append(output, record)
+	}
+	sort.Slice(output, func(i, j int) bool { return output[i].Key < output[j].Key })
+
+	var serialized, serErr = json.MarshalIndent(output, "", "  ")
+	if serErr != nil {
+		return result, fmt.Errorf("failed to serialize records: %v", serErr)
+	}
+
+	// Written next to the target and renamed, readers never see a partial file
+	var temporary, tempErr = os.CreateTemp(filepath.Dir(f.Path), filepath.Base(f.Path)+".*")
+	if tempErr != nil {
+		return result, fmt.Errorf("failed to create %s: %v", f.Path, tempErr)
+	}
+	defer os.Remove(temporary.Name())
+	if _, writeErr := temporary.Write(append(serialized, '\n')); writeErr != nil {
+		_ = temporary.Close()
+		return result, fmt.Errorf("failed to write %s: %v", f.Path, writeErr)
+	}
+	if closeErr := temporary.Close(); closeErr != nil {
+		return result, fmt.Errorf("failed to write %s: %v", f.Path, closeErr)
+	}
+	if renameErr := os.Rename(temporary.Name(), f.Path); renameErr != nil {
+		return result, fmt.Errorf("failed to replace %s: %v", f.Path, renameErr)
+	}
+
+	return result, nil
+}
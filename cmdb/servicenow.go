@@ -0,0 +1,255 @@
+package cmdb
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+const DefaultServiceNowTable = "cmdb_ci_service"
+
+// DefaultDiscoverySource marks the CIs the adapter manages, CIs with another discovery_source are never touched
+const DefaultDiscoverySource = "Better Stack"
+
+// install_status and operational_status values of the cmdb_ci base table
+const installStatusInstalled = "1"
+const installStatusRetired = "7"
+const operationalStatusOperational = "1"
+const operationalStatusNonOperational = "2"
+
+const serviceNowPageSize = 500
+
+// ServiceNow exports records through the Table API. CIs are correlated with monitors by correlation_id and
+// retired by install_status, they are never deleted.
+type ServiceNow struct {
+	// Instance URL, e.g. https://example.service-now.com
+	Instance string
+
+	// Basic authentication, or an OAuth access token
+	Username string
+	Password string
+	Token    string
+
+	// Defaults to DefaultServiceNowTable
+	Table string
+
+	// Defaults to DefaultDiscoverySource
+	Source string
+
+	// Maps a record to CI fields, defaults to DefaultServiceNowFields. correlation_id, discovery_source and
+	// install_status are always set.
+	Fields func(record Record) map[string]string
+
+	HTTPClient *http.Client
+}
+
+// DefaultServiceNowFields maps onto fields of the cmdb_ci base table. Owner and service are free text in the
+// records while the matching CI fields reference users and groups, so they are left to a custom Fields function.
+func DefaultServiceNowFields(record Record) map[string]string {
+	var status = operationalStatusOperational
+	if record.Status == client.MonitorStatusDown {
+		status = operationalStatusNonOperational
+	}
+	return map[string]string{
+		"name":               record.Name,
+		"short_description":  record.URL,
+		"operational_status": status,
+	}
+}
+
+func (s *ServiceNow) Name() string {
+	return "ServiceNow " + s.table()
+}
+
+func (s *ServiceNow) Export(ctx context.Context, records []Record, retire bool) (Result, error) {
+	var result = Result{Failed: map[string]error{}}
+
+	var current, listErr = s.list(ctx)
+	if listErr != nil {
+		return result, listErr
+	}
+
+	var wanted = map[string]bool{}
+	for _, record := range records {
+		wanted[record.Key] = true
+		var fields = s.fields(record)
+
+		var existing, found = current[record.Key]
+		if !found {
+			if _, createErr := s.call(ctx, http.MethodPost, s.tableURL(client.Blanc), fields); createErr != nil {
+				result.Failed[record.Key] = createErr
+				continue
+			}
+			result.Created = append(result.Created, record.Key)
+			continue
+		}
+
+		var changed = map[string]string{}
+		for name, value := range fields {
+			if existing[name] != value {
+				changed[name] = value
+			}
+		}
+		if len(changed) == 0 {
+			result.Unchanged++
+			continue
+		}
+		if _, updateErr := s.call(ctx, http.MethodPatch, s.tableURL(existing["sys_id"]), changed); updateErr != nil {
+			result.Failed[record.Key] = updateErr
+			continue
+		}
+		result.Updated = append(result.Updated, record.Key)
+	}
+
+	if !retire {
+		return result, nil
+	}
+	for key, existing := range current {
+		if wanted[key] || existing["install_status"] == installStatusRetired {
+			continue
+		}
+		var retireFields = map[string]string{"install_status": installStatusRetired}
+		if _, retireErr := s.call(ctx, http.MethodPatch, s.tableURL(existing["sys_id"]), retireFields); retireErr != nil {
+			result.Failed[key] = retireErr
+			continue
+		}
+		result.Retired = append(result.Retired, key)
+	}
+	sort.Strings(result.Retired)
+
+	return result, nil
+}
+
+func (s *ServiceNow) fields(record Record) map[string]string {
+	var mapping = s.Fields
+	if mapping == nil {
+		mapping = DefaultServiceNowFields
+	}
+	var result = map[string]string{}
+	for name, value := range mapping(record) {
+		result[name] = value
+	}
+	result["correlation_id"] = record.Key
+	result["discovery_source"] = s.source()
+	result["install_status"] = installStatusInstalled
+	return result
+}
+
+// list returns the CIs of the source by correlation_id, retired ones included so returning monitors revive their CI
+func (s *ServiceNow) list(ctx context.Context) (map[string]map[string]string, error) {
+	var names = []string{"sys_id", "correlation_id", "discovery_source", "install_status"}
+	for name := range s.fields(Record{}) {
+		if !funk.ContainsString(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	var result = map[string]map[string]string{}
+	for offset := 0; ; offset += serviceNowPageSize {
+		var params = url.Values{}
+		params.Set("sysparm_query", "discovery_source="+s.source())
+		params.Set("sysparm_fields", strings.Join(names, ","))
+		params.Set("sysparm_exclude_reference_link", "true")
+		params.Set("sysparm_limit", fmt.Sprintf("%d", serviceNowPageSize))
+		params.Set("sysparm_offset", fmt.Sprintf("%d", offset))
+
+		var page struct {
+			Result []map[string]string `json:"result"`
+		}
+		var body, listErr = s.call(ctx, http.MethodGet, s.tableURL(client.Blanc)+"?"+params.Encode(), nil)
+		if listErr != nil {
+			return nil, fmt.Errorf("failed to list CIs: %v", listErr)
+		}
+		if unmErr := json.Unmarshal(body, &page); unmErr != nil {
+			return nil, fmt.Errorf("failed to unmarshal CIs: %v", unmErr)
+		}
+
+		for _, ci := range page.Result {
+			if key := ci["correlation_id"]; funk.NotEmpty(key) {
+				result[key] = ci
+			}
+		}
+		if len(page.Result) < serviceNowPageSize {
+			return result, nil
+		}
+	}
+}
+
+func (s *ServiceNow) call(ctx context.Context, method, target string, fields map[string]string) ([]byte, error) {
+	if funk.IsEmpty(s.Instance) {
+		return nil, errors.New("ServiceNow instance is not set")
+	}
+
+	var body io.Reader
+	if fields != nil {
+		var serialized, serErr = json.Marshal(fields)
+		if serErr != nil {
+			return nil, fmt.Errorf("failed to serialize CI: %v", serErr)
+		}
+		body = bytes.NewReader(serialized)
+	}
+
+	var request, requestErr = http.NewRequestWithContext(ctx, method, target, body)
+	if requestErr != nil {
+		return nil, fmt.Errorf("failed to create request: %v", requestErr)
+	}
+	request.Header.Set("Accept", client.ApplicationJSON)
+	request.Header.Set(client.ContentType, client.ApplicationJSON)
+	if funk.NotEmpty(s.Token) {
+		request.Header.Set("Authorization", "Bearer "+s.Token)
+	} else {
+		request.SetBasicAuth(s.Username, s.Password)
+	}
+
+	var httpClient = s.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	var response, responseErr = httpClient.Do(request)
+	if responseErr != nil {
+		return nil, fmt.Errorf("failed to execute request: %v", responseErr)
+	}
+	defer response.Body.Close()
+
+	var content, readErr = io.ReadAll(response.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("failed to read response: %v", readErr)
+	}
+	if response.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("ServiceNow answered %s: %s", response.Status, strings.TrimSpace(string(content)))
+	}
+	return content, nil
+}
+
+func (s *ServiceNow) tableURL(sysID string) string {
+	var target = strings.TrimSuffix(s.Instance, "/") + "/api/now/table/" + url.PathEscape(s.table())
+	if funk.NotEmpty(sysID) {
+		target += "/" + url.PathEscape(sysID)
+	}
+	return target
+}
+
+func (s *ServiceNow) table() string {
+	if funk.IsEmpty(s.Table) {
+		return DefaultServiceNowTable
+	}
+	return s.Table
+}
+
+func (s *ServiceNow) source() string {
+	if funk.IsEmpty(s.Source) {
+		return DefaultDiscoverySource
+	}
+	return s.Source
+}
@@ -0,0 +1,206 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrNoResolver is returned when a config references monitor groups or escalation policies by name but no
+// Resolver was given to look them up
+var ErrNoResolver = errors.New("group and policy names need a resolver")
+
+// File is the declarative format for monitors and monitor groups. Monitors use the attribute names of the API and
+// reference groups and escalation policies by name.
+type File struct {
+	Groups   []Group   `json:"groups,omitempty"`
+	Monitors []Monitor `json:"monitors,omitempty"`
+}
+
+type Group struct {
+	Name string `json:"name"`
+}
+
+type Monitor struct {
+	client.Monitor
+
+	// Name of the monitor group, see FileSource.EnsureGroups to create missing ones
+	Group string `json:"group,omitempty"`
+
+	// Name of the escalation policy
+	Policy string `json:"policy,omitempty"`
+}
+
+// Load reads and validates a config file, see Parse
+func Load(path string, opts ...LoadOption) (*File, error) {
+	var data, readErr = os.ReadFile(path)
+	if readErr != nil {
+		return nil, fmt.Errorf("failed to read config: %v", readErr)
+	}
+	return Parse(data, path, opts...)
+}
+
+// Parse renders the config template, validates the YAML (or JSON) against the schema and decodes it. Validation
+// failures are returned as ValidationErrors, name prefixes their positions. A config with bases is an overlay,
+// see overlay.go.
+func Parse(data []byte, name string, opts ...LoadOption) (*File, error) {
+	var options loadOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	var document, overlay, parseErr = parseDocument(data, name, options, map[string]bool{})
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	// Through JSON so the attribute names are the json tags of the API models
+	var serialized, serErr = json.Marshal(document)
+	if serErr != nil {
+		return nil, fmt.Errorf("%s: %v", name, serErr)
+	}
+	var result File
+	if unmErr := json.Unmarshal(serialized, &result); unmErr != nil {
+		return nil, fmt.Errorf("%s: %v", name, unmErr)
+	}
+
+	if overlay {
+		// Entries of overlays may be partial, only the merged result must be complete
+		if requiredErrs := checkRequired(&result, name); len(requiredErrs) > 0 {
+			return nil, requiredErrs
+		}
+	}
+
+	return &result, nil
+}
+
+func parseDocument(data []byte, name string, options loadOptions, visiting map[string]bool) (map[string]any, bool, error) {
+	var rendered, renderErr = render(data, name, options)
+	if renderErr != nil {
+		return nil, false, fmt.Errorf("%s: %v", name, renderErr)
+	}
+
+	var node yaml.Node
+	if parseErr := yaml.Unmarshal(rendered, &node); parseErr != nil {
+		return nil, false, fmt.Errorf("%s: %v", name, parseErr)
+	}
+
+	var overlay = isOverlay(&node)
+	var schema = compiledSchema
+	if overlay {
+		schema = overlaySchema
+	}
+	if validationErrs := validateNode(&node, schema, name); len(validationErrs) > 0 {
+		return nil, overlay, validationErrs
+	}
+
+	var document map[string]any
+	if decodeErr := node.Decode(&document); decodeErr != nil {
+		return nil, overlay, fmt.Errorf("%s: %v", name, decodeErr)
+	}
+	if document == nil {
+		document = map[string]any{}
+	}
+
+	if overlay {
+		var merged, mergeErr = applyOverlay(document, name, options, visiting)
+		return merged, true, mergeErr
+	}
+	return document, false, nil
+}
+
+// FileSource is a reconcile source producing the monitors of a config file. The file is read on every call, so a
+// plan always reflects the file as it is now. Monitors never writes to the account, missing monitor groups fail it;
+// call EnsureGroups before planning to create them.
+type FileSource struct {
+	Path     string
+	Resolver *client.Resolver
+
+	Options []LoadOption
+}
+
+func (s *FileSource) Name() string {
+	return s.Path
+}
+
+func (s *FileSource) Monitors(ctx context.Context) ([]client.Monitor, error) {
+	var file, loadErr = Load(s.Path, s.Options...)
+	if loadErr != nil {
+		return nil, loadErr
+	}
+	return file.Resolve(ctx, s.Resolver)
+}
+
+// EnsureGroups creates the monitor groups the file declares or references which don't exist yet and returns the
+// IDs of all of them by name. In dry-run mode nothing is created, the IDs of those groups are empty.
+func (s *FileSource) EnsureGroups(ctx context.Context) (map[string]string, error) {
+	var file, loadErr = Load(s.Path, s.Options...)
+	if loadErr != nil {
+		return nil, loadErr
+	}
+	return file.EnsureGroups(ctx, s.Resolver)
+}
+
+// GroupNames returns the declared monitor groups followed by the ones only monitors reference, without duplicates
+func (f *File) GroupNames() []string {
+	var names []string
+	var seen = map[string]bool{}
+	var add = func(name string) {
+		if funk.NotEmpty(name) && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	for _, group := range f.Groups {
+		add(group.Name)
+	}
+	for _, monitor := range f.Monitors {
+		add(monitor.Group)
+	}
+	return names
+}
+
+// EnsureGroups creates the missing monitor groups of GroupNames, see FileSource.EnsureGroups
+func (f *File) EnsureGroups(ctx context.Context, resolver *client.Resolver) (map[string]string, error) {
+	var names = f.GroupNames()
+	if len(names) > 0 && resolver == nil {
+		return nil, ErrNoResolver
+	}
+
+	var result = make(map[string]string, len(names))
+	for _, name := range names {
+		var id, ensureErr = resolver.EnsureGroupID(ctx, name)
+		if ensureErr != nil {
+			return nil, ensureErr
+		}
+		result[name] = id
+	}
+	return result, nil
+}
+
+// Resolve translates group and policy names to IDs and returns the monitors ready for the API. It only reads, a
+// group which doesn't exist fails the resolution with client.ErrNameNotResolved.
+func (f *File) Resolve(ctx context.Context, resolver *client.Resolver) ([]client.Monitor, error) {
+	var result = make([]client.Monitor, 0, len(f.Monitors))
+	for _, monitor := range f.Monitors {
+		if funk.IsEmpty(monitor.Group) && funk.IsEmpty(monitor.Policy) {
+			result = append(result, monitor.Monitor)
+			continue
+		}
+		if resolver == nil {
+			return nil, fmt.Errorf("monitor %q: %w", monitor.PronounceableName, ErrNoResolver)
+		}
+		var resolved, resolveErr = resolver.ResolveReferences(ctx, monitor.Monitor, monitor.Group, monitor.Policy, false)
+		if resolveErr != nil {
+			return nil, fmt.Errorf("monitor %q: %w", monitor.PronounceableName, resolveErr)
+		}
+		result = append(result, resolved)
+	}
+	return result, nil
+}
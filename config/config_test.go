@@ -0,0 +1,115 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/qameta/betterstack/client"
+)
+
+const groupedConfig = `
+groups:
+  - name: shop
+monitors:
+  - url: https://example.com
+    pronounceable_name: checkout
+    monitor_type: status
+    group: shop
+`
+
+func writeConfig(t *testing.T, content string) string {
+	var path = filepath.Join(t.TempDir(), "monitors.yaml")
+	if writeErr := os.WriteFile(path, []byte(content), 0o600); writeErr != nil {
+		t.Fatal(writeErr)
+	}
+	return path
+}
+
+// groupsAPI serves an account without monitor groups and counts the groups created
+func groupsAPI(t *testing.T, created *int) *client.BetterstackClient {
+	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			*created++
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"data":{"id":"7","attributes":{"name":"shop"}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	t.Cleanup(server.Close)
+	return client.NewClient("token", client.WithBaseURL(server.URL))
+}
+
+func TestResolveWithoutResolver(t *testing.T) {
+	var source = FileSource{Path: writeConfig(t, groupedConfig)}
+	var _, monitorsErr = source.Monitors(context.Background())
+	if !errors.Is(monitorsErr, ErrNoResolver) {
+		t.Fatalf("expected ErrNoResolver, got %v", monitorsErr)
+	}
+}
+
+func TestMonitorsDoesNotCreateGroups(t *testing.T) {
+	var created int
+	var c = groupsAPI(t, &created)
+	var source = FileSource{Path: writeConfig(t, groupedConfig), Resolver: client.NewResolver(c)}
+
+	var _, monitorsErr = source.Monitors(context.Background())
+	if !errors.Is(monitorsErr, client.ErrNameNotResolved) || created != 0 {
+		t.Fatalf("expected the missing group to fail without creating it, created %d: %v", created, monitorsErr)
+	}
+
+	var ids, ensureErr = source.EnsureGroups(context.Background())
+	if ensureErr != nil || ids["shop"] != "7" || created != 1 {
+		t.Fatalf("expected the group created once, created %d, ids %v: %v", created, ids, ensureErr)
+	}
+	var monitors, resolvedErr = source.Monitors(context.Background())
+	if resolvedErr != nil || monitors[0].MonitorGroupID != "7" {
+		t.Errorf("expected the monitor in group 7, got %+v: %v", monitors, resolvedErr)
+	}
+}
+
+func TestTemplateEnv(t *testing.T) {
+	t.Setenv("REGION", "eu")
+	t.Setenv("BETTERSTACK_TOKEN", "secret")
+	t.Setenv("DEPLOY_API_KEY", "secret")
+
+	var render = func(expression string, opts ...LoadOption) (string, error) {
+		var rendered, renderErr = render([]byte(expression), "test", buildOptions(opts))
+		return string(rendered), renderErr
+	}
+	var cases = []struct {
+		expression string
+		opts       []LoadOption
+		expected   string
+	}{
+		{`{{ env "REGION" }}`, nil, "eu"},
+		{`{{ .env.REGION }}`, nil, "eu"},
+		{`{{ env "BETTERSTACK_TOKEN" }}`, nil, ""},
+		{`{{ .env.DEPLOY_API_KEY }}`, nil, ""},
+		{`{{ env "MISSING" }}`, nil, ""},
+		{`{{ env "DEPLOY_API_KEY" }}`, []LoadOption{WithEnvAllow("DEPLOY_API_KEY")}, "secret"},
+		{`{{ env "REGION" }}`, []LoadOption{WithEnvAllow("DEPLOY_API_KEY")}, ""},
+	}
+	for _, tc := range cases {
+		var rendered, renderErr = render(tc.expression, tc.opts...)
+		if tc.expected == "" && renderErr == nil {
+			t.Errorf("%s: expected an error, rendered %q", tc.expression, rendered)
+		}
+		if tc.expected != "" && (renderErr != nil || rendered != tc.expected) {
+			t.Errorf("%s: expected %q, got %q: %v", tc.expression, tc.expected, rendered, renderErr)
+		}
+	}
+}
+
+func buildOptions(opts []LoadOption) loadOptions {
+	var options loadOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
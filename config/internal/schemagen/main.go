@@ -0,0 +1,26 @@
+// Command schemagen writes the JSON Schema of the config format, run through go generate in the config package
+package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/qameta/betterstack/config"
+	log "github.com/sirupsen/logrus"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatal("usage: schemagen <output>")
+	}
+
+	// The standard library sorts keys and indents nested values properly, so the published file is stable
+	var serialized, serErr = json.MarshalIndent(config.GenerateSchema(), "", "  ")
+	if serErr != nil {
+		log.Fatalf("failed to serialize schema: %v", serErr)
+	}
+
+	if writeErr := os.WriteFile(os.Args[1], append(serialized, '\n'), 0o644); writeErr != nil {
+		log.Fatalf("failed to write schema: %v", writeErr)
+	}
+}
@@ -0,0 +1,222 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	json "github.com/json-iterator/go"
+	"github.com/thoas/go-funk"
+	"gopkg.in/yaml.v3"
+)
+
+// Overlays adapt base configs to an environment:
+//
+//	bases: [../base/monitors.yaml]
+//	monitors:                      # merged into the base monitor with the same name, or added
+//	  - pronounceable_name: API
+//	    url: https://api.staging.example.com
+//	patches:                       # set attributes on every matching monitor
+//	  - match: {name: "*", group: Backend}
+//	    set: {check_frequency: 300, policy: Staging}
+//	remove: [Billing]
+//
+// Bases are merged in order, may be overlays themselves and are resolved relative to the overlay. Then the
+// overlay monitors are merged attribute by attribute, lists replace lists, then patches apply in order and last
+// removals. Monitors keep the order of their first appearance, so the result is deterministic.
+
+type patchMatch struct {
+	// Glob against pronounceable_name
+	Name        string `json:"name,omitempty"`
+	Group       string `json:"group,omitempty"`
+	MonitorType string `json:"monitor_type,omitempty"`
+}
+
+var overlaySchema = func() *schemaNode {
+	var groups = compiledSchema.Properties["groups"]
+	var monitor = *compiledSchema.Properties["monitors"].Items
+	monitor.Required = nil
+
+	var closed = false
+	var stringList = &schemaNode{Type: "array", Items: &schemaNode{Type: "string"}}
+	var match = &schemaNode{
+		Type:                 "object",
+		AdditionalProperties: &closed,
+		Properties: map[string]*schemaNode{
+			"name":         {Type: "string"},
+			"group":        {Type: "string"},
+			"monitor_type": monitor.Properties["monitor_type"],
+		},
+	}
+
+	return &schemaNode{
+		Type:                 "object",
+		AdditionalProperties: &closed,
+		Required:             []string{"bases"},
+		Properties: map[string]*schemaNode{
+			"bases":    stringList,
+			"groups":   groups,
+			"monitors": {Type: "array", Items: &monitor},
+			"remove":   stringList,
+			"patches": {Type: "array", Items: &schemaNode{
+				Type:                 "object",
+				AdditionalProperties: &closed,
+				Required:             []string{"match", "set"},
+				Properties:           map[string]*schemaNode{"match": match, "set": &monitor},
+			}},
+		},
+	}
+}()
+
+func isOverlay(node *yaml.Node) bool {
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	if node.Kind != yaml.MappingNode {
+		return false
+	}
+	for i := 0; i < len(node.Content); i += 2 {
+		if node.Content[i].Value == "bases" {
+			return true
+		}
+	}
+	return false
+}
+
+func applyOverlay(overlay map[string]any, name string, options loadOptions, visiting map[string]bool) (map[string]any, error) {
+	var absolute, absErr = filepath.Abs(name)
+	if absErr != nil {
+		return nil, fmt.Errorf("%s: %v", name, absErr)
+	}
+	if visiting[absolute] {
+		return nil, fmt.Errorf("%s: overlay includes itself", name)
+	}
+	visiting[absolute] = true
+	defer delete(visiting, absolute)
+
+	var groups []any
+	var monitors []map[string]any
+	var index = map[string]int{}
+
+	var merge = func(entry map[string]any) {
+		var key = fmt.Sprint(entry["pronounceable_name"])
+		if position, found := index[key]; found {
+			for attribute, value := range entry {
+				monitors[position][attribute] = value
+			}
+			return
+		}
+		index[key] = len(monitors)
+		monitors = append(monitors, entry)
+	}
+	var mergeGroups = func(entries []any) {
+		for _, group := range entries {
+			if !funk.Contains(groups, group) {
+				groups = append(groups, group)
+			}
+		}
+	}
+
+	for _, base := range toList(overlay["bases"]) {
+		var basePath = fmt.Sprint(base)
+		if !filepath.IsAbs(basePath) {
+			basePath = filepath.Join(filepath.Dir(name), basePath)
+		}
+		var data, readErr = os.ReadFile(basePath)
+		if readErr != nil {
+			return nil, fmt.Errorf("%s: failed to read base: %v", name, readErr)
+		}
+		var document, _, baseErr = parseDocument(data, basePath, options, visiting)
+		if baseErr != nil {
+			return nil, baseErr
+		}
+		mergeGroups(toList(document["groups"]))
+		for _, entry := range toList(document["monitors"]) {
+			merge(entry.(map[string]any))
+		}
+	}
+
+	mergeGroups(toList(overlay["groups"]))
+	for _, entry := range toList(overlay["monitors"]) {
+		merge(entry.(map[string]any))
+	}
+
+	for _, raw := range toList(overlay["patches"]) {
+		var patch = raw.(map[string]any)
+		var match patchMatch
+		var serialized, _ = json.Marshal(patch["match"])
+		if unmErr := json.Unmarshal(serialized, &match); unmErr != nil {
+			return nil, fmt.Errorf("%s: invalid patch match: %v", name, unmErr)
+		}
+		var set, _ = patch["set"].(map[string]any)
+		for _, monitor := range monitors {
+			var matched, matchErr = match.matches(monitor)
+			if matchErr != nil {
+				return nil, fmt.Errorf("%s: invalid patch match: %v", name, matchErr)
+			}
+			if !matched {
+				continue
+			}
+			for attribute, value := range set {
+				monitor[attribute] = value
+			}
+		}
+	}
+
+	var removed = toList(overlay["remove"])
+	var result = make([]any, 0, len(monitors))
+	for _, monitor := range monitors {
+		if !funk.Contains(removed, monitor["pronounceable_name"]) {
+			result = append(result, monitor)
+		}
+	}
+
+	return map[string]any{"groups": groups, "monitors": result}, nil
+}
+
+func (m patchMatch) matches(monitor map[string]any) (bool, error) {
+	if funk.NotEmpty(m.Name) {
+		var name, _ = monitor["pronounceable_name"].(string)
+		var matched, matchErr = path.Match(m.Name, name)
+		if matchErr != nil || !matched {
+			return false, matchErr
+		}
+	}
+	if funk.NotEmpty(m.Group) && monitor["group"] != m.Group {
+		return false, nil
+	}
+	if funk.NotEmpty(m.MonitorType) && monitor["monitor_type"] != m.MonitorType {
+		return false, nil
+	}
+	return true, nil
+}
+
+func toList(value any) []any {
+	var list, _ = value.([]any)
+	return list
+}
+
+// checkRequired reports merged monitors and groups missing required attributes
+func checkRequired(file *File, name string) ValidationErrors {
+	var errs ValidationErrors
+	for i, group := range file.Groups {
+		if funk.IsEmpty(group.Name) {
+			errs = append(errs, ValidationError{File: name, Field: fmt.Sprintf("$.groups[%d]", i),
+				Message: `missing required attribute "name"`})
+		}
+	}
+	for i, monitor := range file.Monitors {
+		for _, value := range [][2]string{
+			{"pronounceable_name", monitor.PronounceableName},
+			{"monitor_type", monitor.MonitorType},
+			{"url", monitor.URL},
+		} {
+			if funk.IsEmpty(value[1]) {
+				errs = append(errs, ValidationError{File: name, Field: fmt.Sprintf("$.monitors[%d]", i),
+					Message: fmt.Sprintf("monitor %q misses required attribute %q after merging", monitor.PronounceableName, value[0])})
+			}
+		}
+	}
+	return errs
+}
@@ -0,0 +1,261 @@
+package config
+
+import (
+	_ "embed"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+	"gopkg.in/yaml.v3"
+)
+
+//go:generate go run ./internal/schemagen schema.json
+
+const SchemaID = "https://github.com/qameta/betterstack/config/schema.json"
+
+// SchemaJSON is the published JSON Schema of the config format. Editors pick it up with a
+// "# yaml-language-server: $schema=<SchemaID>" comment.
+//
+//go:embed schema.json
+var SchemaJSON []byte
+
+// Attributes the API manages, they are never part of a config
+var readOnlyAttributes = []string{"id", "status", "created_at", "updated_at"}
+
+// Attributes a config entry can't do without
+var requiredAttributes = map[reflect.Type][]string{
+	reflect.TypeOf(Monitor{}): {"pronounceable_name", "monitor_type", "url"},
+	reflect.TypeOf(Group{}):   {"name"},
+}
+
+var monitorTypes = []string{
+	client.MonitorTypeStatus, client.MonitorTypeStatusCode, client.MonitorTypeExpectedStatusCode,
+	client.MonitorTypeKeyword, client.MonitorTypeKeywordAbsence, client.MonitorTypePing, client.MonitorTypeTCP,
+	client.MonitorTypeUDP, client.MonitorTypeSMTP, client.MonitorTypePOP, client.MonitorTypeIMAP,
+	client.MonitorTypeDNS, client.MonitorTypePlaywright,
+}
+
+type ValidationError struct {
+	File    string
+	Line    int
+	Column  int
+	Field   string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("%s: %s: %s", e.File, e.Field, e.Message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s: %s", e.File, e.Line, e.Column, e.Field, e.Message)
+}
+
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	var lines = make([]string, 0, len(e))
+	for _, err := range e {
+		lines = append(lines, err.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// GenerateSchema derives the JSON Schema from the config types, SchemaJSON is its output
+func GenerateSchema() map[string]any {
+	var result = typeSchema(reflect.TypeOf(File{}))
+	result["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	result["$id"] = SchemaID
+	result["title"] = "Better Stack monitors"
+	return result
+}
+
+func typeSchema(t reflect.Type) map[string]any {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return typeSchema(t.Elem())
+	case reflect.Slice:
+		return map[string]any{"type": "array", "items": typeSchema(t.Elem())}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return map[string]any{"type": "integer", "minimum": 0}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Interface:
+		// IDs of related resources, the API accepts both
+		return map[string]any{"type": []any{"string", "integer"}}
+	case reflect.Struct:
+		var properties = map[string]any{}
+		addProperties(t, properties)
+		var result = map[string]any{"type": "object", "properties": properties, "additionalProperties": false}
+		if required, found := requiredAttributes[t]; found {
+			result["required"] = required
+		}
+		return result
+	}
+	return map[string]any{}
+}
+
+func addProperties(t reflect.Type, properties map[string]any) {
+	for i := 0; i < t.NumField(); i++ {
+		var field = t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			addProperties(field.Type, properties)
+			continue
+		}
+		var name = strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == client.Blanc || name == "-" || funk.ContainsString(readOnlyAttributes, name) {
+			continue
+		}
+		var property = typeSchema(field.Type)
+		if name == "monitor_type" {
+			property["enum"] = monitorTypes
+		}
+		properties[name] = property
+	}
+}
+
+// schemaNode is the subset of JSON Schema GenerateSchema produces
+type schemaNode struct {
+	Type                 any                    `json:"type"`
+	Properties           map[string]*schemaNode `json:"properties"`
+	Required             []string               `json:"required"`
+	AdditionalProperties *bool                  `json:"additionalProperties"`
+	Items                *schemaNode            `json:"items"`
+	Enum                 []string               `json:"enum"`
+	Minimum              *float64               `json:"minimum"`
+}
+
+var compiledSchema = func() *schemaNode {
+	var result schemaNode
+	if unmErr := json.Unmarshal(SchemaJSON, &result); unmErr != nil {
+		panic(fmt.Sprintf("invalid embedded schema: %v", unmErr))
+	}
+	return &result
+}()
+
+// Validate checks a config file against the schema, reporting every violation with its line and field
+func Validate(path string, opts ...LoadOption) error {
+	var _, loadErr = Load(path, opts...)
+	return loadErr
+}
+
+func validateNode(node *yaml.Node, schema *schemaNode, file string) ValidationErrors {
+	var errs ValidationErrors
+	if node.Kind == yaml.DocumentNode {
+		if len(node.Content) == 0 {
+			return nil
+		}
+		node = node.Content[0]
+	}
+	validateValue(node, schema, "$", file, &errs)
+	return errs
+}
+
+func validateValue(node *yaml.Node, schema *schemaNode, field, file string, errs *ValidationErrors) {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	var fail = func(format string, args ...any) {
+		*errs = append(*errs, ValidationError{File: file, Line: node.Line, Column: node.Column, Field: field,
+			Message: fmt.Sprintf(format, args...)})
+	}
+
+	var actual = nodeType(node)
+	if !typeAllowed(schema.Type, actual) {
+		fail("expected %s, got %s", typeNames(schema.Type), actual)
+		return
+	}
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		var seen = map[string]bool{}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			var key, value = node.Content[i], node.Content[i+1]
+			seen[key.Value] = true
+			var property, known = schema.Properties[key.Value]
+			if !known {
+				if schema.AdditionalProperties != nil && !*schema.AdditionalProperties {
+					*errs = append(*errs, ValidationError{File: file, Line: key.Line, Column: key.Column,
+						Field: field + "." + key.Value, Message: "unknown attribute"})
+				}
+				continue
+			}
+			validateValue(value, property, field+"."+key.Value, file, errs)
+		}
+		for _, name := range schema.Required {
+			if !seen[name] {
+				fail("missing required attribute %q", name)
+			}
+		}
+	case yaml.SequenceNode:
+		if schema.Items == nil {
+			return
+		}
+		for i, item := range node.Content {
+			validateValue(item, schema.Items, field+"["+strconv.Itoa(i)+"]", file, errs)
+		}
+	case yaml.ScalarNode:
+		if len(schema.Enum) > 0 && !funk.ContainsString(schema.Enum, node.Value) {
+			fail("%q is not one of %s", node.Value, strings.Join(schema.Enum, ", "))
+		}
+		if schema.Minimum != nil && (actual == "integer" || actual == "number") {
+			if number, convErr := strconv.ParseFloat(node.Value, 64); convErr == nil && number < *schema.Minimum {
+				fail("must be at least %v", *schema.Minimum)
+			}
+		}
+	}
+}
+
+func nodeType(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return "object"
+	case yaml.SequenceNode:
+		return "array"
+	}
+	switch node.ShortTag() {
+	case "!!int":
+		return "integer"
+	case "!!float":
+		return "number"
+	case "!!bool":
+		return "boolean"
+	case "!!null":
+		return "null"
+	}
+	return "string"
+}
+
+func typeAllowed(allowed any, actual string) bool {
+	switch value := allowed.(type) {
+	case nil:
+		return true
+	case string:
+		return value == actual || (value == "number" && actual == "integer")
+	case []any:
+		for _, option := range value {
+			if typeAllowed(option, actual) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func typeNames(allowed any) string {
+	if options, ok := allowed.([]any); ok {
+		var names = make([]string, 0, len(options))
+		for _, option := range options {
+			names = append(names, fmt.Sprint(option))
+		}
+		return strings.Join(names, " or ")
+	}
+	return fmt.Sprint(allowed)
+}
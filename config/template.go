@@ -0,0 +1,156 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/template"
+
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+type loadOptions struct {
+	vars     map[string]any
+	env      map[string]string
+	envAllow []string
+}
+
+// Variables of the process environment templates don't see unless WithEnvAllow names them: everything of this
+// client and whatever looks like a credential
+const hiddenEnvPrefix = "BETTERSTACK_"
+
+var hiddenEnvParts = []string{"TOKEN", "SECRET", "PASSWORD", "PASSWD", "CREDENTIAL", "API_KEY", "APIKEY", "PRIVATE_KEY"}
+
+type LoadOption func(o *loadOptions)
+
+// WithVars makes values available to templates as .vars, e.g. {{ range .vars.services }}
+func WithVars(vars map[string]any) LoadOption {
+	return func(o *loadOptions) {
+		o.vars = vars
+	}
+}
+
+// WithEnv replaces the process environment templates see, to pin or limit what a config can read
+func WithEnv(env map[string]string) LoadOption {
+	return func(o *loadOptions) {
+		o.env = env
+	}
+}
+
+// WithEnvAllow limits the process environment templates see to the given variables, secrets included when named.
+// Without it templates see the whole environment except BETTERSTACK_* and variables named like credentials.
+func WithEnvAllow(names ...string) LoadOption {
+	return func(o *loadOptions) {
+		o.envAllow = append(o.envAllow, names...)
+	}
+}
+
+// Templates only get these functions. Nothing touches files, the network or processes, so rendering a config is
+// as safe as parsing it.
+func templateFuncs(env map[string]string) template.FuncMap {
+	return template.FuncMap{
+		"env": func(name string) (string, error) {
+			var value, found = env[name]
+			if !found {
+				return client.Blanc, fmt.Errorf("environment variable %s is not set", name)
+			}
+			return value, nil
+		},
+		"required": func(message string, value any) (any, error) {
+			if funk.IsEmpty(value) {
+				return nil, errors.New(message)
+			}
+			return value, nil
+		},
+		"default": func(fallback, value any) any {
+			if funk.IsEmpty(value) {
+				return fallback
+			}
+			return value
+		},
+		"lower":    strings.ToLower,
+		"upper":    strings.ToUpper,
+		"trim":     strings.TrimSpace,
+		"replace":  func(old, new, s string) string { return strings.ReplaceAll(s, old, new) },
+		"split":    func(separator, s string) []string { return strings.Split(s, separator) },
+		"join":     func(separator string, values []string) string { return strings.Join(values, separator) },
+		"contains": func(substr, s string) bool { return strings.Contains(s, substr) },
+		"quote":    strconv.Quote,
+		"list":     func(values ...any) []any { return values },
+		"toJson": func(value any) (string, error) {
+			var serialized, serErr = json.Marshal(value)
+			return string(serialized), serErr
+		},
+	}
+}
+
+// render evaluates the config as a Go template. The environment is available through the env function and as
+// .env, so {{ env "REGION" }} and {{ .env.REGION }} are the same, see WithEnvAllow for what they see. Missing keys
+// and variables fail the rendering rather than silently producing empty values. Positions of validation errors
+// refer to the rendered config.
+func render(data []byte, name string, opts loadOptions) ([]byte, error) {
+	if !bytes.Contains(data, []byte("{{")) {
+		return data, nil
+	}
+
+	var env = opts.env
+	if env == nil {
+		env = processEnv(opts.envAllow)
+	}
+
+	var parsed, parseErr = template.New(name).Option("missingkey=error").Funcs(templateFuncs(env)).Parse(string(data))
+	if parseErr != nil {
+		return nil, fmt.Errorf("failed to parse template: %v", parseErr)
+	}
+
+	var vars = opts.vars
+	if vars == nil {
+		vars = map[string]any{}
+	}
+
+	var result bytes.Buffer
+	if execErr := parsed.Execute(&result, map[string]any{"env": env, "vars": vars}); execErr != nil {
+		return nil, fmt.Errorf("failed to render template: %v", execErr)
+	}
+	return result.Bytes(), nil
+}
+
+// processEnv returns the allowed variables of the process environment, or all but the hidden ones without an
+// allow-list
+func processEnv(allow []string) map[string]string {
+	var env = map[string]string{}
+	if len(allow) > 0 {
+		for _, name := range allow {
+			if value, found := os.LookupEnv(name); found {
+				env[name] = value
+			}
+		}
+		return env
+	}
+
+	for _, entry := range os.Environ() {
+		var key, value, _ = strings.Cut(entry, "=")
+		if !hiddenEnv(key) {
+			env[key] = value
+		}
+	}
+	return env
+}
+
+func hiddenEnv(name string) bool {
+	var upper = strings.ToUpper(name)
+	if strings.HasPrefix(upper, hiddenEnvPrefix) {
+		return true
+	}
+	for _, part := range hiddenEnvParts {
+		if strings.Contains(upper, part) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+package correlate
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/qameta/betterstack/webhooks"
+)
+
+const DefaultWindow = 2 * time.Minute
+
+// KeyFunc returns the correlation key of an event, events sharing a key within the window form one outage. An empty
+// key means the event is not correlated with anything.
+type KeyFunc func(event webhooks.Event) string
+
+// Outage is a set of incidents which started within one correlation window and share a key
+type Outage struct {
+	Key       string
+	StartedAt time.Time
+	Events    []webhooks.Event
+}
+
+// MonitorIDs returns the IDs of the affected monitors
+func (o Outage) MonitorIDs() []string {
+	var result []string
+	var seen = map[string]bool{}
+	for _, event := range o.Events {
+		if !seen[event.MonitorID] {
+			seen[event.MonitorID] = true
+			result = append(result, event.MonitorID)
+		}
+	}
+	return result
+}
+
+// Correlator collects started incidents by key for Window after the first one and emits them as a single Outage.
+// Acknowledgements and resolutions are not correlated, they are passed to Next as they come.
+type Correlator struct {
+	Key    KeyFunc
+	Window time.Duration
+	Emit   func(ctx context.Context, outage Outage) error
+	Next   webhooks.Handler
+
+	// Receives the failures of Emit, defaults to logrus
+	Logger client.Logger
+
+	mu      sync.Mutex
+	pending map[string]*pendingOutage
+}
+
+type pendingOutage struct {
+	outage Outage
+	timer  *time.Timer
+}
+
+func New(key KeyFunc, window time.Duration, emit func(ctx context.Context, outage Outage) error) *Correlator {
+	if window <= 0 {
+		window = DefaultWindow
+	}
+	return &Correlator{
+		Key:    key,
+		Window: window,
+		Emit:   emit,
+	}
+}
+
+func (c *Correlator) Handle(ctx context.Context, event webhooks.Event) error {
+	if event.Kind() != webhooks.EventStarted {
+		if c.Next != nil {
+			return c.Next.Handle(ctx, event)
+		}
+		return nil
+	}
+
+	var key = c.Key(event)
+	if key == client.Blanc {
+		return c.Emit(ctx, Outage{StartedAt: event.OccurredAt(), Events: []webhooks.Event{event}})
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.pending == nil {
+		c.pending = map[string]*pendingOutage{}
+	}
+
+	if pending, found := c.pending[key]; found {
+		pending.outage.Events = append(pending.outage.Events, event)
+		return nil
+	}
+
+	var pending = &pendingOutage{
+		outage: Outage{Key: key, StartedAt: event.OccurredAt(), Events: []webhooks.Event{event}},
+	}
+	// The delivery context ends with the webhook request, the outage is emitted long after that
+	pending.timer = time.AfterFunc(c.Window, func() {
+		c.emit(context.Background(), key)
+	})
+	c.pending[key] = pending
+
+	return nil
+}
+
+// Flush emits all pending outages without waiting for their windows to close, used on shutdown
+func (c *Correlator) Flush(ctx context.Context) {
+	c.mu.Lock()
+	var keys []string
+	for key, pending := range c.pending {
+		pending.timer.Stop()
+		keys = append(keys, key)
+	}
+	c.mu.Unlock()
+
+	for _, key := range keys {
+		c.emit(ctx, key)
+	}
+}
+
+func (c *Correlator) emit(ctx context.Context, key string) {
+	c.mu.Lock()
+	var pending, found = c.pending[key]
+	delete(c.pending, key)
+	c.mu.Unlock()
+
+	if !found {
+		return
+	}
+
+	if emitErr := c.Emit(ctx, pending.outage); emitErr != nil {
+		client.LoggerOrDefault(c.Logger).Error("failed to emit correlated outage", "key", key, "error", emitErr)
+	}
+}
+
+// ByHost correlates incidents of monitors checking the same host
+func ByHost(event webhooks.Event) string {
+	var rawURL = event.Incident.URL
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "//" + rawURL
+	}
+	var parsedURL, parseErr = url.Parse(rawURL)
+	if parseErr != nil {
+		return client.Blanc
+	}
+	return strings.ToLower(parsedURL.Hostname())
+}
+
+// ByMonitorGroup correlates incidents of monitors in the same monitor group. Incidents do not carry the group, so
+// groupOf maps a monitor ID onto its group ID, e.g. from a ListAllMonitors snapshot.
+func ByMonitorGroup(groupOf func(monitorID string) string) KeyFunc {
+	return func(event webhooks.Event) string {
+		return groupOf(event.MonitorID)
+	}
+}
+
+// GroupIndex builds a monitor ID to group ID lookup out of a list of monitors, for use with ByMonitorGroup
+func GroupIndex(monitors []client.Monitor) func(monitorID string) string {
+	var index = map[string]string{}
+	for _, monitor := range monitors {
+		index[monitor.ID] = monitor.GroupID()
+	}
+	return func(monitorID string) string {
+		return index[monitorID]
+	}
+}
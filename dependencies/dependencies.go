@@ -0,0 +1,222 @@
+package dependencies
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+// DependsOnKey is the metadata key of a monitor listing the IDs of the monitors it depends on, comma-separated
+const DependsOnKey = "depends_on"
+
+// Graph of monitor dependencies: monitor A depends on B when A cannot be up while B is down
+type Graph struct {
+	upstream   map[string][]string
+	downstream map[string][]string
+}
+
+// Load reads the dependencies of all monitors from their metadata
+func Load(ctx context.Context, c *client.BetterstackClient) (Graph, error) {
+	var records, listErr = c.Metadata().List(ctx, client.OwnerTypeMonitor, client.Blanc)
+	if listErr != nil {
+		return Graph{}, fmt.Errorf("failed to list metadata: %v", listErr)
+	}
+	return Build(records), nil
+}
+
+// Build creates the graph from metadata records, records other than DependsOnKey of monitors are ignored
+func Build(records []client.MetadataRecord) Graph {
+	var edges = map[string][]string{}
+	for _, record := range records {
+		if record.OwnerType != client.OwnerTypeMonitor || !strings.EqualFold(record.Key, DependsOnKey) {
+			continue
+		}
+		var id = client.StringID(record.OwnerID)
+		edges[id] = append(edges[id], parse(record.Value)...)
+	}
+	return New(edges)
+}
+
+// New creates the graph from the upstream IDs per monitor ID
+func New(upstream map[string][]string) Graph {
+	var graph = Graph{upstream: map[string][]string{}, downstream: map[string][]string{}}
+	for id, ids := range upstream {
+		for _, upstreamID := range ids {
+			graph.add(id, upstreamID)
+		}
+	}
+	return graph
+}
+
+func (g Graph) add(id, upstreamID string) {
+	if id == upstreamID || funk.ContainsString(g.upstream[id], upstreamID) {
+		return
+	}
+	g.upstream[id] = append(g.upstream[id], upstreamID)
+	g.downstream[upstreamID] = append(g.downstream[upstreamID], id)
+	sort.Strings(g.upstream[id])
+	sort.Strings(g.downstream[upstreamID])
+}
+
+func parse(value string) []string {
+	var result []string
+	for _, id := range strings.Split(value, ",") {
+		if id = strings.TrimSpace(id); funk.NotEmpty(id) {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// Empty returns true when no monitor has dependencies
+func (g Graph) Empty() bool {
+	return len(g.upstream) == 0
+}
+
+// Upstream returns the monitors the monitor depends on directly
+func (g Graph) Upstream(id string) []string {
+	return g.upstream[id]
+}
+
+// Downstream returns the monitors depending on the monitor directly
+func (g Graph) Downstream(id string) []string {
+	return g.downstream[id]
+}
+
+// Ancestors returns every monitor the monitor depends on, directly or through other monitors, nearest first
+func (g Graph) Ancestors(id string) []string {
+	return walk(g.upstream, id)
+}
+
+// Dependents returns every monitor depending on the monitor, directly or through other monitors, nearest first
+func (g Graph) Dependents(id string) []string {
+	return walk(g.downstream, id)
+}
+
+func walk(edges map[string][]string, id string) []string {
+	var result []string
+	var seen = map[string]bool{id: true}
+	var queue = []string{id}
+	for len(queue) > 0 {
+		var current = queue[0]
+		queue = queue[1:]
+		for _, next := range edges[current] {
+			if !seen[next] {
+				seen[next] = true
+				result = append(result, next)
+				queue = append(queue, next)
+			}
+		}
+	}
+	return result
+}
+
+// DownUpstream returns the ancestors of the monitor isDown reports as down, nearest first
+func (g Graph) DownUpstream(id string, isDown func(id string) bool) []string {
+	var result []string
+	for _, ancestor := range g.Ancestors(id) {
+		if isDown(ancestor) {
+			result = append(result, ancestor)
+		}
+	}
+	return result
+}
+
+// Cycles returns the dependency cycles of the graph, each as the monitor IDs along the cycle
+func (g Graph) Cycles() [][]string {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	var state = map[string]int{}
+	var path []string
+	var result [][]string
+
+	var visit func(id string)
+	visit = func(id string) {
+		state[id] = visiting
+		path = append(path, id)
+		for _, upstreamID := range g.upstream[id] {
+			switch state[upstreamID] {
+			case unvisited:
+				visit(upstreamID)
+			case visiting:
+				var start = funk.IndexOfString(path, upstreamID)
+				result = append(result, append([]string{}, path[start:]...))
+			}
+		}
+		path = path[:len(path)-1]
+		state[id] = done
+	}
+
+	var ids = make([]string, 0, len(g.upstream))
+	for id := range g.upstream {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if state[id] == unvisited {
+			visit(id)
+		}
+	}
+	return result
+}
+
+// Missing returns the upstream IDs which are not among the known monitors, e.g. deleted ones
+func (g Graph) Missing(monitors []client.Monitor) map[string][]string {
+	var known = map[string]bool{}
+	for _, monitor := range monitors {
+		known[monitor.ID] = true
+	}
+	var result = map[string][]string{}
+	for id, ids := range g.upstream {
+		for _, upstreamID := range ids {
+			if !known[upstreamID] {
+				result[id] = append(result[id], upstreamID)
+			}
+		}
+	}
+	return result
+}
+
+// Set replaces the dependencies of the monitor, no upstream IDs remove them
+func Set(ctx context.Context, c *client.BetterstackClient, monitorID string, upstreamIDs ...string) error {
+	var ids []string
+	for _, id := range upstreamIDs {
+		if id = strings.TrimSpace(id); funk.NotEmpty(id) && id != monitorID && !funk.ContainsString(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	if len(ids) > 0 {
+		var _, upsertErr = c.Metadata().Upsert(ctx, client.MetadataRecord{
+			Key:       DependsOnKey,
+			Value:     strings.Join(ids, ","),
+			OwnerID:   monitorID,
+			OwnerType: client.OwnerTypeMonitor,
+		})
+		if upsertErr != nil {
+			return fmt.Errorf("failed to set dependencies of monitor %s: %v", monitorID, upsertErr)
+		}
+		return nil
+	}
+
+	var records, listErr = c.Metadata().List(ctx, client.OwnerTypeMonitor, monitorID)
+	if listErr != nil {
+		return fmt.Errorf("failed to list metadata: %v", listErr)
+	}
+	for _, record := range records {
+		if strings.EqualFold(record.Key, DependsOnKey) {
+			if deleteErr := c.Metadata().Delete(ctx, record.ID); deleteErr != nil {
+				return fmt.Errorf("failed to remove dependencies of monitor %s: %v", monitorID, deleteErr)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,349 @@
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/qameta/betterstack/report"
+	"github.com/thoas/go-funk"
+)
+
+// ContentType of the Prometheus text exposition format
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// DefaultInterval keeps the API calls of one exporter, several per monitor, well within the rate limit
+const DefaultInterval = 5 * time.Minute
+
+const defaultConcurrency = 4
+const defaultSSLTimeout = 10 * time.Second
+
+// Options turn off the metrics needing calls per monitor, for large accounts
+type Options struct {
+	// Minimum age of the data before a scrape refreshes it, defaults to DefaultInterval
+	Interval time.Duration
+
+	// Longest a refresh may take, defaults to Interval
+	RefreshTimeout time.Duration
+
+	SkipResponseTimes bool
+	SkipSLA           bool
+	SkipSSL           bool
+
+	// Timeout of a certificate probe, defaults to 10 seconds
+	SSLTimeout time.Duration
+
+	// Parallel calls per refresh, defaults to 4
+	Concurrency int
+}
+
+// Exporter publishes monitor status, response times, certificate expiration and availability as Prometheus
+// gauges. Data is refreshed at most every Interval, in the background: scrapes never wait for the API and are
+// served from the last refresh.
+type Exporter struct {
+	client *client.BetterstackClient
+	opts   Options
+
+	mu            sync.Mutex
+	rendered      []byte
+	refreshedAt   time.Time
+	startedAt     time.Time
+	refreshErrors int
+
+	// Closed when the running refresh finishes, nil while none runs
+	refreshing chan struct{}
+}
+
+func New(c *client.BetterstackClient, opts Options) *Exporter {
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultInterval
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultConcurrency
+	}
+	if opts.SSLTimeout <= 0 {
+		opts.SSLTimeout = defaultSSLTimeout
+	}
+	if opts.RefreshTimeout <= 0 {
+		opts.RefreshTimeout = opts.Interval
+	}
+	return &Exporter{client: c, opts: opts}
+}
+
+type sample struct {
+	name   string
+	labels map[string]string
+	value  float64
+}
+
+var help = map[string]string{
+	"betterstack_monitor_up":                              "1 when the monitor is up, 0 otherwise",
+	"betterstack_monitor_paused":                          "1 when the monitor is paused",
+	"betterstack_monitor_status":                          "Status of the monitor, 1 for the current one",
+	"betterstack_monitor_response_time_seconds":           "Latest response time per region",
+	"betterstack_monitor_response_time_quantile_seconds":  "Response time percentiles per region over the samples the API keeps",
+	"betterstack_monitor_ssl_days_remaining":              "Days until the certificate expires",
+	"betterstack_monitor_availability_ratio":              "Availability over the window, whole days as the API counts them",
+	"betterstack_exporter_last_refresh_timestamp_seconds": "Time of the last successful refresh",
+	"betterstack_exporter_refresh_errors_total":           "Refreshes which failed",
+}
+
+var statuses = []string{
+	client.MonitorStatusUp, client.MonitorStatusDown, client.MonitorStatusPaused, client.MonitorStatusPending,
+	client.MonitorStatusMaintenance, client.MonitorStatusValidating,
+}
+
+// ServeHTTP serves the metrics of the last refresh, starting a refresh in the background when they are older than
+// the interval. Until the first refresh finished only the exporter metrics are served. A failed refresh keeps the
+// previous data and counts in betterstack_exporter_refresh_errors_total.
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var metrics = e.metrics(r.Context(), false)
+	w.Header().Set("Content-Type", ContentType)
+	_, _ = w.Write(metrics)
+}
+
+// Push sends the metrics to a Prometheus Pushgateway, replacing the previous push of the job. Unlike a scrape it
+// waits for a due refresh, as long as ctx allows.
+func (e *Exporter) Push(ctx context.Context, gatewayURL, job string) error {
+	var metrics = e.metrics(ctx, true)
+
+	var target = strings.TrimSuffix(gatewayURL, "/") + "/metrics/job/" + url.PathEscape(job)
+	var request, reqErr = http.NewRequestWithContext(ctx, http.MethodPut, target, bytes.NewReader(metrics))
+	if reqErr != nil {
+		return fmt.Errorf("failed to create request: %v", reqErr)
+	}
+	request.Header.Set("Content-Type", ContentType)
+
+	var response, respErr = http.DefaultClient.Do(request)
+	if respErr != nil {
+		return fmt.Errorf("failed to execute request: %v", respErr)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode/100 != 2 {
+		return fmt.Errorf("failed to execute request: %v", response.Status)
+	}
+	return nil
+}
+
+// metrics renders the last refresh, starting a refresh when it is due. With wait set it waits for that refresh
+// until ctx is done.
+func (e *Exporter) metrics(ctx context.Context, wait bool) []byte {
+	e.mu.Lock()
+	// Counted from the start, so a failing API is asked once per interval rather than on every scrape
+	if e.refreshing == nil && time.Since(e.startedAt) >= e.opts.Interval {
+		e.startedAt = time.Now()
+		e.refreshing = make(chan struct{})
+		go e.refresh(e.refreshing)
+	}
+	var refreshing = e.refreshing
+	e.mu.Unlock()
+
+	if wait && refreshing != nil {
+		select {
+		case <-refreshing:
+		case <-ctx.Done():
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var buffer bytes.Buffer
+	buffer.Write(e.rendered)
+	var status = []sample{{name: "betterstack_exporter_refresh_errors_total", value: float64(e.refreshErrors)}}
+	if !e.refreshedAt.IsZero() {
+		status = append(status, sample{name: "betterstack_exporter_last_refresh_timestamp_seconds", value: float64(e.refreshedAt.Unix())})
+	}
+	write(&buffer, status)
+	return buffer.Bytes()
+}
+
+// refresh collects the samples with a context of its own, detached from the scrape which started it, and closes
+// done when finished
+func (e *Exporter) refresh(done chan struct{}) {
+	var ctx, cancel = context.WithTimeout(context.Background(), e.opts.RefreshTimeout)
+	defer cancel()
+	var samples, collectErr = e.collect(ctx)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	defer close(done)
+	e.refreshing = nil
+	if collectErr != nil {
+		e.client.Logger().Warn("failed to refresh metrics", "error", collectErr)
+		e.refreshErrors++
+		return
+	}
+	e.refreshedAt = time.Now()
+	var buffer bytes.Buffer
+	write(&buffer, samples)
+	e.rendered = buffer.Bytes()
+}
+
+// collect fetches the current values. Failures of calls per monitor drop the affected samples only.
+func (e *Exporter) collect(ctx context.Context) ([]sample, error) {
+	var monitors, monsErr = e.client.Monitors().List(ctx)
+	if monsErr != nil {
+		return nil, fmt.Errorf("failed to list monitors: %v", monsErr)
+	}
+
+	var mu sync.Mutex
+	var result []sample
+	var add = func(samples ...sample) {
+		mu.Lock()
+		defer mu.Unlock()
+		result = append(result, samples...)
+	}
+
+	var semaphore = make(chan struct{}, e.opts.Concurrency)
+	var wg sync.WaitGroup
+	for _, monitor := range monitors {
+		var labels = map[string]string{
+			"monitor_id": monitor.ID,
+			"name":       monitor.PronounceableName,
+			"type":       monitor.MonitorType,
+		}
+
+		var up, paused float64
+		if monitor.Status == client.MonitorStatusUp {
+			up = 1
+		}
+		if monitor.Paused || monitor.Status == client.MonitorStatusPaused {
+			paused = 1
+		}
+		add(sample{name: "betterstack_monitor_up", labels: labels, value: up},
+			sample{name: "betterstack_monitor_paused", labels: labels, value: paused})
+		for _, status := range statuses {
+			var value float64
+			if monitor.Status == status {
+				value = 1
+			}
+			add(sample{name: "betterstack_monitor_status", labels: with(labels, "status", status), value: value})
+		}
+
+		wg.Add(1)
+		go func(monitor client.Monitor, labels map[string]string) {
+			defer wg.Done()
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-semaphore }()
+			add(e.collectMonitor(ctx, monitor, labels)...)
+		}(monitor, labels)
+	}
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return result, nil
+}
+
+func (e *Exporter) collectMonitor(ctx context.Context, monitor client.Monitor, labels map[string]string) []sample {
+	var result []sample
+
+	if !e.opts.SkipResponseTimes {
+		if times, timesErr := e.client.Monitors().ResponseTimes(ctx, monitor.ID); timesErr == nil {
+			for _, region := range times.Data.Attributes.Regions {
+				if latest, found := region.Latest(); found {
+					result = append(result, sample{name: "betterstack_monitor_response_time_seconds",
+						labels: with(labels, "region", region.Region), value: latest.ResponseTime})
+				}
+			}
+			for _, region := range report.ByRegion(times.Data.Attributes.Regions) {
+				var regionLabels = with(labels, "region", region.Region)
+				for quantile, value := range map[string]float64{"0.5": region.P50, "0.95": region.P95, "0.99": region.P99} {
+					result = append(result, sample{name: "betterstack_monitor_response_time_quantile_seconds",
+						labels: with(regionLabels, "quantile", quantile), value: value})
+				}
+			}
+		}
+	}
+
+	if !e.opts.SkipSSL {
+		if address, ok := report.HTTPSAddress(monitor.URL); ok {
+			if cert, certErr := report.ProbeCertificate(address, e.opts.SSLTimeout); certErr == nil {
+				result = append(result, sample{name: "betterstack_monitor_ssl_days_remaining", labels: labels,
+					value: math.Floor(time.Until(cert.NotAfter).Hours() / 24)})
+			}
+		}
+	}
+
+	if !e.opts.SkipSLA {
+		var now = time.Now()
+		for window, duration := range map[string]time.Duration{"24h": 24 * time.Hour, "7d": 7 * 24 * time.Hour} {
+			if sla, slaErr := e.client.Monitors().SLA(ctx, monitor.ID, now.Add(-duration), now); slaErr == nil {
+				result = append(result, sample{name: "betterstack_monitor_availability_ratio",
+					labels: with(labels, "window", window), value: sla.Data.Attributes.Availability / 100})
+			}
+		}
+	}
+
+	return result
+}
+
+func with(labels map[string]string, key, value string) map[string]string {
+	var result = make(map[string]string, len(labels)+1)
+	for k, v := range labels {
+		result[k] = v
+	}
+	result[key] = value
+	return result
+}
+
+// write renders samples grouped by metric name, sorted for stable output
+func write(w io.Writer, samples []sample) {
+	var byName = map[string][]sample{}
+	for _, s := range samples {
+		byName[s.name] = append(byName[s.name], s)
+	}
+
+	var names = funk.Keys(byName).([]string)
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help[name], name, metricType(name))
+		var lines []string
+		for _, s := range byName[name] {
+			lines = append(lines, fmt.Sprintf("%s%s %v", name, renderLabels(s.labels), s.value))
+		}
+		sort.Strings(lines)
+		for _, line := range lines {
+			fmt.Fprintln(w, line)
+		}
+	}
+}
+
+func metricType(name string) string {
+	if strings.HasSuffix(name, "_total") {
+		return "counter"
+	}
+	return "gauge"
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+func renderLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return client.Blanc
+	}
+	var keys = funk.Keys(labels).([]string)
+	sort.Strings(keys)
+
+	var pairs = make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, key, labelEscaper.Replace(labels[key])))
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
@@ -0,0 +1,92 @@
+package exporter
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+)
+
+var monitorsOnly = Options{SkipResponseTimes: true, SkipSLA: true, SkipSSL: true}
+
+func newTestExporter(t *testing.T, api http.HandlerFunc) *Exporter {
+	var server = httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	return New(client.NewClient("token", client.WithBaseURL(server.URL)), monitorsOnly)
+}
+
+func scrape(e *Exporter, ctx context.Context) string {
+	var recorder = httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil).WithContext(ctx))
+	return recorder.Body.String()
+}
+
+// waitForRefresh waits until no refresh is running
+func waitForRefresh(t *testing.T, e *Exporter) {
+	e.mu.Lock()
+	var refreshing = e.refreshing
+	e.mu.Unlock()
+	if refreshing == nil {
+		return
+	}
+	select {
+	case <-refreshing:
+	case <-time.After(5 * time.Second):
+		t.Fatal("refresh did not finish")
+	}
+}
+
+func TestRefreshOutlivesTheScrape(t *testing.T) {
+	var e = newTestExporter(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[{"id":"1","attributes":{"pronounceable_name":"shop","status":"up"}}]}`))
+	})
+
+	var ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_ = scrape(e, ctx)
+	waitForRefresh(t, e)
+
+	var metrics = scrape(e, context.Background())
+	if !strings.Contains(metrics, `betterstack_monitor_up{monitor_id="1",name="shop",type=""} 1`) {
+		t.Errorf("refresh started by a canceled scrape was lost:\n%s", metrics)
+	}
+	if !strings.Contains(metrics, "betterstack_exporter_refresh_errors_total 0") {
+		t.Errorf("expected no refresh errors:\n%s", metrics)
+	}
+}
+
+func TestScrapesDoNotWaitForTheAPI(t *testing.T) {
+	var release = make(chan struct{})
+	var e = newTestExporter(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	})
+	defer waitForRefresh(t, e)
+	defer close(release)
+
+	var started = time.Now()
+	for range 3 {
+		_ = scrape(e, context.Background())
+	}
+	if elapsed := time.Since(started); elapsed > time.Second {
+		t.Errorf("scrapes waited %v for the refresh", elapsed)
+	}
+}
+
+func TestFailedRefreshIsCounted(t *testing.T) {
+	var e = newTestExporter(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	_ = scrape(e, context.Background())
+	waitForRefresh(t, e)
+
+	var metrics = scrape(e, context.Background())
+	if !strings.Contains(metrics, "betterstack_exporter_refresh_errors_total 1") ||
+		strings.Contains(metrics, "last_refresh_timestamp") {
+		t.Errorf("expected 1 refresh error and no refresh timestamp:\n%s", metrics)
+	}
+}
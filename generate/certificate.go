@@ -0,0 +1,91 @@
+package generate
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/qameta/betterstack/report"
+	"github.com/thoas/go-funk"
+)
+
+const DefaultSSLExpiration = 14
+
+type CertificateOptions struct {
+	// Days before expiration the generated monitors alert, defaults to DefaultSSLExpiration. Valid values are 1, 2,
+	// 3, 7, 14, 30 and 60.
+	SSLExpiration int
+
+	// Path checked on every name, defaults to /
+	Path string
+
+	// Base of every generated monitor
+	Template client.Monitor
+}
+
+// CertificateFromFile reads the first certificate of a PEM file
+func CertificateFromFile(path string) (*x509.Certificate, error) {
+	var data, readErr = os.ReadFile(path)
+	if readErr != nil {
+		return nil, fmt.Errorf("failed to read certificate: %v", readErr)
+	}
+
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, fmt.Errorf("no certificate found in %s", path)
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
+
+// CertificateFromEndpoint retrieves the certificate served at address (host:port)
+func CertificateFromEndpoint(address string, timeout time.Duration) (*x509.Certificate, error) {
+	if _, _, splitErr := net.SplitHostPort(address); splitErr != nil {
+		address = net.JoinHostPort(address, "443")
+	}
+	return report.ProbeCertificate(address, timeout)
+}
+
+// MonitorsFromCertificate generates an HTTPS status monitor with SSL expiration alerting for every DNS name the
+// certificate covers. Wildcard names can not be checked and are returned separately so they can be reviewed.
+func MonitorsFromCertificate(cert *x509.Certificate, opts CertificateOptions) ([]client.Monitor, []string) {
+	if opts.SSLExpiration <= 0 {
+		opts.SSLExpiration = DefaultSSLExpiration
+	}
+	if funk.IsEmpty(opts.Path) {
+		opts.Path = "/"
+	}
+	if !strings.HasPrefix(opts.Path, "/") {
+		opts.Path = "/" + opts.Path
+	}
+
+	var names = cert.DNSNames
+	if len(names) == 0 && funk.NotEmpty(cert.Subject.CommonName) {
+		names = []string{cert.Subject.CommonName}
+	}
+
+	var result []client.Monitor
+	var wildcards []string
+	for _, name := range names {
+		name = strings.ToLower(name)
+		if strings.HasPrefix(name, "*.") {
+			wildcards = append(wildcards, name)
+			continue
+		}
+
+		var monitor = fromTemplate(opts.Template, client.MonitorTypeStatus, name, "https://"+name+opts.Path)
+		monitor.SSLExpiration = opts.SSLExpiration
+		result = append(result, monitor)
+	}
+
+	return dedupe(result), wildcards
+}
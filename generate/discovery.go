@@ -0,0 +1,192 @@
+package generate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+	"gopkg.in/yaml.v3"
+)
+
+// Labels (Compose) or annotations (Kubernetes) a service declares its monitor with
+const AnnotationURL = "betterstack.io/url"
+const AnnotationCheckType = "betterstack.io/check-type"
+const AnnotationName = "betterstack.io/name"
+
+var discoverableTypes = []string{
+	client.MonitorTypeStatus, client.MonitorTypeExpectedStatusCode, client.MonitorTypeKeyword,
+	client.MonitorTypeKeywordAbsence, client.MonitorTypePing, client.MonitorTypeTCP, client.MonitorTypeUDP,
+	client.MonitorTypeSMTP, client.MonitorTypePOP, client.MonitorTypeIMAP, client.MonitorTypeDNS,
+}
+
+type composeFile struct {
+	Services map[string]struct {
+		Labels any `yaml:"labels"`
+		Deploy struct {
+			Labels any `yaml:"labels"`
+		} `yaml:"deploy"`
+	} `yaml:"services"`
+}
+
+type manifestMetadata struct {
+	Name        string            `yaml:"name"`
+	Namespace   string            `yaml:"namespace"`
+	Annotations map[string]string `yaml:"annotations"`
+}
+
+type manifest struct {
+	Kind     string           `yaml:"kind"`
+	Metadata manifestMetadata `yaml:"metadata"`
+	Spec     struct {
+		Template struct {
+			Metadata manifestMetadata `yaml:"metadata"`
+		} `yaml:"template"`
+	} `yaml:"spec"`
+	Items []manifest `yaml:"items"`
+}
+
+// DiscoverFile reads a Compose file or Kubernetes manifests, see Discover
+func DiscoverFile(path string, template client.Monitor) ([]client.Monitor, error) {
+	var data, readErr = os.ReadFile(path)
+	if readErr != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", path, readErr)
+	}
+	return Discover(data, template)
+}
+
+// Discover generates monitors for services declaring AnnotationURL, in labels of a Compose file or in annotations
+// of Kubernetes manifests (multiple documents and List kinds included). The check type defaults to status.
+// Monitors are named after the service unless AnnotationName is set.
+func Discover(data []byte, template client.Monitor) ([]client.Monitor, error) {
+	var decoder = yaml.NewDecoder(bytes.NewReader(data))
+	var result []client.Monitor
+	for {
+		var node yaml.Node
+		if decodeErr := decoder.Decode(&node); errors.Is(decodeErr, io.EOF) {
+			break
+		} else if decodeErr != nil {
+			return nil, fmt.Errorf("failed to parse manifest: %v", decodeErr)
+		}
+
+		var monitors, discoverErr = discoverDocument(&node, template)
+		if discoverErr != nil {
+			return nil, discoverErr
+		}
+		result = append(result, monitors...)
+	}
+	return dedupe(result), nil
+}
+
+func discoverDocument(node *yaml.Node, template client.Monitor) ([]client.Monitor, error) {
+	var probe struct {
+		Services map[string]any `yaml:"services"`
+		Kind     string         `yaml:"kind"`
+	}
+	if decodeErr := node.Decode(&probe); decodeErr != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %v", decodeErr)
+	}
+
+	if funk.IsEmpty(probe.Kind) && len(probe.Services) > 0 {
+		var compose composeFile
+		if decodeErr := node.Decode(&compose); decodeErr != nil {
+			return nil, fmt.Errorf("failed to parse compose file: %v", decodeErr)
+		}
+
+		var names = funk.Keys(compose.Services).([]string)
+		sort.Strings(names)
+
+		var result []client.Monitor
+		for _, name := range names {
+			var service = compose.Services[name]
+			var labels = composeLabels(service.Deploy.Labels)
+			for key, value := range composeLabels(service.Labels) {
+				labels[key] = value
+			}
+			var monitor, found, monitorErr = fromAnnotations(labels, name, template)
+			if monitorErr != nil {
+				return nil, monitorErr
+			}
+			if found {
+				result = append(result, monitor)
+			}
+		}
+		return result, nil
+	}
+
+	var object manifest
+	if decodeErr := node.Decode(&object); decodeErr != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %v", decodeErr)
+	}
+	return discoverManifest(object, template)
+}
+
+func discoverManifest(object manifest, template client.Monitor) ([]client.Monitor, error) {
+	var result []client.Monitor
+	for _, item := range object.Items {
+		var monitors, itemErr = discoverManifest(item, template)
+		if itemErr != nil {
+			return nil, itemErr
+		}
+		result = append(result, monitors...)
+	}
+
+	var name = object.Metadata.Name
+	if funk.NotEmpty(object.Metadata.Namespace) {
+		name = object.Metadata.Namespace + "/" + name
+	}
+
+	// Workloads may declare the monitor on the pod template as well
+	for _, annotations := range []map[string]string{object.Metadata.Annotations, object.Spec.Template.Metadata.Annotations} {
+		var monitor, found, monitorErr = fromAnnotations(annotations, name, template)
+		if monitorErr != nil {
+			return nil, monitorErr
+		}
+		if found {
+			result = append(result, monitor)
+		}
+	}
+	return result, nil
+}
+
+// composeLabels accepts both the map and the list ("key=value") syntax
+func composeLabels(labels any) map[string]string {
+	var result = map[string]string{}
+	switch value := labels.(type) {
+	case map[string]any:
+		for key, label := range value {
+			result[key] = fmt.Sprint(label)
+		}
+	case []any:
+		for _, entry := range value {
+			var key, label, _ = strings.Cut(fmt.Sprint(entry), "=")
+			result[key] = label
+		}
+	}
+	return result
+}
+
+func fromAnnotations(annotations map[string]string, name string, template client.Monitor) (client.Monitor, bool, error) {
+	var url = strings.TrimSpace(annotations[AnnotationURL])
+	if funk.IsEmpty(url) {
+		return client.Monitor{}, false, nil
+	}
+
+	var checkType = strings.TrimSpace(annotations[AnnotationCheckType])
+	if funk.IsEmpty(checkType) {
+		checkType = client.MonitorTypeStatus
+	}
+	if !funk.ContainsString(discoverableTypes, checkType) {
+		return client.Monitor{}, false, fmt.Errorf("%s: unsupported %s %q", name, AnnotationCheckType, checkType)
+	}
+
+	if custom := strings.TrimSpace(annotations[AnnotationName]); funk.NotEmpty(custom) {
+		name = custom
+	}
+	return fromTemplate(template, checkType, name, url), true, nil
+}
@@ -0,0 +1,35 @@
+package generate
+
+import (
+	"github.com/qameta/betterstack/client"
+)
+
+// fromTemplate copies the template and sets what identifies a generated monitor. Slices are copied so generated
+// monitors never share backing arrays with the template.
+func fromTemplate(template client.Monitor, monitorType, name, url string) client.Monitor {
+	var monitor = template
+	monitor.ID = client.Blanc
+	monitor.MonitorType = monitorType
+	monitor.PronounceableName = name
+	monitor.URL = url
+	monitor.Regions = append([]string(nil), template.Regions...)
+	monitor.RequestHeaders = append([]client.RequestHeader(nil), template.RequestHeaders...)
+	monitor.ExpectedStatusCodes = append([]int(nil), template.ExpectedStatusCodes...)
+	monitor.MaintenanceDays = append([]string(nil), template.MaintenanceDays...)
+	return monitor
+}
+
+// dedupe drops monitors with the same type and URL as an earlier one
+func dedupe(monitors []client.Monitor) []client.Monitor {
+	var seen = map[string]bool{}
+	var result []client.Monitor
+	for _, monitor := range monitors {
+		var key = monitor.MonitorType + " " + monitor.URL + " " + monitor.RequestBody
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, monitor)
+	}
+	return result
+}
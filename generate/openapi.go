@@ -0,0 +1,208 @@
+package generate
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+	"gopkg.in/yaml.v3"
+)
+
+type OpenAPIOptions struct {
+	// Base URL of the API, defaults to the first server of the document
+	BaseURL string
+
+	// Only operations having one of the tags
+	Tags []string
+
+	// Only operations whose operationId or path matches
+	Match *regexp.Regexp
+
+	// Values of path parameters, operations with unresolved parameters are skipped
+	PathParams map[string]string
+
+	// Header values per security scheme name. Values may reference monitor-side secrets, schemes without a value
+	// get a placeholder such as $API_KEY to fill in before creating the monitors.
+	Credentials map[string]string
+
+	// Base of every generated monitor
+	Template client.Monitor
+}
+
+type openAPIDocument struct {
+	OpenAPI string `yaml:"openapi"`
+	Servers []struct {
+		URL string `yaml:"url"`
+	} `yaml:"servers"`
+	Security   []map[string][]string                  `yaml:"security"`
+	Paths      map[string]map[string]openAPIOperation `yaml:"paths"`
+	Components struct {
+		SecuritySchemes map[string]openAPISecurityScheme `yaml:"securitySchemes"`
+	} `yaml:"components"`
+}
+
+type openAPIOperation struct {
+	OperationID string                 `yaml:"operationId"`
+	Summary     string                 `yaml:"summary"`
+	Tags        []string               `yaml:"tags"`
+	Responses   map[string]any         `yaml:"responses"`
+	Security    *[]map[string][]string `yaml:"security"`
+}
+
+type openAPISecurityScheme struct {
+	Type   string `yaml:"type"`
+	Scheme string `yaml:"scheme"`
+	In     string `yaml:"in"`
+	Name   string `yaml:"name"`
+}
+
+var pathParam = regexp.MustCompile(`\{([^}]+)\}`)
+var nonAlphanumeric = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// MonitorsFromOpenAPIFile reads an OpenAPI 3 document in JSON or YAML
+func MonitorsFromOpenAPIFile(path string, opts OpenAPIOptions) ([]client.Monitor, error) {
+	var data, readErr = os.ReadFile(path)
+	if readErr != nil {
+		return nil, fmt.Errorf("failed to read OpenAPI document: %v", readErr)
+	}
+	return MonitorsFromOpenAPI(data, opts)
+}
+
+// MonitorsFromOpenAPI generates an expected_status_code monitor for every selected GET operation of an OpenAPI 3
+// document. Expected codes are the documented 2xx responses, headers are derived from the security requirements of
+// the operation.
+func MonitorsFromOpenAPI(data []byte, opts OpenAPIOptions) ([]client.Monitor, error) {
+	var document openAPIDocument
+	if parseErr := yaml.Unmarshal(data, &document); parseErr != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI document: %v", parseErr)
+	}
+	if !strings.HasPrefix(document.OpenAPI, "3.") {
+		return nil, fmt.Errorf("unsupported OpenAPI version: %q", document.OpenAPI)
+	}
+
+	var baseURL = opts.BaseURL
+	if funk.IsEmpty(baseURL) && len(document.Servers) > 0 {
+		baseURL = document.Servers[0].URL
+	}
+	var base, baseErr = url.Parse(baseURL)
+	if baseErr != nil || !base.IsAbs() {
+		return nil, fmt.Errorf("invalid base URL %q, set OpenAPIOptions.BaseURL", baseURL)
+	}
+
+	var paths = funk.Keys(document.Paths).([]string)
+	sort.Strings(paths)
+
+	var result []client.Monitor
+	for _, path := range paths {
+		var operation, found = document.Paths[path]["get"]
+		if !found {
+			continue
+		}
+		if len(opts.Tags) > 0 && len(funk.IntersectString(opts.Tags, operation.Tags)) == 0 {
+			continue
+		}
+		if opts.Match != nil && !opts.Match.MatchString(operation.OperationID) && !opts.Match.MatchString(path) {
+			continue
+		}
+
+		var resolved, ok = resolvePath(path, opts.PathParams)
+		if !ok {
+			continue
+		}
+
+		var name = operation.OperationID
+		if funk.IsEmpty(name) {
+			name = "GET " + path
+		}
+
+		var monitor = fromTemplate(opts.Template, client.MonitorTypeExpectedStatusCode, name,
+			strings.TrimSuffix(base.String(), "/")+resolved)
+		monitor.ExpectedStatusCodes = successCodes(operation.Responses)
+
+		var security = document.Security
+		if operation.Security != nil {
+			security = *operation.Security
+		}
+		monitor.RequestHeaders = append(monitor.RequestHeaders,
+			securityHeaders(security, document.Components.SecuritySchemes, opts.Credentials)...)
+
+		result = append(result, monitor)
+	}
+
+	return dedupe(result), nil
+}
+
+func resolvePath(path string, params map[string]string) (string, bool) {
+	var ok = true
+	var resolved = pathParam.ReplaceAllStringFunc(path, func(match string) string {
+		var value, found = params[strings.Trim(match, "{}")]
+		if !found {
+			ok = false
+		}
+		return url.PathEscape(value)
+	})
+	return resolved, ok
+}
+
+// successCodes lists the documented 2xx codes, a 2XX range or no documented success means 200
+func successCodes(responses map[string]any) []int {
+	var result []int
+	for code := range responses {
+		if status, convErr := strconv.Atoi(code); convErr == nil && status >= 200 && status < 300 {
+			result = append(result, status)
+		}
+	}
+	if len(result) == 0 {
+		return []int{200}
+	}
+	sort.Ints(result)
+	return result
+}
+
+// securityHeaders uses the first requirement the headers can satisfy. Query and cookie API keys can't be expressed
+// as monitor headers and are skipped.
+func securityHeaders(security []map[string][]string, schemes map[string]openAPISecurityScheme,
+	credentials map[string]string) []client.RequestHeader {
+	for _, requirement := range security {
+		var headers []client.RequestHeader
+		var satisfied = true
+		for name := range requirement {
+			var header, ok = securityHeader(name, schemes[name], credentials)
+			if !ok {
+				satisfied = false
+				break
+			}
+			headers = append(headers, header)
+		}
+		if satisfied {
+			sort.Slice(headers, func(i, j int) bool { return headers[i].Name < headers[j].Name })
+			return headers
+		}
+	}
+	return nil
+}
+
+func securityHeader(name string, scheme openAPISecurityScheme, credentials map[string]string) (client.RequestHeader, bool) {
+	var value, found = credentials[name]
+	if !found {
+		value = "$" + strings.ToUpper(nonAlphanumeric.ReplaceAllString(name, "_"))
+	}
+
+	switch {
+	case scheme.Type == "apiKey" && scheme.In == "header":
+		return client.RequestHeader{Name: scheme.Name, Value: value}, true
+	case scheme.Type == "http" && strings.EqualFold(scheme.Scheme, "bearer"):
+		return client.RequestHeader{Name: "Authorization", Value: "Bearer " + value}, true
+	case scheme.Type == "http" && strings.EqualFold(scheme.Scheme, "basic"):
+		return client.RequestHeader{Name: "Authorization", Value: "Basic " + value}, true
+	case scheme.Type == "oauth2" || scheme.Type == "openIdConnect":
+		return client.RequestHeader{Name: "Authorization", Value: "Bearer " + value}, true
+	}
+	return client.RequestHeader{}, false
+}
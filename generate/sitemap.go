@@ -0,0 +1,313 @@
+package generate
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+const DefaultMaxPages = 50
+const DefaultUserAgent = "betterstack-go"
+
+// Sitemaps and robots.txt larger than this are rejected
+const maxDocumentSize = 50 << 20
+
+// Sitemap indexes nest, but not deeper than this in practice
+const maxSitemapDepth = 3
+
+type SitemapOptions struct {
+	// Only pages at most one path segment deep
+	TopLevelOnly bool
+
+	// Only pages whose path matches
+	Match *regexp.Regexp
+
+	// Maximum number of generated monitors, defaults to DefaultMaxPages
+	MaxPages int
+
+	// With a keyword set keyword monitors requiring it are generated instead of status monitors
+	Keyword string
+
+	// User agent for fetching and for matching robots.txt groups, defaults to DefaultUserAgent
+	UserAgent string
+
+	// Defaults to http.DefaultClient
+	HTTPClient *http.Client
+
+	// Base of every generated monitor
+	Template client.Monitor
+}
+
+type urlSet struct {
+	URLs []struct {
+		Loc string `xml:"loc"`
+	} `xml:"url"`
+	Sitemaps []struct {
+		Loc string `xml:"loc"`
+	} `xml:"sitemap"`
+}
+
+// MonitorsFromSitemap reads the sitemap of a site and generates monitors for the selected pages. siteURL is either
+// the sitemap itself (ending with .xml or .xml.gz) or the site root, in which case the sitemaps announced in
+// robots.txt are used, falling back to /sitemap.xml. Pages robots.txt disallows for the user agent are skipped.
+func MonitorsFromSitemap(ctx context.Context, siteURL string, opts SitemapOptions) ([]client.Monitor, error) {
+	if opts.MaxPages <= 0 {
+		opts.MaxPages = DefaultMaxPages
+	}
+	if funk.IsEmpty(opts.UserAgent) {
+		opts.UserAgent = DefaultUserAgent
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = http.DefaultClient
+	}
+
+	var site, parseErr = url.Parse(siteURL)
+	if parseErr != nil || funk.IsEmpty(site.Host) {
+		return nil, fmt.Errorf("invalid site URL: %s", siteURL)
+	}
+
+	var root = &url.URL{Scheme: site.Scheme, Host: site.Host}
+	var robots, robotsErr = fetchRobots(ctx, opts, root.JoinPath("robots.txt").String())
+	if robotsErr != nil {
+		return nil, robotsErr
+	}
+
+	var sitemaps = robots.sitemaps
+	if strings.HasSuffix(site.Path, ".xml") || strings.HasSuffix(site.Path, ".xml.gz") {
+		sitemaps = []string{site.String()}
+	} else if len(sitemaps) == 0 {
+		sitemaps = []string{root.JoinPath("sitemap.xml").String()}
+	}
+
+	var pages []string
+	for _, sitemap := range sitemaps {
+		var found, fetchErr = collectPages(ctx, opts, sitemap, 0)
+		if fetchErr != nil {
+			return nil, fetchErr
+		}
+		pages = append(pages, found...)
+	}
+
+	var result []client.Monitor
+	for _, page := range funk.UniqString(pages) {
+		if len(result) >= opts.MaxPages {
+			break
+		}
+
+		var pageURL, pageErr = url.Parse(page)
+		if pageErr != nil || pageURL.Host != site.Host {
+			continue
+		}
+		var path = pageURL.EscapedPath()
+		if funk.IsEmpty(path) {
+			path = "/"
+		}
+		if opts.TopLevelOnly && strings.Count(strings.Trim(path, "/"), "/") > 0 {
+			continue
+		}
+		if opts.Match != nil && !opts.Match.MatchString(path) {
+			continue
+		}
+		if !robots.allowed(path) {
+			continue
+		}
+
+		var monitor client.Monitor
+		if funk.NotEmpty(opts.Keyword) {
+			monitor = fromTemplate(opts.Template, client.MonitorTypeKeyword, pageURL.Host+path, page)
+			monitor.RequiredKeyword = opts.Keyword
+		} else {
+			monitor = fromTemplate(opts.Template, client.MonitorTypeStatus, pageURL.Host+path, page)
+		}
+		result = append(result, monitor)
+	}
+
+	return result, nil
+}
+
+func collectPages(ctx context.Context, opts SitemapOptions, sitemapURL string, depth int) ([]string, error) {
+	var body, fetchErr = fetch(ctx, opts, sitemapURL)
+	if fetchErr != nil {
+		return nil, fetchErr
+	}
+	defer body.Close()
+
+	var reader io.Reader = body
+	if strings.HasSuffix(sitemapURL, ".gz") {
+		var gz, gzErr = gzip.NewReader(body)
+		if gzErr != nil {
+			return nil, fmt.Errorf("failed to decompress %s: %v", sitemapURL, gzErr)
+		}
+		defer gz.Close()
+		reader = gz
+	}
+
+	var set urlSet
+	if decErr := xml.NewDecoder(io.LimitReader(reader, maxDocumentSize)).Decode(&set); decErr != nil {
+		return nil, fmt.Errorf("failed to parse sitemap %s: %v", sitemapURL, decErr)
+	}
+
+	var result []string
+	for _, entry := range set.URLs {
+		result = append(result, strings.TrimSpace(entry.Loc))
+	}
+
+	if depth < maxSitemapDepth {
+		for _, nested := range set.Sitemaps {
+			if len(result) >= opts.MaxPages*4 {
+				break
+			}
+			var pages, nestedErr = collectPages(ctx, opts, strings.TrimSpace(nested.Loc), depth+1)
+			if nestedErr != nil {
+				return nil, nestedErr
+			}
+			result = append(result, pages...)
+		}
+	}
+
+	return result, nil
+}
+
+type robotsRule struct {
+	path  string
+	allow bool
+}
+
+type robotsPolicy struct {
+	rules    []robotsRule
+	sitemaps []string
+}
+
+// allowed applies the longest matching rule, allow wins ties, as specified by RFC 9309
+func (p robotsPolicy) allowed(path string) bool {
+	var best = -1
+	var allow = true
+	for _, rule := range p.rules {
+		if !robotsMatch(rule.path, path) {
+			continue
+		}
+		if len(rule.path) > best || (len(rule.path) == best && rule.allow) {
+			best = len(rule.path)
+			allow = rule.allow
+		}
+	}
+	return allow
+}
+
+func robotsMatch(pattern, path string) bool {
+	var anchored = strings.HasSuffix(pattern, "$")
+	pattern = strings.TrimSuffix(pattern, "$")
+
+	var expression = "^" + strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*")
+	if anchored {
+		expression += "$"
+	}
+	var matched, _ = regexp.MatchString(expression, path)
+	return matched
+}
+
+// fetchRobots reads the rules of the group matching the user agent, or of the * group. A missing robots.txt
+// allows everything.
+func fetchRobots(ctx context.Context, opts SitemapOptions, robotsURL string) (robotsPolicy, error) {
+	var result robotsPolicy
+
+	var request, reqErr = http.NewRequestWithContext(ctx, http.MethodGet, robotsURL, nil)
+	if reqErr != nil {
+		return result, fmt.Errorf("failed to create request: %v", reqErr)
+	}
+	request.Header.Set("User-Agent", opts.UserAgent)
+
+	var response, respErr = opts.HTTPClient.Do(request)
+	if respErr != nil {
+		return result, fmt.Errorf("failed to fetch %s: %v", robotsURL, respErr)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 400 && response.StatusCode < 500 {
+		return result, nil
+	}
+	if response.StatusCode != http.StatusOK {
+		return result, fmt.Errorf("failed to fetch %s: %s", robotsURL, response.Status)
+	}
+
+	var token = strings.ToLower(strings.SplitN(opts.UserAgent, "/", 2)[0])
+	var groups = map[string][]robotsRule{}
+	var agents []string
+	var inRules bool
+
+	var scanner = bufio.NewScanner(io.LimitReader(response.Body, maxDocumentSize))
+	for scanner.Scan() {
+		var line = strings.TrimSpace(strings.SplitN(scanner.Text(), "#", 2)[0])
+		var key, value, found = strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "user-agent":
+			if inRules {
+				agents = nil
+				inRules = false
+			}
+			agents = append(agents, strings.ToLower(value))
+			for _, agent := range agents {
+				if _, exists := groups[agent]; !exists {
+					groups[agent] = nil
+				}
+			}
+		case "allow", "disallow":
+			inRules = true
+			if funk.IsEmpty(value) {
+				continue
+			}
+			for _, agent := range agents {
+				groups[agent] = append(groups[agent], robotsRule{path: value, allow: key == "allow"})
+			}
+		case "sitemap":
+			result.sitemaps = append(result.sitemaps, value)
+		}
+	}
+
+	if rules, found := groups[token]; found {
+		result.rules = rules
+	} else {
+		result.rules = groups["*"]
+	}
+	sort.SliceStable(result.rules, func(i, j int) bool {
+		return len(result.rules[i].path) > len(result.rules[j].path)
+	})
+
+	return result, nil
+}
+
+func fetch(ctx context.Context, opts SitemapOptions, target string) (io.ReadCloser, error) {
+	var request, reqErr = http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if reqErr != nil {
+		return nil, fmt.Errorf("failed to create request: %v", reqErr)
+	}
+	request.Header.Set("User-Agent", opts.UserAgent)
+
+	var response, respErr = opts.HTTPClient.Do(request)
+	if respErr != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %v", target, respErr)
+	}
+	if response.StatusCode != http.StatusOK {
+		_ = response.Body.Close()
+		return nil, fmt.Errorf("failed to fetch %s: %s", target, response.Status)
+	}
+
+	return response.Body, nil
+}
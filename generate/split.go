@@ -0,0 +1,118 @@
+package generate
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+// SplitSeparator joins the service and deployment names of split monitors, "checkout / canary"
+const SplitSeparator = " / "
+
+// Deployment is one URL a service is served from while its traffic is split: a canary, the blue or green stack, a
+// regional endpoint
+type Deployment struct {
+	Name string
+	URL  string
+}
+
+type SplitOptions struct {
+	Service     string
+	Deployments []Deployment
+
+	// Monitor group of all split monitors, optional
+	GroupID string
+
+	// Attributes of every monitor, monitor_type defaults to status
+	Template client.Monitor
+}
+
+// Collapse tells how to end a split: Keep is the surviving monitor renamed to the service, Delete the others
+type Collapse struct {
+	Keep   client.Monitor
+	Delete []client.Monitor
+}
+
+// SplitName names the monitor of a deployment of the service
+func SplitName(service, deployment string) string {
+	return service + SplitSeparator + deployment
+}
+
+// ExpandSplit creates one monitor per deployment of the service, named with SplitName and sharing the template and
+// group. Feed them to a reconcile source owning the service name prefix to roll the split out.
+func ExpandSplit(opts SplitOptions) ([]client.Monitor, error) {
+	if funk.IsEmpty(strings.TrimSpace(opts.Service)) {
+		return nil, errors.New("a service name is required")
+	}
+	if len(opts.Deployments) == 0 {
+		return nil, fmt.Errorf("service %s has no deployments", opts.Service)
+	}
+
+	var monitorType = opts.Template.MonitorType
+	if funk.IsEmpty(monitorType) {
+		monitorType = client.MonitorTypeStatus
+	}
+
+	var seen = map[string]bool{}
+	var result []client.Monitor
+	for _, deployment := range opts.Deployments {
+		if funk.IsEmpty(deployment.Name) || funk.IsEmpty(deployment.URL) {
+			return nil, fmt.Errorf("deployment of service %s needs a name and a URL", opts.Service)
+		}
+		if seen[deployment.Name] {
+			return nil, fmt.Errorf("deployment %s of service %s is listed twice", deployment.Name, opts.Service)
+		}
+		seen[deployment.Name] = true
+
+		var monitor = fromTemplate(opts.Template, monitorType, SplitName(opts.Service, deployment.Name), deployment.URL)
+		if funk.NotEmpty(opts.GroupID) {
+			monitor.MonitorGroupID = opts.GroupID
+		}
+		result = append(result, monitor)
+	}
+
+	return result, nil
+}
+
+// SplitMembers returns the monitors of the service split, by deployment name
+func SplitMembers(service string, monitors []client.Monitor) map[string]client.Monitor {
+	var result = map[string]client.Monitor{}
+	var prefix = service + SplitSeparator
+	for _, monitor := range monitors {
+		if strings.HasPrefix(monitor.PronounceableName, prefix) {
+			result[strings.TrimPrefix(monitor.PronounceableName, prefix)] = monitor
+		}
+	}
+	return result
+}
+
+// CollapseSplit ends the split of the service: the monitor of the surviving deployment is kept under the plain
+// service name, so its history stays, and the other split monitors are to be deleted
+func CollapseSplit(service string, monitors []client.Monitor, survivor string) (Collapse, error) {
+	var result Collapse
+	var members = SplitMembers(service, monitors)
+
+	var keep, found = members[survivor]
+	if !found {
+		var names = funk.Keys(members).([]string)
+		sort.Strings(names)
+		return result, fmt.Errorf("service %s has no deployment %s, deployments: %v", service, survivor, names)
+	}
+	keep.PronounceableName = service
+	result.Keep = keep
+
+	for name, monitor := range members {
+		if name != survivor {
+			result.Delete = append(result.Delete, monitor)
+		}
+	}
+	sort.Slice(result.Delete, func(i, j int) bool {
+		return result.Delete[i].PronounceableName < result.Delete[j].PronounceableName
+	})
+
+	return result, nil
+}
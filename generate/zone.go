@@ -0,0 +1,226 @@
+package generate
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+// Record is a resource record of a DNS zone with a fully qualified name (without the trailing dot)
+type Record struct {
+	Name  string
+	Type  string
+	TTL   int
+	Value string
+}
+
+type ZoneOptions struct {
+	// Ping monitor for every name with an A or AAAA record
+	Ping bool
+
+	// HTTPS status monitor for every name with an A, AAAA or CNAME record
+	Status bool
+
+	// DNS monitor for every name server of the zone, querying the zone origin
+	DNS bool
+
+	// Records the generator considers, all when nil
+	Include func(record Record) bool
+
+	// Base of every generated monitor (regions, alerting, frequency...)
+	Template client.Monitor
+}
+
+var recordTypes = []string{"A", "AAAA", "CNAME", "NS", "MX", "TXT", "SOA", "SRV", "CAA", "PTR"}
+
+// ParseZone reads a zone in BIND master file format. Relative names are completed with origin, which $ORIGIN
+// directives override. $INCLUDE is not supported.
+func ParseZone(r io.Reader, origin string) ([]Record, error) {
+	var result []Record
+	var scanner = bufio.NewScanner(r)
+	var defaultTTL int
+	var previousOwner string
+	var pending string
+	var firstLine string
+	var depth int
+	var lineNumber int
+
+	origin = strings.TrimSuffix(origin, ".")
+
+	for scanner.Scan() {
+		lineNumber++
+		var line = stripComment(scanner.Text())
+		if funk.IsEmpty(strings.TrimSpace(pending)) {
+			firstLine = line
+		}
+
+		depth += strings.Count(line, "(") - strings.Count(line, ")")
+		pending += " " + strings.NewReplacer("(", " ", ")", " ").Replace(line)
+		if depth > 0 {
+			continue
+		}
+
+		var raw = pending
+		pending = client.Blanc
+		if strings.TrimSpace(raw) == client.Blanc {
+			continue
+		}
+
+		// Lines with an empty owner inherit the owner of the previous record
+		var inherited = strings.HasPrefix(firstLine, " ") || strings.HasPrefix(firstLine, "\t")
+		var fields = strings.Fields(raw)
+
+		switch strings.ToUpper(fields[0]) {
+		case "$ORIGIN":
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("line %d: $ORIGIN without a name", lineNumber)
+			}
+			origin = qualify(fields[1], origin)
+			continue
+		case "$TTL":
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("line %d: $TTL without a value", lineNumber)
+			}
+			var ttl, ttlErr = strconv.Atoi(fields[1])
+			if ttlErr != nil {
+				return nil, fmt.Errorf("line %d: invalid $TTL: %v", lineNumber, ttlErr)
+			}
+			defaultTTL = ttl
+			continue
+		case "$INCLUDE":
+			return nil, fmt.Errorf("line %d: $INCLUDE is not supported", lineNumber)
+		}
+
+		var owner = previousOwner
+		if !inherited {
+			owner = qualify(fields[0], origin)
+			fields = fields[1:]
+		}
+		previousOwner = owner
+
+		var record = Record{Name: owner, TTL: defaultTTL}
+		for len(fields) > 0 && funk.IsEmpty(record.Type) {
+			var field = strings.ToUpper(fields[0])
+			switch {
+			case field == "IN" || field == "CH" || field == "HS":
+			case funk.ContainsString(recordTypes, field):
+				record.Type = field
+			default:
+				var ttl, ttlErr = strconv.Atoi(field)
+				if ttlErr != nil {
+					return nil, fmt.Errorf("line %d: unknown record type %q", lineNumber, fields[0])
+				}
+				record.TTL = ttl
+			}
+			fields = fields[1:]
+		}
+		if funk.IsEmpty(record.Type) || len(fields) == 0 {
+			return nil, fmt.Errorf("line %d: incomplete record", lineNumber)
+		}
+
+		switch record.Type {
+		case "CNAME", "NS", "PTR":
+			record.Value = qualify(fields[0], origin)
+		case "MX":
+			record.Value = qualify(fields[len(fields)-1], origin)
+		default:
+			record.Value = strings.Join(fields, " ")
+		}
+
+		result = append(result, record)
+	}
+
+	if scanErr := scanner.Err(); scanErr != nil {
+		return nil, fmt.Errorf("failed to read zone: %v", scanErr)
+	}
+	if depth > 0 {
+		return nil, fmt.Errorf("unbalanced parentheses at end of zone")
+	}
+
+	return result, nil
+}
+
+// MonitorsFromRecords turns zone records into monitors as selected by opts. The zone origin is inferred from the
+// SOA record for DNS monitors.
+func MonitorsFromRecords(records []Record, opts ZoneOptions) []client.Monitor {
+	var result []client.Monitor
+	var origin string
+	for _, record := range records {
+		if record.Type == "SOA" {
+			origin = record.Name
+		}
+	}
+
+	for _, record := range records {
+		if strings.HasPrefix(record.Name, "*") {
+			continue
+		}
+		if opts.Include != nil && !opts.Include(record) {
+			continue
+		}
+
+		switch record.Type {
+		case "A", "AAAA":
+			if opts.Ping {
+				result = append(result, fromTemplate(opts.Template, client.MonitorTypePing,
+					fmt.Sprintf("%s ping", record.Name), record.Name))
+			}
+			if opts.Status {
+				result = append(result, fromTemplate(opts.Template, client.MonitorTypeStatus,
+					record.Name, "https://"+record.Name))
+			}
+		case "CNAME":
+			if opts.Status {
+				result = append(result, fromTemplate(opts.Template, client.MonitorTypeStatus,
+					record.Name, "https://"+record.Name))
+			}
+		case "NS":
+			if opts.DNS {
+				var query = origin
+				if funk.IsEmpty(query) {
+					query = record.Name
+				}
+				var monitor = fromTemplate(opts.Template, client.MonitorTypeDNS,
+					fmt.Sprintf("%s dns %s", query, record.Value), record.Value)
+				monitor.RequestBody = query
+				result = append(result, monitor)
+			}
+		}
+	}
+
+	return dedupe(result)
+}
+
+func qualify(name, origin string) string {
+	if name == "@" {
+		return origin
+	}
+	if strings.HasSuffix(name, ".") {
+		return strings.ToLower(strings.TrimSuffix(name, "."))
+	}
+	if funk.IsEmpty(origin) {
+		return strings.ToLower(name)
+	}
+	return strings.ToLower(name + "." + origin)
+}
+
+// stripComment removes a trailing ; comment, ignoring semicolons inside quoted strings
+func stripComment(line string) string {
+	var quoted bool
+	for i, char := range line {
+		switch char {
+		case '"':
+			quoted = !quoted
+		case ';':
+			if !quoted {
+				return line[:i]
+			}
+		}
+	}
+	return line
+}
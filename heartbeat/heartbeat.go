@@ -0,0 +1,149 @@
+package heartbeat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+const BaseURL = "https://uptime.betterstack.com/api/v1/heartbeat/"
+
+const DefaultTimeout = 10 * time.Second
+
+// Exit code reported for errors without an ExitCode method
+const DefaultExitCode = 1
+
+// Exit code reported when the job panics
+const PanicExitCode = 2
+
+// Error output beyond this is cut, heartbeat bodies are meant for a summary
+const maxMessageSize = 10 << 10
+
+// Heartbeat pings a Better Stack heartbeat. Success pings the URL, failures ping URL/<exit code> with the error as
+// body, and with Start set URL/start marks the beginning of a run so the duration of every run is tracked.
+type Heartbeat struct {
+	URL string
+
+	// Send URL/start before running the job
+	Start bool
+
+	// Defaults to a client with DefaultTimeout
+	HTTPClient *http.Client
+
+	// Receives the failing pings of Wrap, defaults to logrus
+	Logger client.Logger
+}
+
+// New returns a heartbeat for the heartbeat token, the last path segment of the heartbeat URL
+func New(token string) *Heartbeat {
+	return &Heartbeat{URL: BaseURL + token}
+}
+
+// exitCoder is implemented by errors carrying a process exit code, such as *exec.ExitError
+type exitCoder interface {
+	ExitCode() int
+}
+
+// Wrap runs the job and reports its outcome to the heartbeat at url, see Heartbeat.Wrap
+func Wrap(url string, job func() error) error {
+	return (&Heartbeat{URL: url}).Wrap(context.Background(), job)
+}
+
+// Wrap runs the job and reports its outcome: a ping on success, a failure with the exit code and error otherwise.
+// Errors with an ExitCode method report that code, others DefaultExitCode. A panic is reported with
+// PanicExitCode and the stack, then re-raised. Failing pings are logged but never fail the job, the job error is
+// returned as is.
+func (h *Heartbeat) Wrap(ctx context.Context, job func() error) (jobErr error) {
+	if h.Start {
+		if startErr := h.send(ctx, "start", client.Blanc); startErr != nil {
+			client.LoggerOrDefault(h.Logger).Warn("failed to signal heartbeat start", "error", startErr)
+		}
+	}
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			var message = fmt.Sprintf("panic: %v\n\n%s", recovered, debug.Stack())
+			if failErr := h.Fail(ctx, PanicExitCode, message); failErr != nil {
+				client.LoggerOrDefault(h.Logger).Warn("failed to report heartbeat failure", "error", failErr)
+			}
+			panic(recovered)
+		}
+	}()
+
+	jobErr = job()
+	if jobErr == nil {
+		if pingErr := h.Ping(ctx); pingErr != nil {
+			client.LoggerOrDefault(h.Logger).Warn("failed to ping heartbeat", "error", pingErr)
+		}
+		return nil
+	}
+
+	var exitCode = DefaultExitCode
+	var coder exitCoder
+	if errors.As(jobErr, &coder) && coder.ExitCode() > 0 {
+		exitCode = coder.ExitCode()
+	}
+	if failErr := h.Fail(ctx, exitCode, jobErr.Error()); failErr != nil {
+		client.LoggerOrDefault(h.Logger).Warn("failed to report heartbeat failure", "error", failErr)
+	}
+	return jobErr
+}
+
+// Ping reports a successful run
+func (h *Heartbeat) Ping(ctx context.Context) error {
+	return h.send(ctx, client.Blanc, client.Blanc)
+}
+
+// Fail reports a failed run with the exit code, message is shown with the incident
+func (h *Heartbeat) Fail(ctx context.Context, exitCode int, message string) error {
+	if exitCode <= 0 {
+		exitCode = DefaultExitCode
+	}
+	if len(message) > maxMessageSize {
+		message = message[:maxMessageSize]
+	}
+	return h.send(ctx, strconv.Itoa(exitCode), message)
+}
+
+func (h *Heartbeat) send(ctx context.Context, suffix, body string) error {
+	if funk.IsEmpty(h.URL) {
+		return errors.New("heartbeat URL is not set")
+	}
+	var target = strings.TrimSuffix(h.URL, "/")
+	if suffix != client.Blanc {
+		target += "/" + suffix
+	}
+
+	var request, requestErr = http.NewRequestWithContext(ctx, http.MethodPost, target, strings.NewReader(body))
+	if requestErr != nil {
+		return fmt.Errorf("failed to create request: %v", requestErr)
+	}
+	if body != client.Blanc {
+		request.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	}
+
+	var httpClient = h.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: DefaultTimeout}
+	}
+	var response, responseErr = httpClient.Do(request)
+	if responseErr != nil {
+		return fmt.Errorf("failed to execute request: %v", responseErr)
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("failed to execute request: %v", response.Status)
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+type Severity string
+
+const SeverityWarning Severity = "warning"
+const SeverityError Severity = "error"
+
+// DefaultMinCheckFrequency is the lowest check frequency (in seconds) available on paid plans
+const DefaultMinCheckFrequency = 30
+
+// Rule is a single best-practice check. Check returns one message per violation found on the monitor.
+type Rule struct {
+	Name     string
+	Severity Severity
+	Check    func(monitor client.Monitor) []string
+}
+
+type Finding struct {
+	Monitor  client.Monitor
+	Rule     string
+	Severity Severity
+	Message  string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s [%s]: %s", f.Severity, describe(f.Monitor), f.Rule, f.Message)
+}
+
+type Findings []Finding
+
+// HasErrors reports whether any finding has error severity, which is what a CI gate should fail on
+func (f Findings) HasErrors() bool {
+	for _, finding := range f {
+		if finding.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Lint checks every monitor against the rules, DefaultRules() are used when no rules are given
+func Lint(monitors []client.Monitor, rules ...Rule) Findings {
+	if len(rules) == 0 {
+		rules = DefaultRules()
+	}
+
+	var result Findings
+	for _, monitor := range monitors {
+		for _, rule := range rules {
+			for _, message := range rule.Check(monitor) {
+				result = append(result, Finding{
+					Monitor:  monitor,
+					Rule:     rule.Name,
+					Severity: rule.Severity,
+					Message:  message,
+				})
+			}
+		}
+	}
+
+	return result
+}
+
+func DefaultRules() []Rule {
+	return []Rule{
+		MissingRegions(),
+		MissingRecoveryPeriod(),
+		MinCheckFrequency(DefaultMinCheckFrequency),
+		MissingSSLExpiration(),
+		KeywordWithoutRequiredKeyword(),
+		UnusedRequiredKeyword(),
+		KeywordLooksLikePattern(),
+		InvalidMaintenanceTimezone(),
+		InvalidExpectedStatusCodes(),
+		InvalidRequestBody(),
+	}
+}
+
+func MissingRegions() Rule {
+	return Rule{
+		Name:     "missing-regions",
+		Severity: SeverityWarning,
+		Check: func(monitor client.Monitor) []string {
+			if len(monitor.Regions) == 0 {
+				return []string{"no regions set, checks run from the account default regions only"}
+			}
+			return nil
+		},
+	}
+}
+
+func MissingRecoveryPeriod() Rule {
+	return Rule{
+		Name:     "missing-recovery-period",
+		Severity: SeverityWarning,
+		Check: func(monitor client.Monitor) []string {
+			if monitor.RecoveryPeriod == 0 {
+				return []string{"recovery_period is not set, flapping endpoints will resolve and reopen incidents"}
+			}
+			return nil
+		},
+	}
+}
+
+// MinCheckFrequency flags monitors checking more often than the plan allows, minSeconds is the plan floor
+func MinCheckFrequency(minSeconds int) Rule {
+	return Rule{
+		Name:     "check-frequency-below-plan",
+		Severity: SeverityError,
+		Check: func(monitor client.Monitor) []string {
+			if monitor.CheckFrequency != 0 && monitor.CheckFrequency < minSeconds {
+				return []string{fmt.Sprintf("check_frequency %ds is below the plan limit of %ds",
+					monitor.CheckFrequency, minSeconds)}
+			}
+			return nil
+		},
+	}
+}
+
+func MissingSSLExpiration() Rule {
+	return Rule{
+		Name:     "missing-ssl-expiration",
+		Severity: SeverityWarning,
+		Check: func(monitor client.Monitor) []string {
+			if isHTTPMonitor(monitor) && strings.HasPrefix(strings.ToLower(monitor.URL), "https://") &&
+				monitor.SSLExpiration == 0 {
+				return []string{"https endpoint without ssl_expiration alert"}
+			}
+			return nil
+		},
+	}
+}
+
+func KeywordWithoutRequiredKeyword() Rule {
+	return Rule{
+		Name:     "keyword-without-required-keyword",
+		Severity: SeverityError,
+		Check: func(monitor client.Monitor) []string {
+			if client.UsesKeyword(monitor.MonitorType) && funk.IsEmpty(monitor.RequiredKeyword) {
+				return []string{fmt.Sprintf("monitor_type %s requires required_keyword", monitor.MonitorType)}
+			}
+			return nil
+		},
+	}
+}
+
+// UnusedRequiredKeyword flags required_keyword on monitor types which ignore it, the API rejects them
+func UnusedRequiredKeyword() Rule {
+	return Rule{
+		Name:     "unused-required-keyword",
+		Severity: SeverityError,
+		Check: func(monitor client.Monitor) []string {
+			if funk.NotEmpty(monitor.MonitorType) && !client.UsesKeyword(monitor.MonitorType) && funk.NotEmpty(monitor.RequiredKeyword) {
+				return []string{fmt.Sprintf("monitor_type %s does not support required_keyword", monitor.MonitorType)}
+			}
+			return nil
+		},
+	}
+}
+
+// KeywordLooksLikePattern flags keywords written as regular expressions, the API matches them literally
+func KeywordLooksLikePattern() Rule {
+	return Rule{
+		Name:     "keyword-looks-like-pattern",
+		Severity: SeverityWarning,
+		Check: func(monitor client.Monitor) []string {
+			if client.UsesKeyword(monitor.MonitorType) && client.LooksLikePattern(monitor.RequiredKeyword) {
+				return []string{fmt.Sprintf("required_keyword %q looks like a regular expression, it is matched literally", monitor.RequiredKeyword)}
+			}
+			return nil
+		},
+	}
+}
+
+// InvalidMaintenanceTimezone flags maintenance_timezone values the API rejects, most commonly IANA names like
+// Europe/Berlin where the Rails name Berlin is expected
+func InvalidMaintenanceTimezone() Rule {
+	return Rule{
+		Name:     "invalid-maintenance-timezone",
+		Severity: SeverityError,
+		Check: func(monitor client.Monitor) []string {
+			if validateErr := client.ValidateTimezone(monitor.MaintenanceTimezone); validateErr != nil {
+				return []string{validateErr.Error()}
+			}
+			return nil
+		},
+	}
+}
+
+func isHTTPMonitor(monitor client.Monitor) bool {
+	switch monitor.MonitorType {
+	case client.MonitorTypeStatus, client.MonitorTypeStatusCode, client.MonitorTypeExpectedStatusCode,
+		client.MonitorTypeKeyword, client.MonitorTypeKeywordAbsence:
+		return true
+	}
+	return false
+}
+
+func describe(monitor client.Monitor) string {
+	if funk.NotEmpty(monitor.PronounceableName) {
+		return monitor.PronounceableName
+	}
+	if funk.NotEmpty(monitor.ID) {
+		return monitor.ID
+	}
+	return monitor.URL
+}
+
+// InvalidExpectedStatusCodes flags expected_status_code monitors without codes and codes the API rejects
+func InvalidExpectedStatusCodes() Rule {
+	return Rule{
+		Name:     "invalid-expected-status-codes",
+		Severity: SeverityError,
+		Check: func(monitor client.Monitor) []string {
+			if monitor.MonitorType == client.MonitorTypeExpectedStatusCode && len(monitor.ExpectedStatusCodes) == 0 {
+				return []string{"expected_status_code monitor without expected_status_codes"}
+			}
+			if _, codesErr := client.NormalizeStatusCodes(monitor.ExpectedStatusCodes); codesErr != nil {
+				return []string{codesErr.Error()}
+			}
+			return nil
+		},
+	}
+}
+
+// InvalidRequestBody flags bodies the check wouldn't send, e.g. with http_method GET, and dns monitors without the
+// domain to query
+func InvalidRequestBody() Rule {
+	return Rule{
+		Name:     "invalid-request-body",
+		Severity: SeverityError,
+		Check: func(monitor client.Monitor) []string {
+			if bodyErr := client.ValidateRequestBody(monitor); bodyErr != nil {
+				return []string{bodyErr.Error()}
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,226 @@
+package naming
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+const DefaultInterval = 500 * time.Millisecond
+
+var placeholder = regexp.MustCompile(`<([A-Za-z0-9_-]+)>`)
+
+// Policy is a naming convention like "<team>/<service>/<check>": placeholders in angle brackets, everything else
+// literal. Values may not contain the literals of the pattern, so names always parse back unambiguously.
+type Policy struct {
+	Pattern string
+
+	// Names of the placeholders, in pattern order
+	Fields []string
+
+	// Lowercase values before formatting, so legacy names converge on one spelling
+	Lowercase bool
+
+	literals []string
+	parser   *regexp.Regexp
+}
+
+// DeriveFunc extracts the placeholder values of a monitor which does not follow the convention yet, e.g. from its
+// group, metadata or legacy name
+type DeriveFunc func(monitor client.Monitor) (map[string]string, error)
+
+// Rename is a planned rename, the preview a dry run shows
+type Rename struct {
+	Monitor client.Monitor
+	From    string
+	To      string
+}
+
+type Result struct {
+	Renamed []Rename
+	Failed  map[string]error
+}
+
+func NewPolicy(pattern string) (*Policy, error) {
+	var matches = placeholder.FindAllStringSubmatchIndex(pattern, -1)
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("pattern %q has no <placeholder>", pattern)
+	}
+
+	var policy = &Policy{Pattern: pattern}
+	var expression strings.Builder
+	expression.WriteString("^")
+	var last = 0
+	for i, match := range matches {
+		var literal = pattern[last:match[0]]
+		if i > 0 && literal == client.Blanc {
+			return nil, fmt.Errorf("placeholders of pattern %q need a separator between them", pattern)
+		}
+		if literal != client.Blanc {
+			policy.literals = append(policy.literals, literal)
+		}
+		var field = pattern[match[2]:match[3]]
+		if funk.ContainsString(policy.Fields, field) {
+			return nil, fmt.Errorf("placeholder <%s> appears twice in pattern %q", field, pattern)
+		}
+		policy.Fields = append(policy.Fields, field)
+
+		expression.WriteString(regexp.QuoteMeta(literal))
+		expression.WriteString("(.+?)")
+		last = match[1]
+	}
+	if trailing := pattern[last:]; trailing != client.Blanc {
+		policy.literals = append(policy.literals, trailing)
+		expression.WriteString(regexp.QuoteMeta(trailing))
+	}
+	expression.WriteString("$")
+
+	policy.parser = regexp.MustCompile(expression.String())
+	return policy, nil
+}
+
+// Parse returns the placeholder values of a name following the convention
+func (p *Policy) Parse(name string) (map[string]string, bool) {
+	var match = p.parser.FindStringSubmatch(name)
+	if match == nil {
+		return nil, false
+	}
+	var result = map[string]string{}
+	for i, field := range p.Fields {
+		if validateErr := p.validateValue(field, match[i+1]); validateErr != nil {
+			return nil, false
+		}
+		result[field] = match[i+1]
+	}
+	return result, true
+}
+
+// Validate checks the name against the convention
+func (p *Policy) Validate(name string) error {
+	if _, ok := p.Parse(name); !ok {
+		return fmt.Errorf("name %q does not follow %s", name, p.Pattern)
+	}
+	return nil
+}
+
+// Format builds the name out of the placeholder values, all of them are required
+func (p *Policy) Format(values map[string]string) (string, error) {
+	var replacements = make([]string, 0, len(p.Fields)*2)
+	for _, field := range p.Fields {
+		var value = strings.TrimSpace(values[field])
+		if p.Lowercase {
+			value = strings.ToLower(value)
+		}
+		if validateErr := p.validateValue(field, value); validateErr != nil {
+			return client.Blanc, validateErr
+		}
+		replacements = append(replacements, "<"+field+">", value)
+	}
+	return strings.NewReplacer(replacements...).Replace(p.Pattern), nil
+}
+
+func (p *Policy) validateValue(field, value string) error {
+	if funk.IsEmpty(strings.TrimSpace(value)) {
+		return fmt.Errorf("<%s> is empty", field)
+	}
+	if p.Lowercase && value != strings.ToLower(value) {
+		return fmt.Errorf("<%s> value %q is not lowercase", field, value)
+	}
+	for _, literal := range p.literals {
+		if strings.Contains(value, literal) {
+			return fmt.Errorf("<%s> value %q contains %q", field, value, literal)
+		}
+	}
+	return nil
+}
+
+// Rename returns the conventional name of the monitor. Names already following the convention are kept as they are.
+func (p *Policy) Rename(monitor client.Monitor, derive DeriveFunc) (string, error) {
+	if p.Validate(monitor.PronounceableName) == nil {
+		return monitor.PronounceableName, nil
+	}
+	if derive == nil {
+		return client.Blanc, errors.New("no derive function for names outside the convention")
+	}
+	var values, deriveErr = derive(monitor)
+	if deriveErr != nil {
+		return client.Blanc, deriveErr
+	}
+	return p.Format(values)
+}
+
+// Plan lists the renames bringing the monitors into the convention without changing anything. Monitors whose name
+// can't be derived are returned by ID in the error map. Two monitors ending up with the same name are both
+// reported, the API would accept the duplicate but nobody could tell them apart.
+func (p *Policy) Plan(monitors []client.Monitor, derive DeriveFunc) ([]Rename, map[string]error) {
+	var result []Rename
+	var errs = map[string]error{}
+
+	var claimed = map[string]string{}
+	for _, monitor := range monitors {
+		if p.Validate(monitor.PronounceableName) == nil {
+			claimed[monitor.PronounceableName] = monitor.ID
+		}
+	}
+
+	for _, monitor := range monitors {
+		var name, renameErr = p.Rename(monitor, derive)
+		if renameErr != nil {
+			errs[monitor.ID] = renameErr
+			continue
+		}
+		if name == monitor.PronounceableName {
+			continue
+		}
+		if owner, taken := claimed[name]; taken {
+			errs[monitor.ID] = fmt.Errorf("%q is already the name of monitor %s", name, owner)
+			continue
+		}
+		claimed[name] = monitor.ID
+		result = append(result, Rename{Monitor: monitor, From: monitor.PronounceableName, To: name})
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].To < result[j].To })
+	return result, errs
+}
+
+// Apply executes the renames, at most one every interval (DefaultInterval when zero). It keeps going when single
+// renames fail. The full monitor is sent with the new name, so nothing else of it changes. Use a dry-run client to
+// preview the requests.
+func Apply(ctx context.Context, c *client.BetterstackClient, renames []Rename, interval time.Duration) (Result, error) {
+	var result = Result{Failed: map[string]error{}}
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	var ticker = time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for i, rename := range renames {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return result, ctx.Err()
+			case <-ticker.C:
+			}
+		}
+
+		var monitor = rename.Monitor
+		monitor.PronounceableName = rename.To
+		monitor.Status = client.Blanc
+		if _, updateErr := c.Monitors().Update(ctx, rename.Monitor.ID, monitor); updateErr != nil {
+			c.Logger().Warn("failed to rename monitor", "id", monitor.ID, "name", rename.To, "error", updateErr)
+			result.Failed[monitor.ID] = updateErr
+			continue
+		}
+		result.Renamed = append(result.Renamed, rename)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,180 @@
+package output
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	json "github.com/json-iterator/go"
+	"github.com/thoas/go-funk"
+	"gopkg.in/yaml.v3"
+)
+
+const FormatJSON = "json"
+const FormatYAML = "yaml"
+const FormatTable = "table"
+
+// Exit codes of commands, stable so pipelines can branch on them
+const ExitClean = 0
+const ExitError = 1
+const ExitDrift = 2
+const ExitPartialFailure = 3
+
+var Formats = []string{FormatJSON, FormatYAML, FormatTable}
+
+var ErrUnknownFormat = errors.New("unknown output format")
+
+// ParseFormat validates the value of an --output flag, empty means table
+func ParseFormat(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case FormatJSON:
+		return FormatJSON, nil
+	case FormatYAML, "yml":
+		return FormatYAML, nil
+	case FormatTable, "":
+		return FormatTable, nil
+	}
+	return "", fmt.Errorf("%w: %q, expected one of %s", ErrUnknownFormat, value, strings.Join(Formats, "|"))
+}
+
+// ExitCode maps the outcome of a command. A partial failure wins over drift, drift over a clean run.
+func ExitCode(drift bool, failures int) int {
+	switch {
+	case failures > 0:
+		return ExitPartialFailure
+	case drift:
+		return ExitDrift
+	}
+	return ExitClean
+}
+
+// Write renders v in format. JSON and YAML follow the json tags of v, so the schema is the one of the API models.
+// Tables render a struct or a slice of structs, one row per element and a column per scalar field, or per
+// listed column (json names) when columns are given.
+func Write(w io.Writer, format string, v any, columns ...string) error {
+	switch format {
+	case FormatJSON:
+		var encoder = json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(v)
+	case FormatYAML:
+		// Round trip so YAML keys are the json tags
+		var serialized, serErr = json.Marshal(v)
+		if serErr != nil {
+			return fmt.Errorf("failed to serialize output: %v", serErr)
+		}
+		var generic any
+		if unmErr := yaml.Unmarshal(serialized, &generic); unmErr != nil {
+			return fmt.Errorf("failed to serialize output: %v", unmErr)
+		}
+		var encoder = yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if encErr := encoder.Encode(generic); encErr != nil {
+			return encErr
+		}
+		return encoder.Close()
+	case FormatTable:
+		return writeTable(w, v, columns)
+	}
+	return fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+}
+
+func writeTable(w io.Writer, v any, selected []string) error {
+	var value = reflect.Indirect(reflect.ValueOf(v))
+	var rows []reflect.Value
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			rows = append(rows, reflect.Indirect(value.Index(i)))
+		}
+	case reflect.Struct:
+		rows = append(rows, value)
+	default:
+		var _, writeErr = fmt.Fprintln(w, v)
+		return writeErr
+	}
+
+	var elem = value.Type()
+	if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+		elem = elem.Elem()
+	}
+	for elem.Kind() == reflect.Pointer {
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		for _, row := range rows {
+			if _, writeErr := fmt.Fprintln(w, row.Interface()); writeErr != nil {
+				return writeErr
+			}
+		}
+		return nil
+	}
+
+	var columns []int
+	var headers []string
+	for i := 0; i < elem.NumField(); i++ {
+		var field = elem.Field(i)
+		if !field.IsExported() || !scalar(field.Type) {
+			continue
+		}
+		var name = strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if len(selected) > 0 && !funk.ContainsString(selected, name) {
+			continue
+		}
+		columns = append(columns, i)
+		headers = append(headers, strings.ToUpper(name))
+	}
+
+	var table = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, strings.Join(headers, "\t"))
+	for _, row := range rows {
+		if !row.IsValid() {
+			continue
+		}
+		var cells = make([]string, 0, len(columns))
+		for _, column := range columns {
+			cells = append(cells, cell(row.Field(column)))
+		}
+		fmt.Fprintln(table, strings.Join(cells, "\t"))
+	}
+	return table.Flush()
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func scalar(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == timeType {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64,
+		reflect.Interface:
+		return true
+	}
+	return false
+}
+
+func cell(value reflect.Value) string {
+	if (value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface) && value.IsNil() {
+		return "-"
+	}
+	var v = reflect.Indirect(value).Interface()
+	if t, ok := v.(time.Time); ok {
+		return t.Format(time.RFC3339)
+	}
+	return fmt.Sprint(v)
+}
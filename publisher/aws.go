@@ -0,0 +1,77 @@
+package publisher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/qameta/betterstack/webhooks"
+	"github.com/thoas/go-funk"
+)
+
+// SNSAPI publishes a message to an SNS topic. It is satisfied by a thin adapter over the SNS client of the AWS SDK,
+// so the SDK stays a dependency of the application rather than of this module.
+type SNSAPI interface {
+	Publish(ctx context.Context, topicARN, message string, attributes map[string]string) error
+}
+
+// SQSAPI sends a message to an SQS queue. GroupID and DeduplicationID are only meaningful for FIFO queues.
+type SQSAPI interface {
+	SendMessage(ctx context.Context, queueURL, body string, attributes map[string]string, groupID, deduplicationID string) error
+}
+
+// SNSPublisher forwards incident events to an SNS topic as CloudEvents JSON messages
+type SNSPublisher struct {
+	API      SNSAPI
+	TopicARN string
+
+	// CloudEvents source attribute, defaults to DefaultSource
+	Source string
+
+	// Defaults to DefaultAttributes
+	Attributes AttributesFunc
+}
+
+func (p *SNSPublisher) Handle(ctx context.Context, event webhooks.Event) error {
+	var _, message, encErr = encode(event, p.Source)
+	if encErr != nil {
+		return fmt.Errorf("failed to encode event: %v", encErr)
+	}
+
+	if pubErr := p.API.Publish(ctx, p.TopicARN, string(message), attributes(p.Attributes, event)); pubErr != nil {
+		return fmt.Errorf("failed to publish to %s: %v", p.TopicARN, pubErr)
+	}
+
+	return nil
+}
+
+// SQSPublisher forwards incident events to an SQS queue as CloudEvents JSON messages. For FIFO queues messages are
+// grouped by monitor, so events of one monitor stay ordered, and deduplicated by the CloudEvents ID.
+type SQSPublisher struct {
+	API      SQSAPI
+	QueueURL string
+
+	// CloudEvents source attribute, defaults to DefaultSource
+	Source string
+
+	// Defaults to DefaultAttributes
+	Attributes AttributesFunc
+}
+
+func (p *SQSPublisher) Handle(ctx context.Context, event webhooks.Event) error {
+	var envelope, body, encErr = encode(event, p.Source)
+	if encErr != nil {
+		return fmt.Errorf("failed to encode event: %v", encErr)
+	}
+
+	var groupID = event.MonitorID
+	if funk.IsEmpty(groupID) {
+		groupID = event.Incident.ID
+	}
+
+	var sendErr = p.API.SendMessage(ctx, p.QueueURL, string(body), attributes(p.Attributes, event), groupID, envelope.ID)
+	if sendErr != nil {
+		return fmt.Errorf("failed to send to %s: %v", p.QueueURL, sendErr)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package publisher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/qameta/betterstack/webhooks"
+	"github.com/thoas/go-funk"
+)
+
+const DefaultKafkaTopicPrefix = "betterstack.incident."
+
+type KafkaMessage struct {
+	Topic   string
+	Key     []byte
+	Value   []byte
+	Headers map[string]string
+}
+
+// KafkaProducer writes a message to Kafka. It is satisfied by a thin adapter over the producer of the Kafka client
+// library in use (franz-go, segmentio/kafka-go, confluent-kafka-go).
+type KafkaProducer interface {
+	Produce(ctx context.Context, message KafkaMessage) error
+}
+
+// KafkaPublisher forwards incident events to Kafka as CloudEvents JSON. Every event kind goes to its own topic and
+// messages are keyed by monitor ID, so all events of a monitor land on the same partition in order.
+type KafkaPublisher struct {
+	Producer KafkaProducer
+
+	// Topics are named TopicPrefix + event kind (started, acknowledged, resolved), defaults to
+	// DefaultKafkaTopicPrefix. Ignored when Topic is set.
+	TopicPrefix string
+
+	// Returns the topic of an event, overrides TopicPrefix
+	Topic func(event webhooks.Event) string
+
+	// CloudEvents source attribute, defaults to DefaultSource
+	Source string
+
+	// Message headers, defaults to DefaultAttributes
+	Headers AttributesFunc
+}
+
+func (p *KafkaPublisher) Handle(ctx context.Context, event webhooks.Event) error {
+	var _, value, encErr = encode(event, p.Source)
+	if encErr != nil {
+		return fmt.Errorf("failed to encode event: %v", encErr)
+	}
+
+	var key = event.MonitorID
+	if funk.IsEmpty(key) {
+		key = event.Incident.ID
+	}
+
+	var message = KafkaMessage{
+		Topic:   p.topic(event),
+		Key:     []byte(key),
+		Value:   value,
+		Headers: attributes(p.Headers, event),
+	}
+
+	if prodErr := p.Producer.Produce(ctx, message); prodErr != nil {
+		return fmt.Errorf("failed to produce to %s: %v", message.Topic, prodErr)
+	}
+
+	return nil
+}
+
+func (p *KafkaPublisher) topic(event webhooks.Event) string {
+	if p.Topic != nil {
+		return p.Topic(event)
+	}
+	var prefix = p.TopicPrefix
+	if funk.IsEmpty(prefix) {
+		prefix = DefaultKafkaTopicPrefix
+	}
+	return prefix + event.Kind()
+}
@@ -0,0 +1,139 @@
+package publisher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/qameta/betterstack/webhooks"
+	"github.com/thoas/go-funk"
+)
+
+const DefaultNATSSubject = "betterstack.incident.{{ .Kind }}"
+
+// Characters with special meaning in NATS subjects, replaced in templated values
+var subjectTokenEscaper = strings.NewReplacer(".", "_", "*", "_", ">", "_", " ", "_", "\t", "_")
+
+// NATSConn publishes a core NATS message, *nats.Conn satisfies it as is
+type NATSConn interface {
+	Publish(subject string, data []byte) error
+}
+
+// JetStreamAPI publishes a message to a JetStream stream and waits for the acknowledgement. MsgID is sent as the
+// Nats-Msg-Id header so the stream deduplicates redeliveries. It is satisfied by a thin adapter over jetstream.JetStream.
+type JetStreamAPI interface {
+	PublishMsg(ctx context.Context, subject string, data []byte, headers map[string]string, msgID string) error
+}
+
+// SubjectData is what subject templates are rendered with. Values are escaped to form a single subject token.
+type SubjectData struct {
+	Kind       string
+	IncidentID string
+	MonitorID  string
+	TeamName   string
+}
+
+// NATSPublisher forwards incident events to NATS as CloudEvents JSON. With JetStream set messages are persisted by
+// the stream and acknowledged, otherwise they are published over core NATS via Conn.
+type NATSPublisher struct {
+	Conn      NATSConn
+	JetStream JetStreamAPI
+
+	// Subject template, defaults to DefaultNATSSubject
+	Subject string
+
+	// CloudEvents source attribute, defaults to DefaultSource
+	Source string
+
+	// JetStream message headers, defaults to DefaultAttributes. Core NATS messages carry no headers.
+	Headers AttributesFunc
+
+	subject *template.Template
+}
+
+func NewNATSPublisher(conn NATSConn, subject string) (*NATSPublisher, error) {
+	var publisher = &NATSPublisher{Conn: conn, Subject: subject}
+	var parsed, parseErr = parseSubject(subject)
+	publisher.subject = parsed
+	return publisher, parseErr
+}
+
+func NewJetStreamPublisher(js JetStreamAPI, subject string) (*NATSPublisher, error) {
+	var publisher = &NATSPublisher{JetStream: js, Subject: subject}
+	var parsed, parseErr = parseSubject(subject)
+	publisher.subject = parsed
+	return publisher, parseErr
+}
+
+func (p *NATSPublisher) Handle(ctx context.Context, event webhooks.Event) error {
+	// Publishers built as struct literals parse their template on every event
+	var subjectTemplate = p.subject
+	if subjectTemplate == nil {
+		var parseErr error
+		if subjectTemplate, parseErr = parseSubject(p.Subject); parseErr != nil {
+			return parseErr
+		}
+	}
+
+	var subject, subjErr = render(subjectTemplate, event)
+	if subjErr != nil {
+		return subjErr
+	}
+
+	var envelope, data, encErr = encode(event, p.Source)
+	if encErr != nil {
+		return fmt.Errorf("failed to encode event: %v", encErr)
+	}
+
+	switch {
+	case p.JetStream != nil:
+		if pubErr := p.JetStream.PublishMsg(ctx, subject, data, attributes(p.Headers, event), envelope.ID); pubErr != nil {
+			return fmt.Errorf("failed to publish to stream subject %s: %v", subject, pubErr)
+		}
+	case p.Conn != nil:
+		if pubErr := p.Conn.Publish(subject, data); pubErr != nil {
+			return fmt.Errorf("failed to publish to %s: %v", subject, pubErr)
+		}
+	default:
+		return errors.New("nats publisher has neither a connection nor a JetStream context")
+	}
+
+	return nil
+}
+
+func parseSubject(source string) (*template.Template, error) {
+	if funk.IsEmpty(source) {
+		source = DefaultNATSSubject
+	}
+
+	var parsed, parseErr = template.New("subject").Option("missingkey=error").Parse(source)
+	if parseErr != nil {
+		return nil, fmt.Errorf("invalid subject template: %v", parseErr)
+	}
+
+	return parsed, nil
+}
+
+func render(subjectTemplate *template.Template, event webhooks.Event) (string, error) {
+	var data = SubjectData{
+		Kind:       subjectTokenEscaper.Replace(event.Kind()),
+		IncidentID: subjectTokenEscaper.Replace(event.Incident.ID),
+		MonitorID:  subjectTokenEscaper.Replace(event.MonitorID),
+		TeamName:   subjectTokenEscaper.Replace(event.Incident.TeamName),
+	}
+
+	var builder strings.Builder
+	if execErr := subjectTemplate.Execute(&builder, data); execErr != nil {
+		return client.Blanc, fmt.Errorf("failed to render subject: %v", execErr)
+	}
+
+	var subject = builder.String()
+	if funk.IsEmpty(subject) || strings.Contains(subject, "..") || strings.HasSuffix(subject, ".") {
+		return client.Blanc, fmt.Errorf("rendered subject %q is not valid", subject)
+	}
+
+	return subject, nil
+}
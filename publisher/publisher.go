@@ -0,0 +1,52 @@
+package publisher
+
+import (
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+	"github.com/qameta/betterstack/webhooks"
+	"github.com/thoas/go-funk"
+)
+
+const DefaultSource = "https://uptime.betterstack.com"
+
+const AttributeEventType = "event_type"
+const AttributeIncidentID = "incident_id"
+const AttributeMonitorID = "monitor_id"
+const AttributeTeamName = "team_name"
+
+// AttributesFunc returns the message attributes/headers published alongside an event
+type AttributesFunc func(event webhooks.Event) map[string]string
+
+// DefaultAttributes exposes the event kind, incident, monitor and team, which is what subscription filters usually
+// need. Empty values are left out.
+func DefaultAttributes(event webhooks.Event) map[string]string {
+	var result = map[string]string{
+		AttributeEventType:  event.Kind(),
+		AttributeIncidentID: event.Incident.ID,
+		AttributeMonitorID:  event.MonitorID,
+		AttributeTeamName:   event.Incident.TeamName,
+	}
+	for key, value := range result {
+		if funk.IsEmpty(value) {
+			delete(result, key)
+		}
+	}
+	return result
+}
+
+// encode serializes the event as a CloudEvents envelope, which keeps the message schema stable across sinks
+func encode(event webhooks.Event, source string) (webhooks.CloudEvent, []byte, error) {
+	if source == client.Blanc {
+		source = DefaultSource
+	}
+	var envelope = webhooks.ToCloudEvent(event, source)
+	var serialized, serErr = json.Marshal(envelope)
+	return envelope, serialized, serErr
+}
+
+func attributes(fn AttributesFunc, event webhooks.Event) map[string]string {
+	if fn == nil {
+		return DefaultAttributes(event)
+	}
+	return fn(event)
+}
@@ -0,0 +1,672 @@
+package queue
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+const OperationCreateMonitor = "create_monitor"
+const OperationUpdateMonitor = "update_monitor"
+const OperationDeleteMonitor = "delete_monitor"
+const OperationCreateMonitorGroup = "create_monitor_group"
+const OperationUpdateMonitorGroup = "update_monitor_group"
+
+const StatusPending = "pending"
+const StatusRunning = "running"
+const StatusDone = "done"
+const StatusFailed = "failed"
+const StatusRolledBack = "rolled_back"
+
+// DefaultInterval keeps a single runner well within the API rate limit
+const DefaultInterval = 500 * time.Millisecond
+
+const DefaultMaxAttempts = 3
+
+const maxJournalLine = 16 << 20
+
+var ErrIncompleteRollback = errors.New("rollback could not restore every attribute")
+
+// unpatchable attributes are reported by the API but not accepted
+var unpatchable = []string{"id", "status", "created_at", "updated_at"}
+
+// writeOnly attributes are accepted but not returned by the API, snapshots don't hold them
+var writeOnly = []string{"auth_password"}
+
+// Job is a single pending mutation
+type Job struct {
+	ID        string `json:"id"`
+	Operation string `json:"operation"`
+
+	// ID of the resource to update or delete
+	TargetID string `json:"target_id,omitempty"`
+
+	Monitor *client.Monitor      `json:"monitor,omitempty"`
+	Group   *client.MonitorGroup `json:"group,omitempty"`
+
+	Status   string `json:"status"`
+	Attempts int    `json:"attempts"`
+	Error    string `json:"error,omitempty"`
+
+	// ID of the resource created by the job
+	ResultID string `json:"result_id,omitempty"`
+
+	// State of the resource before an update or delete, taken right before the job is executed
+	MonitorSnapshot *client.Monitor      `json:"monitor_snapshot,omitempty"`
+	GroupSnapshot   *client.MonitorGroup `json:"group_snapshot,omitempty"`
+
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type RunOptions struct {
+	// Minimum time between two API calls, defaults to DefaultInterval
+	Interval time.Duration
+
+	// Attempts per job before it is marked failed, defaults to DefaultMaxAttempts
+	MaxAttempts int
+
+	// Retries and duration of the whole run, optional. An exhausted budget stops the run, the jobs stay pending.
+	Budget *client.Budget
+}
+
+type Summary struct {
+	Done    int
+	Failed  int
+	Pending int
+}
+
+// Queue is a durable queue of mutations backed by an append-only journal file. Every state change of a job is
+// appended and synced before the next API call, so a crashed run resumes where it stopped when the queue is opened
+// again. Jobs are executed at least once: a job interrupted mid-call or failed is retried, and creates retried
+// first look the monitor or group up by name so they are not duplicated.
+type Queue struct {
+	mu      sync.Mutex
+	path    string
+	journal *os.File
+	jobs    []*Job
+	index   map[string]*Job
+	nextID  int
+	logger  client.Logger
+}
+
+type Option func(q *Queue)
+
+// WithLogger sends the warnings of the queue to logger. Without it the journal replay in Open logs to the default
+// logger of the client package and Run to the logger of its client.
+func WithLogger(logger client.Logger) Option {
+	return func(q *Queue) {
+		q.logger = logger
+	}
+}
+
+// Open loads the journal at path, creating it when missing
+func Open(path string, opts ...Option) (*Queue, error) {
+	var q = &Queue{
+		path:  path,
+		index: map[string]*Job{},
+	}
+	for _, opt := range opts {
+		opt(q)
+	}
+
+	if loadErr := q.load(); loadErr != nil {
+		return nil, loadErr
+	}
+
+	var journal, openErr = os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if openErr != nil {
+		return nil, fmt.Errorf("failed to open journal: %v", openErr)
+	}
+	q.journal = journal
+
+	return q, nil
+}
+
+// loggerFor returns the logger of WithLogger, the one of c without
+func (q *Queue) loggerFor(c *client.BetterstackClient) client.Logger {
+	if q.logger != nil {
+		return q.logger
+	}
+	return c.Logger()
+}
+
+func (q *Queue) Close() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.journal.Close()
+}
+
+// Enqueue appends jobs to the queue, assigning their IDs
+func (q *Queue) Enqueue(jobs ...Job) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, job := range jobs {
+		switch job.Operation {
+		case OperationCreateMonitor, OperationUpdateMonitor, OperationCreateMonitorGroup, OperationUpdateMonitorGroup,
+			OperationDeleteMonitor:
+		default:
+			return fmt.Errorf("unknown operation: %q", job.Operation)
+		}
+
+		q.nextID++
+		job.ID = strconv.Itoa(q.nextID)
+		job.Status = StatusPending
+		job.Attempts = 0
+
+		var stored = job
+		q.jobs = append(q.jobs, &stored)
+		q.index[stored.ID] = &stored
+
+		if writeErr := q.write(&stored); writeErr != nil {
+			return writeErr
+		}
+	}
+
+	return nil
+}
+
+// Jobs returns a snapshot of all jobs in queue order
+func (q *Queue) Jobs() []Job {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var result = make([]Job, 0, len(q.jobs))
+	for _, job := range q.jobs {
+		result = append(result, *job)
+	}
+	return result
+}
+
+// Run executes pending jobs in order, pacing the calls by opts.Interval. It returns when every job is done or
+// failed, or when the context is cancelled or opts.Budget runs out, in which case the remaining jobs stay pending
+// for the next run.
+func (q *Queue) Run(parent context.Context, c *client.BetterstackClient, opts RunOptions) (Summary, error) {
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultInterval
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = DefaultMaxAttempts
+	}
+
+	var ctx, cancel = opts.Budget.Context(parent)
+	defer cancel()
+
+	var ticker = time.NewTicker(opts.Interval)
+	defer ticker.Stop()
+
+	for _, job := range q.pending() {
+		for job.Status != StatusDone && job.Status != StatusFailed {
+			select {
+			case <-ctx.Done():
+				return q.summary(), opts.Budget.Explain(ctx.Err())
+			case <-ticker.C:
+			}
+
+			if snapErr := q.snapshot(ctx, c, job); snapErr != nil {
+				return q.summary(), opts.Budget.Explain(ctxErr(ctx, snapErr))
+			}
+
+			// An earlier attempt may have reached the API before failing or crashing, creates look for its result first
+			var retried = job.Attempts > 0
+			if markErr := q.update(job, func(j *Job) {
+				j.Status = StatusRunning
+				j.Attempts++
+			}); markErr != nil {
+				return q.summary(), markErr
+			}
+
+			var resultID, execErr = execute(ctx, c, job, retried)
+			if ctx.Err() != nil {
+				// The job stays running, the next run resumes it
+				return q.summary(), opts.Budget.Explain(ctx.Err())
+			}
+			if updateErr := q.update(job, func(j *Job) {
+				switch {
+				case execErr == nil:
+					j.Status = StatusDone
+					j.Error = client.Blanc
+					j.ResultID = resultID
+				case j.Attempts >= opts.MaxAttempts || errors.Is(execErr, client.ErrReadOnlyClient) ||
+					errors.Is(execErr, client.ErrDeleteVetoed):
+					j.Status = StatusFailed
+					j.Error = execErr.Error()
+				default:
+					j.Status = StatusPending
+					j.Error = execErr.Error()
+				}
+			}); updateErr != nil {
+				return q.summary(), updateErr
+			}
+
+			if execErr != nil {
+				q.loggerFor(c).Warn("job attempt failed", "job", job.ID, "operation", job.Operation, "attempt", job.Attempts,
+					"error", execErr)
+			}
+			if execErr != nil && job.Status == StatusPending {
+				if budgetErr := opts.Budget.Spend(execErr); budgetErr != nil {
+					return q.summary(), budgetErr
+				}
+			}
+		}
+	}
+
+	return q.summary(), nil
+}
+
+// snapshot records the current state of the resource an update or delete job is about to change, so the job can be
+// rolled back. The snapshot is persisted before the job runs.
+func (q *Queue) snapshot(ctx context.Context, c *client.BetterstackClient, job *Job) error {
+	if job.MonitorSnapshot != nil || job.GroupSnapshot != nil {
+		return nil
+	}
+
+	switch job.Operation {
+	case OperationUpdateMonitor, OperationDeleteMonitor:
+		var current, getErr = c.Monitors().Get(ctx, job.TargetID)
+		if getErr != nil {
+			return fmt.Errorf("failed to snapshot monitor %s: %v", job.TargetID, getErr)
+		}
+		var monitor = current.Data.Attributes
+		return q.update(job, func(j *Job) { j.MonitorSnapshot = &monitor })
+	case OperationUpdateMonitorGroup:
+		var current, getErr = c.MonitorGroups().Get(ctx, job.TargetID)
+		if getErr != nil {
+			return fmt.Errorf("failed to snapshot monitor group %s: %v", job.TargetID, getErr)
+		}
+		var group = current.Data.Attributes
+		return q.update(job, func(j *Job) { j.GroupSnapshot = &group })
+	}
+
+	return nil
+}
+
+// execute runs one attempt of the job. With retried set a create first looks for the resource an earlier attempt
+// may have created, by name and URL for monitors and by name for groups. A failing lookup fails the attempt rather
+// than risking a duplicate.
+func execute(ctx context.Context, c *client.BetterstackClient, job *Job, retried bool) (string, error) {
+	switch job.Operation {
+	case OperationCreateMonitor:
+		if job.Monitor == nil {
+			return client.Blanc, errors.New("job has no monitor")
+		}
+		if retried {
+			var existing, findErr = c.Monitors().ListPage(ctx, 1, client.FilterByPronounceableName, job.Monitor.PronounceableName)
+			if findErr != nil {
+				return client.Blanc, fmt.Errorf("failed to look for a monitor created before: %w", findErr)
+			}
+			for _, monitor := range existing.Data {
+				if monitor.Attributes.URL == job.Monitor.URL {
+					return monitor.ID, nil
+				}
+			}
+		}
+		var created, createErr = c.Monitors().Create(ctx, *job.Monitor)
+		return created.Data.ID, createErr
+	case OperationUpdateMonitor:
+		if job.Monitor == nil {
+			return client.Blanc, errors.New("job has no monitor")
+		}
+		var updated, updateErr = c.Monitors().Update(ctx, job.TargetID, *job.Monitor)
+		return updated.Data.ID, updateErr
+	case OperationDeleteMonitor:
+		return job.TargetID, c.Monitors().Delete(ctx, job.TargetID)
+	case OperationCreateMonitorGroup:
+		if job.Group == nil {
+			return client.Blanc, errors.New("job has no monitor group")
+		}
+		if retried {
+			var groups, listErr = c.MonitorGroups().List(ctx)
+			if listErr != nil {
+				return client.Blanc, fmt.Errorf("failed to look for a monitor group created before: %w", listErr)
+			}
+			for _, group := range groups {
+				if group.Name == job.Group.Name {
+					return group.ID, nil
+				}
+			}
+		}
+		var created, createErr = c.MonitorGroups().Create(ctx, *job.Group)
+		return created.Data.ID, createErr
+	case OperationUpdateMonitorGroup:
+		if job.Group == nil {
+			return client.Blanc, errors.New("job has no monitor group")
+		}
+		var updated, updateErr = c.MonitorGroups().Update(ctx, job.TargetID, *job.Group)
+		return updated.Data.ID, updateErr
+	}
+	return client.Blanc, fmt.Errorf("unknown operation: %q", job.Operation)
+}
+
+// ctxErr prefers the context error, request errors caused by it are reported with %v and can't be unwrapped
+func ctxErr(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+func (q *Queue) pending() []*Job {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var result []*Job
+	for _, job := range q.jobs {
+		if job.Status == StatusPending || job.Status == StatusRunning {
+			result = append(result, job)
+		}
+	}
+	return result
+}
+
+func (q *Queue) update(job *Job, change func(j *Job)) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	change(job)
+	return q.write(job)
+}
+
+// write appends the current state of the job to the journal, the caller holds the lock
+func (q *Queue) write(job *Job) error {
+	job.UpdatedAt = time.Now().UTC()
+
+	var line, serErr = json.Marshal(job)
+	if serErr != nil {
+		return fmt.Errorf("failed to serialize job %s: %v", job.ID, serErr)
+	}
+
+	if q.journal == nil {
+		return nil
+	}
+	if _, writeErr := q.journal.Write(append(line, '\n')); writeErr != nil {
+		return fmt.Errorf("failed to write journal: %v", writeErr)
+	}
+	if syncErr := q.journal.Sync(); syncErr != nil {
+		return fmt.Errorf("failed to sync journal: %v", syncErr)
+	}
+
+	return nil
+}
+
+func (q *Queue) load() error {
+	var file, openErr = os.Open(q.path)
+	if errors.Is(openErr, os.ErrNotExist) {
+		return nil
+	}
+	if openErr != nil {
+		return fmt.Errorf("failed to open journal: %v", openErr)
+	}
+	defer file.Close()
+
+	var scanner = bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64<<10), maxJournalLine)
+
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var job Job
+		if unmErr := json.Unmarshal(scanner.Bytes(), &job); unmErr != nil {
+			// A crash while appending leaves a torn last line, the previous state of that job is still valid
+			client.LoggerOrDefault(q.logger).Warn("skipping unreadable journal line", "line", line, "error", unmErr)
+			continue
+		}
+
+		if existing, found := q.index[job.ID]; found {
+			*existing = job
+			continue
+		}
+
+		var stored = job
+		q.jobs = append(q.jobs, &stored)
+		q.index[stored.ID] = &stored
+
+		if id, convErr := strconv.Atoi(job.ID); convErr == nil && id > q.nextID {
+			q.nextID = id
+		}
+	}
+
+	if scanErr := scanner.Err(); scanErr != nil {
+		return fmt.Errorf("failed to read journal: %v", scanErr)
+	}
+
+	return nil
+}
+
+func (q *Queue) summary() Summary {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var result Summary
+	for _, job := range q.jobs {
+		switch job.Status {
+		case StatusDone:
+			result.Done++
+		case StatusFailed:
+			result.Failed++
+		default:
+			result.Pending++
+		}
+	}
+	return result
+}
+
+// Compact rewrites the journal keeping only the latest state of every job, optionally dropping finished jobs
+func (q *Queue) Compact(dropDone bool) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var temporary = q.path + ".tmp"
+	var file, createErr = os.Create(temporary)
+	if createErr != nil {
+		return fmt.Errorf("failed to create journal: %v", createErr)
+	}
+
+	var kept []*Job
+	for _, job := range q.jobs {
+		if dropDone && job.Status == StatusDone {
+			continue
+		}
+		var line, serErr = json.Marshal(job)
+		if serErr != nil {
+			_ = file.Close()
+			return fmt.Errorf("failed to serialize job %s: %v", job.ID, serErr)
+		}
+		if _, writeErr := file.Write(append(line, '\n')); writeErr != nil {
+			_ = file.Close()
+			return fmt.Errorf("failed to write journal: %v", writeErr)
+		}
+		kept = append(kept, job)
+	}
+
+	if syncErr := file.Sync(); syncErr != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to sync journal: %v", syncErr)
+	}
+	if closeErr := file.Close(); closeErr != nil {
+		return fmt.Errorf("failed to close journal: %v", closeErr)
+	}
+
+	_ = q.journal.Close()
+	if renameErr := os.Rename(temporary, q.path); renameErr != nil {
+		return fmt.Errorf("failed to replace journal: %v", renameErr)
+	}
+
+	var journal, openErr = os.OpenFile(q.path, os.O_APPEND|os.O_WRONLY, 0o600)
+	if openErr != nil {
+		return fmt.Errorf("failed to reopen journal: %v", openErr)
+	}
+	q.journal = journal
+
+	q.jobs = kept
+	q.index = map[string]*Job{}
+	for _, job := range kept {
+		q.index[job.ID] = job
+	}
+
+	return nil
+}
+
+// Rollback reverses every done job, newest first: created resources are deleted, updated ones are restored from
+// their snapshots and deleted monitors are created again (under a new ID). Monitors are restored with an explicit
+// patch of the attributes the job changed, false and 0 included, and checked afterwards; attributes which could
+// not be restored are reported with ErrIncompleteRollback while the job still counts as rolled back. Rolled back
+// jobs are marked so a second rollback does not repeat them. Jobs which could not be reversed keep their status and
+// are reported in the error.
+func (q *Queue) Rollback(ctx context.Context, c *client.BetterstackClient, opts RunOptions) (int, error) {
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultInterval
+	}
+
+	q.mu.Lock()
+	var done []*Job
+	for i := len(q.jobs) - 1; i >= 0; i-- {
+		if q.jobs[i].Status == StatusDone {
+			done = append(done, q.jobs[i])
+		}
+	}
+	q.mu.Unlock()
+
+	var ticker = time.NewTicker(opts.Interval)
+	defer ticker.Stop()
+
+	var reverted int
+	var errs []error
+	for _, job := range done {
+		select {
+		case <-ctx.Done():
+			return reverted, errors.Join(append(errs, ctx.Err())...)
+		case <-ticker.C:
+		}
+
+		var unreverted, revertErr = revert(ctx, c, job)
+		if ctx.Err() != nil {
+			return reverted, errors.Join(append(errs, ctx.Err())...)
+		}
+		if revertErr != nil {
+			errs = append(errs, fmt.Errorf("job %s (%s): %v", job.ID, job.Operation, revertErr))
+			continue
+		}
+		if len(unreverted) > 0 {
+			errs = append(errs, fmt.Errorf("job %s (%s): %w: %s", job.ID, job.Operation, ErrIncompleteRollback,
+				strings.Join(unreverted, ", ")))
+		}
+
+		if updateErr := q.update(job, func(j *Job) { j.Status = StatusRolledBack }); updateErr != nil {
+			return reverted, errors.Join(append(errs, updateErr)...)
+		}
+		reverted++
+	}
+
+	return reverted, errors.Join(errs...)
+}
+
+// revert reverses a done job, returning the monitor attributes it could not restore
+func revert(ctx context.Context, c *client.BetterstackClient, job *Job) ([]string, error) {
+	switch job.Operation {
+	case OperationCreateMonitor:
+		return nil, c.Monitors().Delete(ctx, job.ResultID)
+	case OperationCreateMonitorGroup:
+		return nil, c.MonitorGroups().Delete(ctx, job.ResultID)
+	case OperationUpdateMonitor:
+		if job.MonitorSnapshot == nil || job.Monitor == nil {
+			return nil, errors.New("no snapshot recorded")
+		}
+		// Update sent the set attributes and those serialized without omitempty, zero or not
+		var sent, serErr = json.Marshal(*job.Monitor)
+		if serErr != nil {
+			return nil, serErr
+		}
+		var changed map[string]any
+		if unmErr := json.Unmarshal(sent, &changed); unmErr != nil {
+			return nil, unmErr
+		}
+		return restoreMonitor(ctx, c, job.TargetID, *job.MonitorSnapshot, funk.Keys(changed).([]string))
+	case OperationDeleteMonitor:
+		if job.MonitorSnapshot == nil {
+			return nil, errors.New("no snapshot recorded")
+		}
+		var created, createErr = c.Monitors().Create(ctx, restorable(*job.MonitorSnapshot))
+		if createErr != nil || created.Data.ID == client.Blanc {
+			// Nothing was created in dry-run mode
+			return nil, createErr
+		}
+		// Create omits false and 0, the API defaults may differ from the snapshot
+		var wanted = job.MonitorSnapshot.Attributes()
+		var got = created.Data.Attributes.Attributes()
+		var differing []string
+		for name, value := range wanted {
+			if !client.SameAttribute(got[name], value) {
+				differing = append(differing, name)
+			}
+		}
+		return restoreMonitor(ctx, c, created.Data.ID, *job.MonitorSnapshot, differing)
+	case OperationUpdateMonitorGroup:
+		if job.GroupSnapshot == nil {
+			return nil, errors.New("no snapshot recorded")
+		}
+		// Group attributes are serialized without omitempty, the snapshot restores false values as well
+		var _, updateErr = c.MonitorGroups().Update(ctx, job.TargetID, *job.GroupSnapshot)
+		return nil, updateErr
+	}
+	return nil, fmt.Errorf("unknown operation: %q", job.Operation)
+}
+
+// restoreMonitor patches the named attributes back to their snapshot values and returns those the monitor doesn't
+// carry afterwards. Write-only attributes the snapshot can't hold are not sent and returned as well.
+func restoreMonitor(ctx context.Context, c *client.BetterstackClient, id string, snapshot client.Monitor, names []string) ([]string, error) {
+	var wanted = snapshot.Attributes()
+	var patch = map[string]any{}
+	var unreverted []string
+	for _, name := range names {
+		var value, known = wanted[name]
+		switch {
+		case !known || funk.ContainsString(unpatchable, name):
+			continue
+		case funk.ContainsString(writeOnly, name) && funk.IsEmpty(value):
+			unreverted = append(unreverted, name)
+			continue
+		}
+		patch[name] = value
+	}
+	if len(patch) == 0 {
+		sort.Strings(unreverted)
+		return unreverted, nil
+	}
+
+	var patched, patchErr = c.Monitors().Patch(ctx, id, patch)
+	if patchErr != nil {
+		return nil, patchErr
+	}
+	var got = patched.Data.Attributes.Attributes()
+	for name, value := range patch {
+		if !funk.ContainsString(writeOnly, name) && !client.SameAttribute(got[name], value) {
+			unreverted = append(unreverted, name)
+		}
+	}
+	sort.Strings(unreverted)
+	return unreverted, nil
+}
+
+// restorable strips attributes the API reports but does not accept
+func restorable(monitor client.Monitor) client.Monitor {
+	monitor.ID = client.Blanc
+	monitor.Status = client.Blanc
+	monitor.CreatedAt = nil
+	monitor.UpdatedAt = nil
+	return monitor
+}
@@ -0,0 +1,278 @@
+package queue
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+)
+
+// fakeAPI keeps monitors as raw attributes, so omitted and false values can be told apart. Created monitors get
+// verify_ssl=true like the API defaults it; auth_password is accepted but never returned. Creates answer 500 after
+// storing the monitor while failCreates is positive.
+type fakeAPI struct {
+	mu          sync.Mutex
+	monitors    map[string]map[string]any
+	created     int
+	failCreates int
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var id = strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/api/v2/monitors"), "/")
+	var body, _ = io.ReadAll(r.Body)
+	var attributes map[string]any
+	_ = json.Unmarshal(body, &attributes)
+
+	switch r.Method {
+	case http.MethodGet:
+		if id == client.Blanc {
+			var list = []any{}
+			for monitorID, monitor := range f.monitors {
+				if monitor["pronounceable_name"] == r.URL.Query().Get("pronounceable_name") {
+					list = append(list, map[string]any{"id": monitorID, "attributes": monitor})
+				}
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": list})
+			return
+		}
+		if _, found := f.monitors[id]; !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+	case http.MethodPatch:
+		for name, value := range attributes {
+			f.monitors[id][name] = value
+		}
+	case http.MethodPost:
+		f.created++
+		id = fmt.Sprintf("new-%d", f.created)
+		attributes["verify_ssl"] = true
+		f.monitors[id] = attributes
+		if f.failCreates > 0 {
+			f.failCreates--
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodDelete:
+		delete(f.monitors, id)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	var returned = map[string]any{}
+	for name, value := range f.monitors[id] {
+		if name != "auth_password" {
+			returned[name] = value
+		}
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": id, "attributes": returned}})
+}
+
+func newFakeAPI(t *testing.T) (*fakeAPI, *client.BetterstackClient) {
+	var api = &fakeAPI{monitors: map[string]map[string]any{}}
+	var server = httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	return api, client.NewClient("token", client.WithBaseURL(server.URL))
+}
+
+func openQueue(t *testing.T, path string) *Queue {
+	var q, openErr = Open(path)
+	if openErr != nil {
+		t.Fatal(openErr)
+	}
+	t.Cleanup(func() { _ = q.Close() })
+	return q
+}
+
+var fast = RunOptions{Interval: time.Millisecond}
+
+func TestRollbackRestoresFalseValues(t *testing.T) {
+	var api, c = newFakeAPI(t)
+	api.monitors["1"] = map[string]any{"url": "https://example.com", "pronounceable_name": "shop", "paused": false,
+		"verify_ssl": false, "follow_redirects": false, "email": true}
+
+	var q = openQueue(t, filepath.Join(t.TempDir(), "journal.jsonl"))
+	var update = client.Monitor{URL: "https://example.com", PronounceableName: "shop", Paused: true, VerifySSL: true,
+		FollowRedirects: true}
+	if enqueueErr := q.Enqueue(Job{Operation: OperationUpdateMonitor, TargetID: "1", Monitor: &update}); enqueueErr != nil {
+		t.Fatal(enqueueErr)
+	}
+	if _, runErr := q.Run(context.Background(), c, fast); runErr != nil {
+		t.Fatal(runErr)
+	}
+	if api.monitors["1"]["paused"] != true || api.monitors["1"]["email"] != false {
+		t.Fatalf("update not applied: %v", api.monitors["1"])
+	}
+
+	var reverted, rollbackErr = q.Rollback(context.Background(), c, fast)
+	if rollbackErr != nil || reverted != 1 {
+		t.Fatalf("rollback reverted %d: %v", reverted, rollbackErr)
+	}
+	for _, name := range []string{"paused", "verify_ssl", "follow_redirects"} {
+		if api.monitors["1"][name] != false {
+			t.Errorf("%s not reverted: %v", name, api.monitors["1"][name])
+		}
+	}
+	if api.monitors["1"]["email"] != true {
+		t.Errorf("email not reverted: %v", api.monitors["1"]["email"])
+	}
+	if q.Jobs()[0].Status != StatusRolledBack {
+		t.Errorf("job not marked rolled back: %s", q.Jobs()[0].Status)
+	}
+}
+
+func TestRollbackReportsWriteOnlyAttributes(t *testing.T) {
+	var api, c = newFakeAPI(t)
+	api.monitors["1"] = map[string]any{"url": "https://example.com", "pronounceable_name": "shop", "auth_password": "old"}
+
+	var q = openQueue(t, filepath.Join(t.TempDir(), "journal.jsonl"))
+	var update = client.Monitor{URL: "https://example.com", PronounceableName: "shop", AuthPassword: "new"}
+	_ = q.Enqueue(Job{Operation: OperationUpdateMonitor, TargetID: "1", Monitor: &update})
+	if _, runErr := q.Run(context.Background(), c, fast); runErr != nil {
+		t.Fatal(runErr)
+	}
+
+	var reverted, rollbackErr = q.Rollback(context.Background(), c, fast)
+	if reverted != 1 || !errors.Is(rollbackErr, ErrIncompleteRollback) || !strings.Contains(rollbackErr.Error(), "auth_password") {
+		t.Fatalf("expected auth_password reported, reverted %d: %v", reverted, rollbackErr)
+	}
+}
+
+func TestRollbackRecreatesDeletedMonitor(t *testing.T) {
+	var api, c = newFakeAPI(t)
+	api.monitors["1"] = map[string]any{"url": "https://example.com", "pronounceable_name": "shop", "monitor_type": "status",
+		"verify_ssl": false}
+
+	var q = openQueue(t, filepath.Join(t.TempDir(), "journal.jsonl"))
+	_ = q.Enqueue(Job{Operation: OperationDeleteMonitor, TargetID: "1"})
+	if _, runErr := q.Run(context.Background(), c, fast); runErr != nil {
+		t.Fatal(runErr)
+	}
+
+	if _, rollbackErr := q.Rollback(context.Background(), c, fast); rollbackErr != nil {
+		t.Fatal(rollbackErr)
+	}
+	var recreated = api.monitors["new-1"]
+	if recreated == nil || recreated["pronounceable_name"] != "shop" || recreated["verify_ssl"] != false {
+		t.Errorf("monitor not recreated as it was: %v", recreated)
+	}
+}
+
+func TestJournalReplay(t *testing.T) {
+	var path = filepath.Join(t.TempDir(), "journal.jsonl")
+	var q, openErr = Open(path)
+	if openErr != nil {
+		t.Fatal(openErr)
+	}
+	var monitor = client.Monitor{URL: "https://example.com", PronounceableName: "shop"}
+	_ = q.Enqueue(Job{Operation: OperationCreateMonitor, Monitor: &monitor}, Job{Operation: OperationDeleteMonitor, TargetID: "9"})
+	_ = q.update(q.jobs[0], func(j *Job) { j.Status = StatusRunning; j.Attempts = 1 })
+	_ = q.Close()
+
+	// A crash while appending leaves a torn last line
+	var journal, _ = os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	_, _ = journal.WriteString(`{"id":"2","status":"do`)
+	_ = journal.Close()
+
+	var output bytes.Buffer
+	var reopened, reopenErr = Open(path, WithLogger(slog.New(slog.NewTextHandler(&output, nil))))
+	if reopenErr != nil {
+		t.Fatal(reopenErr)
+	}
+	defer reopened.Close()
+	if strings.Count(output.String(), "level=WARN") != 1 {
+		t.Errorf("expected the torn line logged, got %s", output.String())
+	}
+	var jobs = reopened.Jobs()
+	if len(jobs) != 2 {
+		t.Fatalf("expected 2 jobs, got %d", len(jobs))
+	}
+	if jobs[0].Status != StatusRunning || jobs[0].Attempts != 1 || jobs[1].Status != StatusPending {
+		t.Errorf("unexpected replayed state: %+v", jobs)
+	}
+	if enqueueErr := reopened.Enqueue(Job{Operation: OperationDeleteMonitor, TargetID: "10"}); enqueueErr != nil {
+		t.Fatal(enqueueErr)
+	}
+	if id := reopened.Jobs()[2].ID; id != "3" {
+		t.Errorf("expected ID 3 after replay, got %s", id)
+	}
+}
+
+func TestResumedCreateIsNotDuplicated(t *testing.T) {
+	var api, c = newFakeAPI(t)
+	api.monitors["5"] = map[string]any{"url": "https://example.com", "pronounceable_name": "shop"}
+
+	var q = openQueue(t, filepath.Join(t.TempDir(), "journal.jsonl"))
+	var monitor = client.Monitor{URL: "https://example.com", PronounceableName: "shop"}
+	_ = q.Enqueue(Job{Operation: OperationCreateMonitor, Monitor: &monitor})
+	_ = q.update(q.jobs[0], func(j *Job) { j.Status = StatusRunning; j.Attempts = 1 })
+
+	if _, runErr := q.Run(context.Background(), c, fast); runErr != nil {
+		t.Fatal(runErr)
+	}
+	if api.created != 0 || q.Jobs()[0].ResultID != "5" {
+		t.Errorf("resumed create duplicated the monitor: created %d, result %s", api.created, q.Jobs()[0].ResultID)
+	}
+}
+
+func TestFailedCreateIsNotDuplicated(t *testing.T) {
+	var api, c = newFakeAPI(t)
+	api.failCreates = 1
+
+	var q = openQueue(t, filepath.Join(t.TempDir(), "journal.jsonl"))
+	var monitor = client.Monitor{URL: "https://example.com", PronounceableName: "shop"}
+	_ = q.Enqueue(Job{Operation: OperationCreateMonitor, Monitor: &monitor})
+
+	if _, runErr := q.Run(context.Background(), c, fast); runErr != nil {
+		t.Fatal(runErr)
+	}
+	var job = q.Jobs()[0]
+	if api.created != 1 || job.Status != StatusDone || job.ResultID != "new-1" || job.Attempts != 2 {
+		t.Errorf("expected the second attempt to find the monitor, created %d: %+v", api.created, job)
+	}
+}
+
+func TestFailedGroupCreateIsNotDuplicated(t *testing.T) {
+	var mu sync.Mutex
+	var groups []map[string]any
+	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Method == http.MethodPost {
+			var body, _ = io.ReadAll(r.Body)
+			var attributes map[string]any
+			_ = json.Unmarshal(body, &attributes)
+			groups = append(groups, map[string]any{"id": fmt.Sprint(len(groups) + 1), "attributes": attributes})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": groups})
+	}))
+	t.Cleanup(server.Close)
+	var c = client.NewClient("token", client.WithBaseURL(server.URL))
+
+	var q = openQueue(t, filepath.Join(t.TempDir(), "journal.jsonl"))
+	_ = q.Enqueue(Job{Operation: OperationCreateMonitorGroup, Group: &client.MonitorGroup{Name: "shop"}})
+	if _, runErr := q.Run(context.Background(), c, fast); runErr != nil {
+		t.Fatal(runErr)
+	}
+	if len(groups) != 1 || q.Jobs()[0].Status != StatusDone || q.Jobs()[0].ResultID != "1" {
+		t.Errorf("expected the second attempt to find the group, created %d: %+v", len(groups), q.Jobs()[0])
+	}
+}
@@ -0,0 +1,210 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+const DefaultConsulAddress = "http://127.0.0.1:8500"
+
+// Service meta key overriding the checked URL, for services whose health checks use internal addresses
+const DefaultConsulURLMeta = "betterstack-url"
+
+type ConsulOptions struct {
+	// Defaults to CONSUL_HTTP_ADDR, then DefaultConsulAddress
+	Address string
+
+	// Defaults to CONSUL_HTTP_TOKEN
+	Token string
+
+	Datacenter string
+
+	// Only services having this tag
+	Tag string
+
+	// Defaults to DefaultConsulURLMeta
+	URLMeta string
+
+	// Defaults to http.DefaultClient
+	HTTPClient *http.Client
+
+	// Base of every produced monitor, set a monitor group to own the monitors with OwnedByGroup
+	Template client.Monitor
+}
+
+// ConsulSource produces a monitor per HTTP and TCP health check of the services in the Consul catalog. A service
+// with the URLMeta key set gets a single status monitor for that URL instead.
+type ConsulSource struct {
+	opts ConsulOptions
+}
+
+type consulEntry struct {
+	Node struct {
+		Node    string `json:"Node"`
+		Address string `json:"Address"`
+	} `json:"Node"`
+	Service struct {
+		ID      string            `json:"ID"`
+		Service string            `json:"Service"`
+		Address string            `json:"Address"`
+		Port    int               `json:"Port"`
+		Meta    map[string]string `json:"Meta"`
+	} `json:"Service"`
+	Checks []struct {
+		CheckID    string `json:"CheckID"`
+		Name       string `json:"Name"`
+		Type       string `json:"Type"`
+		Definition struct {
+			HTTP   string `json:"HTTP"`
+			Method string `json:"Method"`
+			TCP    string `json:"TCP"`
+		} `json:"Definition"`
+	} `json:"Checks"`
+}
+
+func NewConsulSource(opts ConsulOptions) *ConsulSource {
+	if funk.IsEmpty(opts.Address) {
+		opts.Address = os.Getenv("CONSUL_HTTP_ADDR")
+	}
+	if funk.IsEmpty(opts.Address) {
+		opts.Address = DefaultConsulAddress
+	}
+	if funk.IsEmpty(opts.Token) {
+		opts.Token = os.Getenv("CONSUL_HTTP_TOKEN")
+	}
+	if funk.IsEmpty(opts.URLMeta) {
+		opts.URLMeta = DefaultConsulURLMeta
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = http.DefaultClient
+	}
+	return &ConsulSource{opts: opts}
+}
+
+func (s *ConsulSource) Name() string {
+	return "consul"
+}
+
+func (s *ConsulSource) Monitors(ctx context.Context) ([]client.Monitor, error) {
+	var services map[string][]string
+	if getErr := s.get(ctx, "/v1/catalog/services", nil, &services); getErr != nil {
+		return nil, getErr
+	}
+
+	var names = funk.Keys(services).([]string)
+	sort.Strings(names)
+
+	var result []client.Monitor
+	var seen = map[string]bool{}
+	for _, name := range names {
+		if funk.NotEmpty(s.opts.Tag) && !funk.ContainsString(services[name], s.opts.Tag) {
+			continue
+		}
+
+		var query = url.Values{}
+		if funk.NotEmpty(s.opts.Tag) {
+			query.Set("tag", s.opts.Tag)
+		}
+		var entries []consulEntry
+		if getErr := s.get(ctx, "/v1/health/service/"+url.PathEscape(name), query, &entries); getErr != nil {
+			return nil, getErr
+		}
+
+		// Instances of a service commonly share the meta URL
+		for _, entry := range entries {
+			for _, monitor := range s.monitors(entry) {
+				if !seen[monitor.PronounceableName] {
+					seen[monitor.PronounceableName] = true
+					result = append(result, monitor)
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (s *ConsulSource) monitors(entry consulEntry) []client.Monitor {
+	var service = entry.Service.Service
+
+	if target := entry.Service.Meta[s.opts.URLMeta]; funk.NotEmpty(target) {
+		return []client.Monitor{s.monitor(client.MonitorTypeStatus, fmt.Sprintf("%s %s", service, target), target)}
+	}
+
+	var result []client.Monitor
+	for _, check := range entry.Checks {
+		switch {
+		case funk.NotEmpty(check.Definition.HTTP):
+			var monitor = s.monitor(client.MonitorTypeStatus, fmt.Sprintf("%s %s", service, check.Definition.HTTP), check.Definition.HTTP)
+			monitor.HTTPMethod = check.Definition.Method
+			result = append(result, monitor)
+		case funk.NotEmpty(check.Definition.TCP):
+			var host, port, splitErr = net.SplitHostPort(check.Definition.TCP)
+			if splitErr != nil {
+				continue
+			}
+			var monitor = s.monitor(client.MonitorTypeTCP, fmt.Sprintf("%s %s", service, check.Definition.TCP), host)
+			monitor.Port, _ = strconv.Atoi(port)
+			result = append(result, monitor)
+		}
+	}
+	return result
+}
+
+func (s *ConsulSource) monitor(monitorType, name, target string) client.Monitor {
+	var monitor = s.opts.Template
+	monitor.ID = client.Blanc
+	monitor.MonitorType = monitorType
+	monitor.PronounceableName = name
+	monitor.URL = target
+	monitor.Regions = append([]string(nil), s.opts.Template.Regions...)
+	monitor.RequestHeaders = append([]client.RequestHeader(nil), s.opts.Template.RequestHeaders...)
+	return monitor
+}
+
+func (s *ConsulSource) get(ctx context.Context, path string, query url.Values, target any) error {
+	if query == nil {
+		query = url.Values{}
+	}
+	if funk.NotEmpty(s.opts.Datacenter) {
+		query.Set("dc", s.opts.Datacenter)
+	}
+
+	var endpoint = s.opts.Address + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var request, reqErr = http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if reqErr != nil {
+		return fmt.Errorf("failed to create request: %v", reqErr)
+	}
+	if funk.NotEmpty(s.opts.Token) {
+		request.Header.Set("X-Consul-Token", s.opts.Token)
+	}
+
+	var response, respErr = s.opts.HTTPClient.Do(request)
+	if respErr != nil {
+		return fmt.Errorf("failed to execute request: %v", respErr)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to execute request: %v", response.Status)
+	}
+
+	if unmErr := json.NewDecoder(response.Body).Decode(target); unmErr != nil {
+		return fmt.Errorf("failed to unmarshal response: %v", unmErr)
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+	"github.com/qameta/betterstack/generate"
+	"github.com/thoas/go-funk"
+)
+
+const DefaultCloudflareURL = "https://api.cloudflare.com/client/v4"
+
+// Record types DNS sources produce monitors for
+var addressRecordTypes = []string{"A", "AAAA", "CNAME"}
+
+type CloudflareOptions struct {
+	// Defaults to CLOUDFLARE_API_TOKEN, needs Zone:Read and DNS:Read
+	Token string
+
+	// Zone to read, ZoneID takes precedence over ZoneName
+	ZoneID   string
+	ZoneName string
+
+	// Which monitors the records produce, see generate.ZoneOptions. DNS is ignored, NS records are not read.
+	Zone generate.ZoneOptions
+
+	// Defaults to DefaultCloudflareURL
+	BaseURL string
+
+	// Defaults to http.DefaultClient
+	HTTPClient *http.Client
+}
+
+// CloudflareSource produces ping and status monitors for the A, AAAA and CNAME records of a Cloudflare zone
+type CloudflareSource struct {
+	opts CloudflareOptions
+}
+
+type cloudflareResponse struct {
+	Success bool `json:"success"`
+	Errors  []struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"errors"`
+	Result     json.RawMessage `json:"result"`
+	ResultInfo struct {
+		Page       int `json:"page"`
+		TotalPages int `json:"total_pages"`
+	} `json:"result_info"`
+}
+
+type cloudflareRecord struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Content string `json:"content"`
+	TTL     int    `json:"ttl"`
+}
+
+func NewCloudflareSource(opts CloudflareOptions) *CloudflareSource {
+	if funk.IsEmpty(opts.Token) {
+		opts.Token = os.Getenv("CLOUDFLARE_API_TOKEN")
+	}
+	if funk.IsEmpty(opts.BaseURL) {
+		opts.BaseURL = DefaultCloudflareURL
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = http.DefaultClient
+	}
+	return &CloudflareSource{opts: opts}
+}
+
+func (s *CloudflareSource) Name() string {
+	return "cloudflare"
+}
+
+func (s *CloudflareSource) Monitors(ctx context.Context) ([]client.Monitor, error) {
+	var zoneID = s.opts.ZoneID
+	if funk.IsEmpty(zoneID) {
+		var zones []struct {
+			ID string `json:"id"`
+		}
+		if _, getErr := s.get(ctx, "/zones", url.Values{"name": {s.opts.ZoneName}}, &zones); getErr != nil {
+			return nil, getErr
+		}
+		if len(zones) == 0 {
+			return nil, fmt.Errorf("cloudflare zone %q not found", s.opts.ZoneName)
+		}
+		zoneID = zones[0].ID
+	}
+
+	var records []generate.Record
+	for page := 1; ; page++ {
+		var batch []cloudflareRecord
+		var query = url.Values{"page": {strconv.Itoa(page)}, "per_page": {"100"}}
+		var totalPages, getErr = s.get(ctx, "/zones/"+url.PathEscape(zoneID)+"/dns_records", query, &batch)
+		if getErr != nil {
+			return nil, getErr
+		}
+		for _, record := range batch {
+			records = append(records, generate.Record{Name: record.Name, Type: record.Type, TTL: record.TTL, Value: record.Content})
+		}
+		if page >= totalPages {
+			break
+		}
+	}
+
+	return recordMonitors(records, s.opts.Zone), nil
+}
+
+func (s *CloudflareSource) get(ctx context.Context, path string, query url.Values, target any) (int, error) {
+	var request, reqErr = http.NewRequestWithContext(ctx, http.MethodGet, s.opts.BaseURL+path+"?"+query.Encode(), nil)
+	if reqErr != nil {
+		return 0, fmt.Errorf("failed to create request: %v", reqErr)
+	}
+	request.Header.Set("Authorization", "Bearer "+s.opts.Token)
+
+	var response, respErr = s.opts.HTTPClient.Do(request)
+	if respErr != nil {
+		return 0, fmt.Errorf("failed to execute request: %v", respErr)
+	}
+	defer response.Body.Close()
+
+	var result cloudflareResponse
+	if unmErr := json.NewDecoder(response.Body).Decode(&result); unmErr != nil {
+		return 0, fmt.Errorf("failed to unmarshal response: %v", unmErr)
+	}
+	if !result.Success || response.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("failed to execute request: %v %v", response.Status, result.Errors)
+	}
+	if unmErr := json.Unmarshal(result.Result, target); unmErr != nil {
+		return 0, fmt.Errorf("failed to unmarshal response: %v", unmErr)
+	}
+
+	return result.ResultInfo.TotalPages, nil
+}
+
+// Route53API lists the record sets of a hosted zone, following the pagination. It is satisfied by a thin adapter
+// over the Route 53 client of the AWS SDK, so the SDK stays a dependency of the application rather than of this
+// module. Names may be returned as Route 53 does, with the trailing dot and escaped characters.
+type Route53API interface {
+	ListRecords(ctx context.Context, hostedZoneID string) ([]generate.Record, error)
+}
+
+// Route53Source produces ping and status monitors for the A, AAAA and CNAME records of a hosted zone. Alias
+// records are included, the adapter reports them with the alias target as value.
+type Route53Source struct {
+	API          Route53API
+	HostedZoneID string
+
+	// Which monitors the records produce, see generate.ZoneOptions. DNS is ignored, NS records are not read.
+	Zone generate.ZoneOptions
+}
+
+func (s *Route53Source) Name() string {
+	return "route53"
+}
+
+func (s *Route53Source) Monitors(ctx context.Context) ([]client.Monitor, error) {
+	var records, listErr = s.API.ListRecords(ctx, s.HostedZoneID)
+	if listErr != nil {
+		return nil, fmt.Errorf("failed to list records of %s: %v", s.HostedZoneID, listErr)
+	}
+
+	for i := range records {
+		// Route 53 escapes the wildcard label as \052
+		records[i].Name = strings.ToLower(strings.TrimSuffix(strings.ReplaceAll(records[i].Name, `\052`, "*"), "."))
+	}
+
+	return recordMonitors(records, s.Zone), nil
+}
+
+func recordMonitors(records []generate.Record, opts generate.ZoneOptions) []client.Monitor {
+	var addresses []generate.Record
+	for _, record := range records {
+		if funk.ContainsString(addressRecordTypes, strings.ToUpper(record.Type)) {
+			record.Type = strings.ToUpper(record.Type)
+			addresses = append(addresses, record)
+		}
+	}
+	opts.DNS = false
+	return generate.MonitorsFromRecords(addresses, opts)
+}
@@ -0,0 +1,72 @@
+package reconcile
+
+import (
+	"time"
+
+	"github.com/qameta/betterstack/client"
+)
+
+type EventType string
+
+// Plan finished, Event.Plan holds it
+const EventPlanComputed EventType = "plan_computed"
+
+// An owned monitor already matches the source, no update needed
+const EventResourceUpdateSkipped EventType = "resource_update_skipped"
+
+// An owned monitor the source no longer produces is kept: pruning is disabled, the source came back empty or a
+// client.DeleteGuard vetoed the delete
+const EventResourceDeleteBlocked EventType = "resource_delete_blocked"
+
+const EventResourceCreated EventType = "resource_created"
+const EventResourceUpdated EventType = "resource_updated"
+const EventResourceDeleted EventType = "resource_deleted"
+
+// Applying a change failed, Event.Err holds why
+const EventResourceFailed EventType = "resource_failed"
+
+// Apply finished or was cancelled, Event.Result holds what was done
+const EventApplyFinished EventType = "apply_finished"
+
+var appliedEvents = map[string]EventType{
+	ActionCreate: EventResourceCreated,
+	ActionUpdate: EventResourceUpdated,
+	ActionDelete: EventResourceDeleted,
+}
+
+// Event reports a step of planning or applying. Only the fields relevant to the type are set.
+type Event struct {
+	Type   EventType
+	At     time.Time
+	Source string
+
+	// Key of the monitor, for resource events
+	Key    string
+	Change *Change
+	Reason string
+	Err    error
+
+	Plan   *Plan
+	Result *Result
+}
+
+// EventHandler receives events synchronously, in order. Slow handlers slow the reconciler down.
+type EventHandler func(event Event)
+
+// ToChannel delivers events to the channel, blocking while it is full. Close the channel after Sync returned.
+func ToChannel(events chan<- Event) EventHandler {
+	return func(event Event) {
+		events <- event
+	}
+}
+
+func (r *Reconciler) emit(event Event) {
+	if r.Events == nil {
+		return
+	}
+	event.At = time.Now()
+	if event.Source == client.Blanc && r.Source != nil {
+		event.Source = r.Source.Name()
+	}
+	r.Events(event)
+}
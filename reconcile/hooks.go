@@ -0,0 +1,67 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+var ErrHookVetoed = errors.New("change vetoed by hook")
+
+// HookCall is what a hook is run with. Before hooks may adjust Change.Desired of creates and updates, after hooks
+// get the monitor as the API returned it, empty for deletes, or the error applying the change failed with.
+type HookCall struct {
+	Source  string
+	Change  *Change
+	Applied client.Monitor
+	Err     error
+}
+
+type HookFunc func(ctx context.Context, call HookCall) error
+
+// Hook runs code around the changes Apply makes, e.g. to annotate a dashboard, notify a chat or write an audit
+// journal. Hooks run synchronously, in registration order, also with a dry-run client.
+type Hook struct {
+	// Actions the hook runs for, every action when empty
+	Actions []string
+
+	// Runs before the change is applied, optional. An error skips the change, which fails with ErrHookVetoed
+	// wrapping it and doesn't count against the Budget.
+	Before HookFunc
+
+	// Runs after the change was applied or failed, optional. Errors are logged, the change stays applied.
+	After HookFunc
+}
+
+func (h Hook) runsFor(action string) bool {
+	return len(h.Actions) == 0 || funk.ContainsString(h.Actions, action)
+}
+
+// beforeHooks runs the before hooks, the first error vetoes the change
+func (r *Reconciler) beforeHooks(ctx context.Context, source string, change *Change) error {
+	for _, hook := range r.Hooks {
+		if hook.Before == nil || !hook.runsFor(change.Action) {
+			continue
+		}
+		if hookErr := hook.Before(ctx, HookCall{Source: source, Change: change}); hookErr != nil {
+			return fmt.Errorf("%w: %v", ErrHookVetoed, hookErr)
+		}
+	}
+	return nil
+}
+
+// afterHooks runs every after hook, failing ones are logged
+func (r *Reconciler) afterHooks(ctx context.Context, source string, change Change, applied client.Monitor, applyErr error) {
+	for _, hook := range r.Hooks {
+		if hook.After == nil || !hook.runsFor(change.Action) {
+			continue
+		}
+		var call = HookCall{Source: source, Change: &change, Applied: applied, Err: applyErr}
+		if hookErr := hook.After(ctx, call); hookErr != nil {
+			r.logger().Warn("after hook failed", "action", change.Action, "monitor", change.Key, "error", hookErr)
+		}
+	}
+}
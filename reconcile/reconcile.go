@@ -0,0 +1,448 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+	"github.com/qameta/betterstack/queue"
+	"github.com/thoas/go-funk"
+)
+
+const ActionCreate = "create"
+const ActionUpdate = "update"
+const ActionDelete = "delete"
+
+const DefaultInterval = 500 * time.Millisecond
+
+// Attributes the API sets itself or never returns, a difference in them is no reason to update
+var ignoredAttributes = []string{"id", "status", "team_name", "auth_password", "created_at", "updated_at"}
+
+// Source produces the monitors which should exist
+type Source interface {
+	Name() string
+	Monitors(ctx context.Context) ([]client.Monitor, error)
+}
+
+// KeyFunc identifies a monitor across the source and the account, monitors with equal keys are the same monitor
+type KeyFunc func(monitor client.Monitor) string
+
+// OwnsFunc returns true for account monitors managed by the source, only those are ever updated or deleted
+type OwnsFunc func(monitor client.Monitor) bool
+
+func ByName(monitor client.Monitor) string {
+	return monitor.PronounceableName
+}
+
+func OwnedByGroup(groupID string) OwnsFunc {
+	return func(monitor client.Monitor) bool {
+		return monitor.GroupID() == groupID
+	}
+}
+
+func OwnedByNamePrefix(prefix string) OwnsFunc {
+	return func(monitor client.Monitor) bool {
+		return strings.HasPrefix(monitor.PronounceableName, prefix)
+	}
+}
+
+type Change struct {
+	Action string
+	Key    string
+
+	// Monitor as the source wants it, empty for deletes
+	Desired client.Monitor
+
+	// Monitor as it is in the account, empty for creates
+	Current client.Monitor
+
+	// Attributes which differ, for updates
+	Fields []string
+}
+
+type Plan struct {
+	Source  string
+	Changes []Change
+
+	// Monitors modified since the last apply, only with a State. Applying the plan overwrites those changes.
+	Stale []StaleWarning
+
+	// Creates the API refused during planning, by key, only with ValidateRemote. They stay in Changes.
+	Rejected map[string]error
+}
+
+func (p Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// Jobs converts the plan for the durable queue, so a large plan survives interruptions and can be rolled back
+func (p Plan) Jobs() []queue.Job {
+	var result []queue.Job
+	for _, change := range p.Changes {
+		var desired = change.Desired
+		switch change.Action {
+		case ActionCreate:
+			result = append(result, queue.Job{Operation: queue.OperationCreateMonitor, Monitor: &desired})
+		case ActionUpdate:
+			result = append(result, queue.Job{Operation: queue.OperationUpdateMonitor, TargetID: change.Current.ID, Monitor: &desired})
+		case ActionDelete:
+			result = append(result, queue.Job{Operation: queue.OperationDeleteMonitor, TargetID: change.Current.ID})
+		}
+	}
+	return result
+}
+
+func (p Plan) String() string {
+	var builder strings.Builder
+	for _, change := range p.Changes {
+		switch change.Action {
+		case ActionUpdate:
+			fmt.Fprintf(&builder, "~ %s (%s)\n", change.Key, strings.Join(change.Fields, ", "))
+		case ActionCreate:
+			if rejectErr, rejected := p.Rejected[change.Key]; rejected {
+				fmt.Fprintf(&builder, "! %s (rejected: %v)\n", change.Key, rejectErr)
+				continue
+			}
+			fmt.Fprintf(&builder, "+ %s\n", change.Key)
+		case ActionDelete:
+			fmt.Fprintf(&builder, "- %s\n", change.Key)
+		}
+	}
+	return builder.String()
+}
+
+type Result struct {
+	Applied []Change
+	Failed  map[string]error
+}
+
+// Reconciler makes the monitors owned by a source match what the source produces
+type Reconciler struct {
+	Client *client.BetterstackClient
+	Source Source
+
+	// Defaults to ByName
+	Key KeyFunc
+
+	// Required, limits the reconciler to the monitors of the source
+	Owns OwnsFunc
+
+	// Delete owned monitors the source no longer produces
+	Prune bool
+
+	// Prune even when the source produces no monitors at all, which usually means the source is broken
+	AllowEmpty bool
+
+	// Minimum time between two API calls, defaults to DefaultInterval
+	Interval time.Duration
+
+	// Remembers applied changes to warn about monitors modified outside the reconciler, optional
+	State *State
+
+	// Receives lifecycle events to render progress or keep an audit trail, optional
+	Events EventHandler
+
+	// Run before and after each change Apply makes, optional
+	Hooks []Hook
+
+	// Receives the warnings of Plan and Apply, defaults to the logger of Client
+	Logger client.Logger
+
+	// Failures Apply skips and the overall duration of Plan and Apply, optional. An exhausted budget stops Apply,
+	// the remaining changes are left for the next run. So does a failure opening the client's circuit breaker.
+	Budget *client.Budget
+
+	// Validate every create against the API while planning, see client.ValidateMonitorRemote. Costs two API calls
+	// per create and needs a client which may write.
+	ValidateRemote bool
+}
+
+// Plan compares the source with the account without changing anything
+func (r *Reconciler) Plan(parent context.Context) (Plan, error) {
+	var ctx, cancel = r.Budget.Context(parent)
+	defer cancel()
+
+	var plan = Plan{Source: r.Source.Name()}
+	if r.Owns == nil {
+		return plan, errors.New("reconciler needs an Owns function")
+	}
+	var key = r.Key
+	if key == nil {
+		key = ByName
+	}
+
+	var desired, sourceErr = r.Source.Monitors(ctx)
+	if sourceErr != nil {
+		return plan, r.Budget.Explain(fmt.Errorf("failed to read source %s: %w", plan.Source, ctxErr(ctx, sourceErr)))
+	}
+
+	var monitors, listErr = r.Client.Monitors().List(ctx)
+	if listErr != nil {
+		return plan, r.Budget.Explain(fmt.Errorf("failed to list monitors: %w", ctxErr(ctx, listErr)))
+	}
+
+	var current = map[string]client.Monitor{}
+	for _, monitor := range monitors {
+		if r.Owns(monitor) {
+			current[key(monitor)] = monitor
+		}
+	}
+
+	var wanted = map[string]bool{}
+	for _, monitor := range desired {
+		var k = key(monitor)
+		if wanted[k] {
+			return plan, fmt.Errorf("source %s produced %q twice", plan.Source, k)
+		}
+		wanted[k] = true
+
+		var existing, found = current[k]
+		if !found {
+			plan.Changes = append(plan.Changes, Change{Action: ActionCreate, Key: k, Desired: monitor})
+			continue
+		}
+
+		var fields, diffErr = Diff(monitor, existing)
+		if diffErr != nil {
+			return plan, diffErr
+		}
+		if len(fields) > 0 {
+			plan.Changes = append(plan.Changes, Change{Action: ActionUpdate, Key: k, Desired: monitor, Current: existing, Fields: fields})
+		} else {
+			r.emit(Event{Type: EventResourceUpdateSkipped, Source: plan.Source, Key: k, Reason: "up to date"})
+		}
+	}
+
+	var prune = r.Prune && (len(desired) > 0 || r.AllowEmpty)
+	if r.Prune && !prune {
+		r.logger().Warn("source produced no monitors, not pruning", "source", plan.Source)
+	}
+
+	var keys = funk.Keys(current).([]string)
+	sort.Strings(keys)
+	for _, k := range keys {
+		switch {
+		case wanted[k]:
+			// Created or updated above
+		case prune:
+			plan.Changes = append(plan.Changes, Change{Action: ActionDelete, Key: k, Current: current[k]})
+		case r.Prune:
+			r.emit(Event{Type: EventResourceDeleteBlocked, Source: plan.Source, Key: k, Reason: "source produced no monitors"})
+		default:
+			r.emit(Event{Type: EventResourceDeleteBlocked, Source: plan.Source, Key: k, Reason: "pruning is disabled"})
+		}
+	}
+
+	if r.State != nil {
+		plan.Stale = r.State.Stale(current, plan.Changes)
+		for _, warning := range plan.Stale {
+			r.logger().Warn("monitor modified since it was applied", "monitor", warning.Key, "id", warning.Monitor.ID,
+				"updated_at", warning.UpdatedAt, "applied_at", warning.AppliedAt)
+		}
+	}
+
+	if r.ValidateRemote {
+		plan.Rejected = map[string]error{}
+		for _, change := range plan.Changes {
+			if change.Action != ActionCreate {
+				continue
+			}
+			if validateErr := r.Client.Monitors().ValidateRemote(ctx, change.Desired); validateErr != nil {
+				if errors.Is(validateErr, client.ErrValidationUnavailable) {
+					return plan, validateErr
+				}
+				plan.Rejected[change.Key] = validateErr
+			}
+		}
+	}
+
+	r.emit(Event{Type: EventPlanComputed, Source: plan.Source, Plan: &plan})
+
+	return plan, nil
+}
+
+// Apply executes the plan in order. It keeps going when single changes fail and reports them in Result.Failed, until
+// the Budget runs out.
+func (r *Reconciler) Apply(parent context.Context, plan Plan) (Result, error) {
+	var result = Result{Failed: map[string]error{}}
+
+	var ctx, cancel = r.Budget.Context(parent)
+	defer cancel()
+
+	var interval = r.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	var ticker = time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var cancelErr error
+changes:
+	for i, change := range plan.Changes {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				cancelErr = r.Budget.Explain(ctx.Err())
+				break changes
+			case <-ticker.C:
+			}
+		}
+
+		if hookErr := r.beforeHooks(ctx, plan.Source, &change); hookErr != nil {
+			r.logger().Warn("change vetoed by hook", "action", change.Action, "monitor", change.Key, "error", hookErr)
+			result.Failed[change.Key] = hookErr
+			r.emit(Event{Type: EventResourceFailed, Source: plan.Source, Key: change.Key, Change: &change,
+				Reason: "vetoed by hook", Err: hookErr})
+			continue
+		}
+
+		var applied client.MonitorResponse
+		var applyErr error
+		switch change.Action {
+		case ActionCreate:
+			applied, applyErr = r.Client.Monitors().Create(ctx, change.Desired)
+		case ActionUpdate:
+			applied, applyErr = r.Client.Monitors().Update(ctx, change.Current.ID, change.Desired)
+		case ActionDelete:
+			applyErr = r.Client.Monitors().Delete(ctx, change.Current.ID)
+		default:
+			applyErr = fmt.Errorf("unknown action: %q", change.Action)
+		}
+
+		if applyErr != nil && ctx.Err() != nil {
+			// Interrupted mid-call, the change may or may not have been applied and is left to the next plan
+			cancelErr = r.Budget.Explain(ctx.Err())
+			break changes
+		}
+		r.afterHooks(ctx, plan.Source, change, applied.Data.Attributes, applyErr)
+		if applyErr != nil {
+			r.logger().Warn("change failed", "action", change.Action, "monitor", change.Key, "error", applyErr)
+			result.Failed[change.Key] = applyErr
+			if errors.Is(applyErr, client.ErrDeleteVetoed) {
+				r.emit(Event{Type: EventResourceDeleteBlocked, Source: plan.Source, Key: change.Key, Change: &change,
+					Reason: "delete vetoed", Err: applyErr})
+				continue
+			}
+			r.emit(Event{Type: EventResourceFailed, Source: plan.Source, Key: change.Key, Change: &change, Err: applyErr})
+			if r.Client.CircuitOpen() {
+				cancelErr = fmt.Errorf("stopped after %d changes: %w", i+1, client.ErrCircuitOpen)
+				break changes
+			}
+			if budgetErr := r.Budget.Spend(applyErr); budgetErr != nil {
+				cancelErr = budgetErr
+				break changes
+			}
+			continue
+		}
+		result.Applied = append(result.Applied, change)
+		r.emit(Event{Type: appliedEvents[change.Action], Source: plan.Source, Key: change.Key, Change: &change})
+
+		if r.State != nil {
+			if change.Action == ActionDelete {
+				delete(r.State.Records, change.Key)
+			} else {
+				r.State.record(change.Key, change.Desired, applied.Data.Attributes)
+			}
+		}
+	}
+
+	if r.State != nil && len(result.Applied) > 0 {
+		if saveErr := r.State.Save(); saveErr != nil {
+			r.emit(Event{Type: EventApplyFinished, Source: plan.Source, Result: &result, Err: saveErr})
+			return result, saveErr
+		}
+	}
+
+	r.emit(Event{Type: EventApplyFinished, Source: plan.Source, Result: &result, Err: cancelErr})
+
+	return result, cancelErr
+}
+
+// Sync plans and applies in one go
+func (r *Reconciler) Sync(ctx context.Context) (Plan, Result, error) {
+	var plan, planErr = r.Plan(ctx)
+	if planErr != nil {
+		return plan, Result{}, planErr
+	}
+	var result, applyErr = r.Apply(ctx, plan)
+	return plan, result, applyErr
+}
+
+// Diff lists the attributes of desired which differ in current. Attributes serialized with omitempty are only
+// compared when desired sets them, so sources leave unset ones alone but can't clear them either. monitor_type,
+// url, pronounceable_name and the email, sms, call and push flags are always compared: false on desired turns an
+// alert off. Both sides are normalized the way Create and Update send a monitor first, expected status codes
+// sorted and deduplicated, request headers without the IDs the API assigns.
+func Diff(desired, current client.Monitor) ([]string, error) {
+	var wanted, wantedErr = attributes(desired)
+	if wantedErr != nil {
+		return nil, wantedErr
+	}
+	var actual, actualErr = attributes(current)
+	if actualErr != nil {
+		return nil, actualErr
+	}
+
+	var result []string
+	for name, value := range wanted {
+		if value == nil || funk.ContainsString(ignoredAttributes, name) {
+			continue
+		}
+		if name == "monitor_group_id" {
+			if desired.GroupID() != current.GroupID() {
+				result = append(result, name)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(value, actual[name]) {
+			result = append(result, name)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// ctxErr prefers the context error, client errors caused by it are reported with %v and can't be unwrapped
+func ctxErr(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+func attributes(monitor client.Monitor) (map[string]any, error) {
+	// Header IDs are assigned by the API
+	var headers = make([]client.RequestHeader, 0, len(monitor.RequestHeaders))
+	for _, header := range monitor.RequestHeaders {
+		headers = append(headers, client.RequestHeader{Name: header.Name, Value: header.Value})
+	}
+	monitor.RequestHeaders = headers
+
+	// Invalid codes are left as they are, Create and Update reject them
+	if codes, codesErr := client.NormalizeStatusCodes(monitor.ExpectedStatusCodes); codesErr == nil {
+		monitor.ExpectedStatusCodes = codes
+	}
+
+	var serialized, serErr = json.Marshal(monitor)
+	if serErr != nil {
+		return nil, fmt.Errorf("failed to serialize monitor: %v", serErr)
+	}
+	var result map[string]any
+	if unmErr := json.Unmarshal(serialized, &result); unmErr != nil {
+		return nil, fmt.Errorf("failed to deserialize monitor: %v", unmErr)
+	}
+	return result, nil
+}
+
+func (r *Reconciler) logger() client.Logger {
+	if r.Logger != nil {
+		return r.Logger
+	}
+	return r.Client.Logger()
+}
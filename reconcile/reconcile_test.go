@@ -0,0 +1,41 @@
+package reconcile
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/qameta/betterstack/client"
+)
+
+func TestDiff(t *testing.T) {
+	var current = client.Monitor{ID: "1", MonitorType: "status", URL: "https://example.com", PronounceableName: "shop",
+		Email: true, Paused: true, ExpectedStatusCodes: []int{200, 204}, Status: "up",
+		RequestHeaders: []client.RequestHeader{{ID: "9", Name: "X-Key", Value: "key"}}}
+
+	var cases = []struct {
+		name     string
+		change   func(m *client.Monitor)
+		expected []string
+	}{
+		{"unchanged", func(m *client.Monitor) {}, nil},
+		{"status codes unsorted", func(m *client.Monitor) { m.ExpectedStatusCodes = []int{204, 200, 200} }, nil},
+		{"status codes changed", func(m *client.Monitor) { m.ExpectedStatusCodes = []int{200} }, []string{"expected_status_codes"}},
+		{"header IDs", func(m *client.Monitor) { m.RequestHeaders[0].ID = client.Blanc }, nil},
+		{"omitempty left unset", func(m *client.Monitor) { m.Paused = false }, nil},
+		{"alert flag turned off", func(m *client.Monitor) { m.Email = false }, []string{"email"}},
+		{"url always compared", func(m *client.Monitor) { m.URL = client.Blanc }, []string{"url"}},
+		{"ignored attributes", func(m *client.Monitor) { m.ID = "2"; m.Status = "down" }, nil},
+	}
+	for _, tc := range cases {
+		var desired = current
+		desired.RequestHeaders = append([]client.RequestHeader(nil), current.RequestHeaders...)
+		tc.change(&desired)
+		var fields, diffErr = Diff(desired, current)
+		if diffErr != nil {
+			t.Fatalf("%s: %v", tc.name, diffErr)
+		}
+		if !reflect.DeepEqual(fields, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, fields)
+		}
+	}
+}
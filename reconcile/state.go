@@ -0,0 +1,146 @@
+package reconcile
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+// Clock skew between the API and the updated_at it reports right after an apply
+const staleTolerance = time.Second
+
+// AppliedRecord is what the reconciler last applied to a monitor
+type AppliedRecord struct {
+	MonitorID string    `json:"monitor_id"`
+	Hash      string    `json:"hash"`
+	AppliedAt time.Time `json:"applied_at"`
+
+	// updated_at the API reported for the applied change
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// State remembers applied changes across runs, so changes made in the UI after the last apply can be detected
+type State struct {
+	path    string
+	Records map[string]AppliedRecord `json:"records"`
+}
+
+// StaleWarning reports a monitor modified after the reconciler last applied it
+type StaleWarning struct {
+	Key       string
+	Monitor   client.Monitor
+	AppliedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (w StaleWarning) String() string {
+	return fmt.Sprintf("monitor %s (%s) was modified at %s, after it was applied at %s", w.Key, w.Monitor.ID,
+		w.UpdatedAt.Format(time.RFC3339), w.AppliedAt.Format(time.RFC3339))
+}
+
+// LoadState reads the state file at path, a missing file is an empty state
+func LoadState(path string) (*State, error) {
+	var result = &State{path: path, Records: map[string]AppliedRecord{}}
+
+	var data, readErr = os.ReadFile(path)
+	if errors.Is(readErr, os.ErrNotExist) {
+		return result, nil
+	}
+	if readErr != nil {
+		return nil, fmt.Errorf("failed to read state: %v", readErr)
+	}
+	if unmErr := json.Unmarshal(data, result); unmErr != nil {
+		return nil, fmt.Errorf("failed to parse state: %v", unmErr)
+	}
+	if result.Records == nil {
+		result.Records = map[string]AppliedRecord{}
+	}
+	return result, nil
+}
+
+// Save writes the state atomically
+func (s *State) Save() error {
+	var data, serErr = json.Marshal(s)
+	if serErr != nil {
+		return fmt.Errorf("failed to serialize state: %v", serErr)
+	}
+
+	var temporary = s.path + ".tmp"
+	if writeErr := os.WriteFile(temporary, data, 0o600); writeErr != nil {
+		return fmt.Errorf("failed to write state: %v", writeErr)
+	}
+	if renameErr := os.Rename(temporary, s.path); renameErr != nil {
+		return fmt.Errorf("failed to write state: %v", renameErr)
+	}
+	return nil
+}
+
+func (s *State) record(key string, desired, applied client.Monitor) {
+	if applied.UpdatedAt == nil || funk.IsEmpty(applied.ID) {
+		// Dry runs and responses without timestamps give nothing to compare against later
+		return
+	}
+	var hash, _ = Hash(desired)
+	s.Records[key] = AppliedRecord{
+		MonitorID: applied.ID,
+		Hash:      hash,
+		AppliedAt: time.Now(),
+		UpdatedAt: *applied.UpdatedAt,
+	}
+}
+
+// Stale compares owned monitors with the state, current is keyed like the plan. A monitor is stale when it was
+// updated after the last apply, or when the plan updates it although its desired attributes have the hash last
+// applied, i.e. the difference was made elsewhere.
+func (s *State) Stale(current map[string]client.Monitor, changes []Change) []StaleWarning {
+	var result []StaleWarning
+	var reported = map[string]bool{}
+	for key, monitor := range current {
+		var record, found = s.Records[key]
+		if !found || monitor.UpdatedAt == nil || record.MonitorID != monitor.ID {
+			continue
+		}
+		if monitor.UpdatedAt.Sub(record.UpdatedAt) > staleTolerance {
+			result = append(result, StaleWarning{Key: key, Monitor: monitor, AppliedAt: record.AppliedAt, UpdatedAt: *monitor.UpdatedAt})
+			reported[key] = true
+		}
+	}
+	for _, change := range changes {
+		var record, found = s.Records[change.Key]
+		if change.Action != ActionUpdate || !found || reported[change.Key] {
+			continue
+		}
+		if hash, _ := Hash(change.Desired); hash == record.Hash {
+			var warning = StaleWarning{Key: change.Key, Monitor: change.Current, AppliedAt: record.AppliedAt}
+			if change.Current.UpdatedAt != nil {
+				warning.UpdatedAt = *change.Current.UpdatedAt
+			}
+			result = append(result, warning)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Key < result[j].Key })
+	return result
+}
+
+// Hash fingerprints the attributes of a desired monitor
+func Hash(monitor client.Monitor) (string, error) {
+	var attributes, attrErr = attributes(monitor)
+	if attrErr != nil {
+		return client.Blanc, attrErr
+	}
+	// The JSON of maps has sorted keys with the standard library compatible configuration
+	var serialized, serErr = json.ConfigCompatibleWithStandardLibrary.Marshal(attributes)
+	if serErr != nil {
+		return client.Blanc, fmt.Errorf("failed to serialize monitor: %v", serErr)
+	}
+	var sum = sha256.Sum256(serialized)
+	return hex.EncodeToString(sum[:]), nil
+}
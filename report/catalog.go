@@ -0,0 +1,177 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+	"gopkg.in/yaml.v3"
+)
+
+// Catalog is the declared list of services, in YAML or JSON:
+//
+//	services:
+//	  - name: checkout
+//	    group: Shop
+//	    urls: [https://shop.example.com/checkout, https://api.example.com/orders]
+type Catalog struct {
+	Services []CatalogService `yaml:"services" json:"services"`
+}
+
+type CatalogService struct {
+	Name string   `yaml:"name" json:"name"`
+	URLs []string `yaml:"urls" json:"urls"`
+
+	// Monitor group of the service, optional. Monitors in the group belong to the service whatever their URL.
+	Group string `yaml:"group" json:"group"`
+}
+
+func LoadCatalog(path string) (Catalog, error) {
+	var data, readErr = os.ReadFile(path)
+	if readErr != nil {
+		return Catalog{}, fmt.Errorf("failed to read catalog: %v", readErr)
+	}
+	return ParseCatalog(data)
+}
+
+func ParseCatalog(data []byte) (Catalog, error) {
+	var result Catalog
+	if unmErr := yaml.Unmarshal(data, &result); unmErr != nil {
+		return result, fmt.Errorf("failed to parse catalog: %v", unmErr)
+	}
+	for i, service := range result.Services {
+		if funk.IsEmpty(strings.TrimSpace(service.Name)) {
+			return result, fmt.Errorf("service %d has no name", i+1)
+		}
+	}
+	return result, nil
+}
+
+type CoverageOptions struct {
+	// Any monitor on the host of a service URL covers it, instead of monitors on the same host and path only
+	MatchHost bool
+}
+
+type ServiceCoverage struct {
+	Service  CatalogService
+	Monitors []client.Monitor
+
+	// URLs of the service no monitor points at
+	Missing []string
+}
+
+// Covered returns true when at least one monitor belongs to the service
+func (s ServiceCoverage) Covered() bool {
+	return len(s.Monitors) > 0
+}
+
+type CoverageReport struct {
+	GeneratedAt time.Time
+
+	// Every service of the catalog in catalog order
+	Services []ServiceCoverage
+
+	// Services no monitor belongs to
+	Uncovered []CatalogService
+
+	// Monitors with a URL none of the services declares, sorted by name
+	Uncataloged []client.Monitor
+}
+
+// FleetCoverage lists monitors and monitor groups of the account and compares them with the catalog
+func FleetCoverage(ctx context.Context, c *client.BetterstackClient, catalog Catalog, opts CoverageOptions) (CoverageReport, error) {
+	var monitors, monsErr = c.Monitors().List(ctx)
+	if monsErr != nil {
+		return CoverageReport{}, fmt.Errorf("failed to list monitors: %v", monsErr)
+	}
+	var groups, groupsErr = c.MonitorGroups().List(ctx)
+	if groupsErr != nil {
+		return CoverageReport{}, fmt.Errorf("failed to list monitor groups: %v", groupsErr)
+	}
+	return Coverage(catalog, monitors, groups, opts), nil
+}
+
+// Coverage matches monitors to the services of the catalog by URL and monitor group. Monitors without a URL, such
+// as heartbeats, are never uncataloged.
+func Coverage(catalog Catalog, monitors []client.Monitor, groups []client.MonitorGroup, opts CoverageOptions) CoverageReport {
+	var report = CoverageReport{GeneratedAt: time.Now()}
+
+	var groupIDs = map[string]string{}
+	for _, group := range groups {
+		groupIDs[strings.ToLower(group.Name)] = group.ID
+	}
+
+	var cataloged = map[string]bool{}
+	for _, service := range catalog.Services {
+		var coverage = ServiceCoverage{Service: service}
+		var groupID = groupIDs[strings.ToLower(strings.TrimSpace(service.Group))]
+
+		var matched = map[string]bool{}
+		for _, monitor := range monitors {
+			var inGroup = funk.NotEmpty(groupID) && monitor.GroupID() == groupID
+			var matchesURL bool
+			for _, serviceURL := range service.URLs {
+				if coverageKey(serviceURL, opts.MatchHost) == coverageKey(monitor.URL, opts.MatchHost) {
+					matched[serviceURL] = true
+					matchesURL = true
+				}
+			}
+			if inGroup || matchesURL {
+				coverage.Monitors = append(coverage.Monitors, monitor)
+				cataloged[monitor.ID] = true
+			}
+		}
+
+		for _, serviceURL := range service.URLs {
+			if !matched[serviceURL] {
+				coverage.Missing = append(coverage.Missing, serviceURL)
+			}
+		}
+		if !coverage.Covered() {
+			report.Uncovered = append(report.Uncovered, service)
+		}
+		report.Services = append(report.Services, coverage)
+	}
+
+	for _, monitor := range monitors {
+		if !cataloged[monitor.ID] && funk.NotEmpty(coverageKey(monitor.URL, false)) {
+			report.Uncataloged = append(report.Uncataloged, monitor)
+		}
+	}
+	sort.SliceStable(report.Uncataloged, func(i, j int) bool {
+		return report.Uncataloged[i].PronounceableName < report.Uncataloged[j].PronounceableName
+	})
+
+	return report
+}
+
+// coverageKey normalizes a URL for comparison: scheme, query, fragment and trailing slashes are ignored, hosts
+// compare case-insensitively. Host-only monitor URLs, as ping and TCP monitors have, work as well.
+func coverageKey(rawURL string, hostOnly bool) string {
+	rawURL = strings.TrimSpace(rawURL)
+	if funk.IsEmpty(rawURL) {
+		return client.Blanc
+	}
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "//" + rawURL
+	}
+	var parsedURL, parseErr = url.Parse(rawURL)
+	if parseErr != nil || funk.IsEmpty(parsedURL.Hostname()) {
+		return client.Blanc
+	}
+
+	var host = strings.ToLower(parsedURL.Hostname())
+	if hostOnly {
+		return host
+	}
+	if port := parsedURL.Port(); funk.NotEmpty(port) && port != "80" && port != "443" {
+		host += ":" + port
+	}
+	return host + strings.TrimRight(parsedURL.Path, "/")
+}
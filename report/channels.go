@@ -0,0 +1,125 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+// ChannelRow is the notification setup of one monitor
+type ChannelRow struct {
+	MonitorID string
+	Name      string
+
+	Email bool
+	SMS   bool
+	Call  bool
+	Push  bool
+
+	// Name of the escalation policy, blank when the monitor has none
+	Policy string
+
+	// Seconds before the whole team is alerted, zero never escalates to the team
+	TeamWait int
+
+	Paused bool
+
+	// An incident of the monitor alerts no one: no channel is enabled and no working escalation policy is set, team
+	// escalation goes through the same channels
+	PagesNobody bool
+
+	Problems []string
+}
+
+type ChannelMatrix struct {
+	GeneratedAt time.Time
+
+	// Sorted by monitor name
+	Rows []ChannelRow
+
+	// Active monitors an incident would not notify anybody about, paused monitors are left out
+	PagesNobody []ChannelRow
+}
+
+// FleetChannelMatrix reports the notification channels and escalation of every monitor of the account
+func FleetChannelMatrix(ctx context.Context, c *client.BetterstackClient) (ChannelMatrix, error) {
+	var monitors, monsErr = c.Monitors().List(ctx)
+	if monsErr != nil {
+		return ChannelMatrix{}, fmt.Errorf("failed to list monitors: %v", monsErr)
+	}
+	var policies, policiesErr = c.Policies().List(ctx)
+	if policiesErr != nil {
+		return ChannelMatrix{}, fmt.Errorf("failed to list policies: %v", policiesErr)
+	}
+	return NotificationChannels(monitors, policies), nil
+}
+
+// NotificationChannels lines up the channels, escalation policy and team wait of the monitors. Policies referenced by
+// monitors but missing from policies are reported as deleted.
+func NotificationChannels(monitors []client.Monitor, policies []client.Policy) ChannelMatrix {
+	var policyByID = map[string]client.Policy{}
+	for _, policy := range policies {
+		policyByID[policy.ID] = policy
+	}
+
+	var result = ChannelMatrix{GeneratedAt: time.Now()}
+	for _, monitor := range monitors {
+		var row = ChannelRow{
+			MonitorID: monitor.ID,
+			Name:      monitor.PronounceableName,
+			Email:     monitor.Email,
+			SMS:       monitor.SMS,
+			Call:      monitor.Call,
+			Push:      monitor.Push,
+			TeamWait:  monitor.TeamWait,
+			Paused:    monitor.Paused,
+		}
+
+		var anyChannel = monitor.Email || monitor.SMS || monitor.Call || monitor.Push
+		var escalates bool
+
+		if funk.NotEmpty(monitor.PolicyID) {
+			var policy, found = policyByID[monitor.PolicyID]
+			switch {
+			case !found:
+				row.Policy = monitor.PolicyID
+				row.Problems = append(row.Problems, fmt.Sprintf("escalation policy %s does not exist", monitor.PolicyID))
+			case !notifiesAnyone(policy):
+				row.Policy = policy.Name
+				row.Problems = append(row.Problems, fmt.Sprintf("escalation policy %s has no step members", policy.Name))
+			default:
+				row.Policy = policy.Name
+				escalates = true
+			}
+		}
+
+		if !anyChannel {
+			row.Problems = append(row.Problems, "no notification channel enabled")
+		}
+		row.PagesNobody = !anyChannel && !escalates
+
+		result.Rows = append(result.Rows, row)
+	}
+
+	sort.SliceStable(result.Rows, func(i, j int) bool { return result.Rows[i].Name < result.Rows[j].Name })
+	for _, row := range result.Rows {
+		if row.PagesNobody && !row.Paused {
+			result.PagesNobody = append(result.PagesNobody, row)
+		}
+	}
+
+	return result
+}
+
+func notifiesAnyone(policy client.Policy) bool {
+	for _, step := range policy.Steps {
+		if len(step.StepMembers) > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,259 @@
+package report
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+const WhoisIANA = "whois.iana.org:43"
+
+var whoisReferPattern = regexp.MustCompile(`(?im)^\s*(?:refer|whois server|registrar whois server):\s*(\S+)`)
+var whoisExpiryPattern = regexp.MustCompile(`(?im)^\s*(?:registry expiry date|registrar registration expiration date|expiration date|expiry date|expire date|expires on|expires|paid-till|renewal date):\s*(.+?)\s*$`)
+
+var whoisTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05Z",
+	"2006-01-02T15:04:05.0Z",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"2006.01.02",
+	"02-Jan-2006",
+	"2006/01/02",
+}
+
+// DomainStatus aggregates all monitors pointing at one registered domain
+type DomainStatus struct {
+	Domain   string
+	Monitors []client.Monitor
+
+	// Largest domain_expiration alert configured among the monitors, 0 when none alerts on expiration
+	AlertDays int
+
+	ExpiresAt time.Time
+	DaysLeft  int
+	Err       error
+}
+
+type DomainReportOptions struct {
+	// Timeout of a single WHOIS query, defaults to 10 seconds
+	Timeout time.Duration
+
+	// How many domains are looked up in parallel, defaults to 8
+	Concurrency int
+
+	// Returns the expiration date of a registered domain, defaults to WhoisExpiry
+	Lookup func(domain string) (time.Time, error)
+
+	// Maps a host name onto its registered domain, defaults to RegisteredDomain
+	DomainOf func(host string) string
+
+	// Reference point for expiration, defaults to time.Now()
+	Now time.Time
+}
+
+type DomainExpirationReport struct {
+	GeneratedAt time.Time
+
+	// Every monitored domain sorted by expiration date, domains which failed the lookup go last
+	Domains []DomainStatus
+
+	// Domains none of whose monitors has domain_expiration alerting configured
+	Unalerted []DomainStatus
+}
+
+// FleetDomainExpiration lists all monitors of the account and audits their domains
+func FleetDomainExpiration(ctx context.Context, c *client.BetterstackClient, opts DomainReportOptions) (DomainExpirationReport, error) {
+	var monitors, monsErr = c.Monitors().List(ctx)
+	if monsErr != nil {
+		return DomainExpirationReport{}, fmt.Errorf("failed to list monitors: %v", monsErr)
+	}
+	return DomainExpiration(monitors, opts), nil
+}
+
+// DomainExpiration groups monitors by registered domain, looks up the actual expiration of each domain and flags
+// domains which have no expiration alerting configured. Monitors pointing at IP addresses are skipped.
+func DomainExpiration(monitors []client.Monitor, opts DomainReportOptions) DomainExpirationReport {
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultProbeTimeout
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultConcurrency
+	}
+	if opts.Lookup == nil {
+		var timeout = opts.Timeout
+		opts.Lookup = func(domain string) (time.Time, error) {
+			return WhoisExpiry(domain, timeout)
+		}
+	}
+	if opts.DomainOf == nil {
+		opts.DomainOf = RegisteredDomain
+	}
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+
+	var byDomain = map[string]*DomainStatus{}
+	for _, monitor := range monitors {
+		var host = monitorHost(monitor.URL)
+		if funk.IsEmpty(host) || net.ParseIP(host) != nil {
+			continue
+		}
+
+		var domain = opts.DomainOf(host)
+		var status, found = byDomain[domain]
+		if !found {
+			status = &DomainStatus{Domain: domain}
+			byDomain[domain] = status
+		}
+		status.Monitors = append(status.Monitors, monitor)
+		if monitor.DomainExpiration > status.AlertDays {
+			status.AlertDays = monitor.DomainExpiration
+		}
+	}
+
+	var semaphore = make(chan struct{}, opts.Concurrency)
+	var wg sync.WaitGroup
+	for _, status := range byDomain {
+		wg.Add(1)
+		go func(status *DomainStatus) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			var expiresAt, lookupErr = opts.Lookup(status.Domain)
+			if lookupErr != nil {
+				status.Err = lookupErr
+				return
+			}
+			status.ExpiresAt = expiresAt
+			status.DaysLeft = int(expiresAt.Sub(opts.Now).Hours() / 24)
+		}(status)
+	}
+	wg.Wait()
+
+	var result = DomainExpirationReport{GeneratedAt: opts.Now}
+	for _, status := range byDomain {
+		result.Domains = append(result.Domains, *status)
+	}
+	sort.Slice(result.Domains, func(i, j int) bool {
+		var left, right = result.Domains[i], result.Domains[j]
+		if left.ExpiresAt.IsZero() != right.ExpiresAt.IsZero() {
+			return right.ExpiresAt.IsZero()
+		}
+		if !left.ExpiresAt.Equal(right.ExpiresAt) {
+			return left.ExpiresAt.Before(right.ExpiresAt)
+		}
+		return left.Domain < right.Domain
+	})
+
+	for _, status := range result.Domains {
+		if status.AlertDays == 0 {
+			result.Unalerted = append(result.Unalerted, status)
+		}
+	}
+
+	return result
+}
+
+// WhoisExpiry asks IANA for the WHOIS server responsible for the TLD of the domain, follows referrals and parses
+// the expiration date out of the answer.
+func WhoisExpiry(domain string, timeout time.Duration) (time.Time, error) {
+	var server = WhoisIANA
+	var visited = map[string]bool{}
+
+	for len(visited) < 3 {
+		visited[server] = true
+
+		var answer, queryErr = whoisQuery(server, domain, timeout)
+		if queryErr != nil {
+			return time.Time{}, queryErr
+		}
+
+		if match := whoisExpiryPattern.FindStringSubmatch(answer); match != nil {
+			return parseWhoisTime(match[1])
+		}
+
+		var refer = whoisReferPattern.FindStringSubmatch(answer)
+		if refer == nil {
+			break
+		}
+		var next = strings.TrimPrefix(strings.TrimPrefix(refer[1], "whois://"), "rwhois://")
+		if !strings.Contains(next, ":") {
+			next = next + ":43"
+		}
+		if visited[next] {
+			break
+		}
+		server = next
+	}
+
+	return time.Time{}, fmt.Errorf("no expiration date found in WHOIS data for %s", domain)
+}
+
+// RegisteredDomain returns the last two labels of a host name. It does not consult the public suffix list, so
+// multi-label suffixes such as co.uk have to be handled with a custom DomainReportOptions.DomainOf.
+func RegisteredDomain(host string) string {
+	var labels = strings.Split(strings.TrimSuffix(strings.ToLower(host), "."), ".")
+	if len(labels) <= 2 {
+		return strings.Join(labels, ".")
+	}
+	return strings.Join(labels[len(labels)-2:], ".")
+}
+
+func whoisQuery(server, domain string, timeout time.Duration) (string, error) {
+	var conn, dialErr = net.DialTimeout("tcp", server, timeout)
+	if dialErr != nil {
+		return client.Blanc, fmt.Errorf("failed to connect to %s: %v", server, dialErr)
+	}
+	defer conn.Close()
+
+	_ = conn.SetDeadline(time.Now().Add(timeout))
+
+	if _, writeErr := fmt.Fprintf(conn, "%s\r\n", domain); writeErr != nil {
+		return client.Blanc, fmt.Errorf("failed to query %s: %v", server, writeErr)
+	}
+
+	var answer, readErr = io.ReadAll(bufio.NewReader(conn))
+	if readErr != nil {
+		return client.Blanc, fmt.Errorf("failed to read answer of %s: %v", server, readErr)
+	}
+
+	return string(answer), nil
+}
+
+func parseWhoisTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range whoisTimeLayouts {
+		if parsed, parseErr := time.Parse(layout, value); parseErr == nil {
+			return parsed, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unsupported WHOIS date format: %s", value)
+}
+
+// monitorHost extracts the host from a monitor URL, which for ping, tcp or dns monitors may be a bare host name
+func monitorHost(rawURL string) string {
+	if funk.IsEmpty(rawURL) {
+		return client.Blanc
+	}
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "//" + rawURL
+	}
+	var parsedURL, parseErr = url.Parse(rawURL)
+	if parseErr != nil {
+		return client.Blanc
+	}
+	return strings.ToLower(parsedURL.Hostname())
+}
@@ -0,0 +1,203 @@
+package report
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+const FormatCSV = "csv"
+const FormatParquet = "parquet"
+
+// IncidentRow is one exported incident, flat for loading into a warehouse
+type IncidentRow struct {
+	ID          string
+	MonitorID   string
+	MonitorName string
+	Name        string
+	Cause       string
+	Status      string
+	TeamName    string
+	Regions     []string
+
+	StartedAt      *time.Time
+	AcknowledgedAt *time.Time
+	AcknowledgedBy string
+	ResolvedAt     *time.Time
+	ResolvedBy     string
+}
+
+// Duration from start to resolution, nil while the incident is open
+func (r IncidentRow) Duration() *time.Duration {
+	if r.StartedAt == nil || r.ResolvedAt == nil {
+		return nil
+	}
+	var duration = r.ResolvedAt.Sub(*r.StartedAt)
+	return &duration
+}
+
+// incidentColumns are the column names of both formats, in order
+var incidentColumns = []string{
+	"incident_id", "monitor_id", "monitor_name", "name", "cause", "status", "team_name", "regions",
+	"started_at", "acknowledged_at", "acknowledged_by", "resolved_at", "resolved_by", "duration_seconds",
+}
+
+// CollectIncidentRows lists the incidents started between from and to, monitor names are taken from the current
+// monitors and fall back to the incident name for deleted ones
+func CollectIncidentRows(ctx context.Context, c *client.BetterstackClient, from, to time.Time) ([]IncidentRow, error) {
+	var monitors, monsErr = c.Monitors().List(ctx)
+	if monsErr != nil {
+		return nil, fmt.Errorf("failed to list monitors: %v", monsErr)
+	}
+	var names = map[string]string{}
+	for _, monitor := range monitors {
+		names[monitor.ID] = monitor.PronounceableName
+	}
+
+	var result []IncidentRow
+	var pages = c.Incidents().Pages(from, to)
+	for pages.Next(ctx) {
+		for _, entity := range pages.Page().Data {
+			var incident = entity.Attributes
+			var monitorID = entity.Relationships["monitor"].Data.ID
+			var monitorName = names[monitorID]
+			if funk.IsEmpty(monitorName) {
+				monitorName = incident.Name
+			}
+			result = append(result, IncidentRow{
+				ID:             entity.ID,
+				MonitorID:      monitorID,
+				MonitorName:    monitorName,
+				Name:           incident.Name,
+				Cause:          incident.Cause,
+				Status:         incident.Status,
+				TeamName:       incident.TeamName,
+				Regions:        incident.Regions,
+				StartedAt:      incident.StartedAt,
+				AcknowledgedAt: incident.AcknowledgedAt,
+				AcknowledgedBy: incident.AcknowledgedBy,
+				ResolvedAt:     incident.ResolvedAt,
+				ResolvedBy:     incident.ResolvedBy,
+			})
+		}
+	}
+	if pagesErr := pages.Err(); pagesErr != nil {
+		return nil, fmt.Errorf("failed to list incidents: %v", pagesErr)
+	}
+	return result, nil
+}
+
+// ExportIncidents writes the incidents started between from and to in FormatCSV or FormatParquet and returns how
+// many were written
+func ExportIncidents(ctx context.Context, c *client.BetterstackClient, from, to time.Time, w io.Writer, format string) (int, error) {
+	var write func(w io.Writer, rows []IncidentRow) error
+	switch strings.ToLower(format) {
+	case FormatCSV:
+		write = WriteIncidentsCSV
+	case FormatParquet:
+		write = WriteIncidentsParquet
+	default:
+		return 0, fmt.Errorf("unknown export format %q, expected %s or %s", format, FormatCSV, FormatParquet)
+	}
+
+	var rows, collectErr = CollectIncidentRows(ctx, c, from, to)
+	if collectErr != nil {
+		return 0, collectErr
+	}
+	if writeErr := write(w, rows); writeErr != nil {
+		return 0, writeErr
+	}
+	return len(rows), nil
+}
+
+// WriteIncidentsCSV writes a header and one line per incident. Times are RFC 3339 in UTC, missing times and the
+// duration of open incidents are empty, regions are separated by semicolons.
+func WriteIncidentsCSV(w io.Writer, rows []IncidentRow) error {
+	var writer = csv.NewWriter(w)
+	if writeErr := writer.Write(incidentColumns); writeErr != nil {
+		return fmt.Errorf("failed to write CSV: %v", writeErr)
+	}
+
+	var format = func(at *time.Time) string {
+		if at == nil {
+			return client.Blanc
+		}
+		return at.UTC().Format(time.RFC3339)
+	}
+	for _, row := range rows {
+		var duration string
+		if d := row.Duration(); d != nil {
+			duration = strconv.FormatInt(int64(d.Seconds()), 10)
+		}
+		var record = []string{
+			row.ID, row.MonitorID, row.MonitorName, row.Name, row.Cause, row.Status, row.TeamName,
+			strings.Join(row.Regions, ";"), format(row.StartedAt), format(row.AcknowledgedAt), row.AcknowledgedBy,
+			format(row.ResolvedAt), row.ResolvedBy, duration,
+		}
+		if writeErr := writer.Write(record); writeErr != nil {
+			return fmt.Errorf("failed to write CSV: %v", writeErr)
+		}
+	}
+
+	writer.Flush()
+	if flushErr := writer.Error(); flushErr != nil {
+		return fmt.Errorf("failed to write CSV: %v", flushErr)
+	}
+	return nil
+}
+
+// WriteIncidentsParquet writes the incidents as a Parquet file with the columns of the CSV export. Times are
+// timestamps in milliseconds (UTC), empty strings, missing times and the duration of open incidents are null.
+func WriteIncidentsParquet(w io.Writer, rows []IncidentRow) error {
+	var columns = make([]parquetColumn, 0, len(incidentColumns))
+	for i, name := range incidentColumns {
+		var column = parquetColumn{name: name, physical: parquetByteArray, converted: parquetConvertedUTF8, optional: i > 0}
+		switch name {
+		case "started_at", "acknowledged_at", "resolved_at":
+			column.physical, column.converted = parquetInt64, parquetConvertedTimestampMillis
+		case "duration_seconds":
+			column.physical, column.converted = parquetInt64, -1
+		}
+		columns = append(columns, column)
+	}
+
+	var text = func(s string) any {
+		if funk.IsEmpty(s) {
+			return nil
+		}
+		return s
+	}
+	var timestamp = func(at *time.Time) any {
+		if at == nil {
+			return nil
+		}
+		return *at
+	}
+	for _, row := range rows {
+		var duration any
+		if d := row.Duration(); d != nil {
+			duration = int64(d.Seconds())
+		}
+		var values = []any{
+			row.ID, text(row.MonitorID), text(row.MonitorName), text(row.Name), text(row.Cause), text(row.Status),
+			text(row.TeamName), text(strings.Join(row.Regions, ";")), timestamp(row.StartedAt),
+			timestamp(row.AcknowledgedAt), text(row.AcknowledgedBy), timestamp(row.ResolvedAt), text(row.ResolvedBy),
+			duration,
+		}
+		for i := range columns {
+			columns[i].values = append(columns[i].values, values[i])
+		}
+	}
+
+	if writeErr := writeParquet(w, columns); writeErr != nil {
+		return fmt.Errorf("failed to write Parquet: %v", writeErr)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package report
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteIncidentsCSV(t *testing.T) {
+	var started = time.Date(2026, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600))
+	var acknowledged = started.Add(time.Minute)
+	var resolved = started.Add(90 * time.Second)
+	var rows = []IncidentRow{
+		{ID: "1", MonitorID: "10", MonitorName: "shop", Name: "Shop down", Cause: "Status 500, Timeout", Status: "Resolved",
+			TeamName: "web", Regions: []string{"eu", "us"}, StartedAt: &started, AcknowledgedAt: &acknowledged,
+			AcknowledgedBy: "jane@example.com", ResolvedAt: &resolved, ResolvedBy: "auto"},
+		{ID: "2", MonitorID: "11", Name: "API down", Status: "Started", StartedAt: &started},
+	}
+
+	var output bytes.Buffer
+	if writeErr := WriteIncidentsCSV(&output, rows); writeErr != nil {
+		t.Fatal(writeErr)
+	}
+
+	var lines = strings.SplitN(output.String(), "\n", 2)
+	var header = "incident_id,monitor_id,monitor_name,name,cause,status,team_name,regions,started_at,acknowledged_at," +
+		"acknowledged_by,resolved_at,resolved_by,duration_seconds"
+	if lines[0] != header {
+		t.Errorf("expected header\n%s\ngot\n%s", header, lines[0])
+	}
+
+	var records, readErr = csv.NewReader(&output).ReadAll()
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	var expected = [][]string{
+		{"1", "10", "shop", "Shop down", "Status 500, Timeout", "Resolved", "web", "eu;us", "2026-01-02T02:04:05Z",
+			"2026-01-02T02:05:05Z", "jane@example.com", "2026-01-02T02:05:35Z", "auto", "90"},
+		{"2", "11", "", "API down", "", "Started", "", "", "2026-01-02T02:04:05Z", "", "", "", "", ""},
+	}
+	if len(records) != len(expected)+1 {
+		t.Fatalf("expected %d lines, got %d:\n%v", len(expected)+1, len(records), records)
+	}
+	for i, record := range records[1:] {
+		if len(record) != len(incidentColumns) || strings.Join(record, "|") != strings.Join(expected[i], "|") {
+			t.Errorf("line %d: expected\n%q\ngot\n%q", i+2, expected[i], record)
+		}
+	}
+}
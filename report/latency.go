@@ -0,0 +1,131 @@
+package report
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+)
+
+// LatencyStats summarizes response times, in seconds. Percentiles interpolate linearly between the closest ranks.
+type LatencyStats struct {
+	Samples int
+	Min     float64
+	Average float64
+	Max     float64
+	P50     float64
+	P95     float64
+	P99     float64
+}
+
+// RegionLatency summarizes response times of one region
+type RegionLatency struct {
+	Region string
+	LatencyStats
+}
+
+// DailyLatency summarizes response times of one day, Day is its midnight in the location of the rollup
+type DailyLatency struct {
+	Day time.Time
+	LatencyStats
+}
+
+// Percentile returns the p-th percentile (0-100) of the values, 0 for no values. The values are not modified.
+func Percentile(values []float64, p float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sorted = append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	return percentile(sorted, p)
+}
+
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	var rank = math.Max(0, math.Min(100, p)) / 100 * float64(len(sorted)-1)
+	var lower = int(math.Floor(rank))
+	var upper = int(math.Ceil(rank))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
+
+// Summarize computes the statistics of the samples, a zero value for no samples
+func Summarize(samples []client.ResponseTime) LatencyStats {
+	var result = LatencyStats{Samples: len(samples)}
+	if len(samples) == 0 {
+		return result
+	}
+
+	var values = make([]float64, 0, len(samples))
+	var total float64
+	for _, sample := range samples {
+		values = append(values, sample.ResponseTime)
+		total += sample.ResponseTime
+	}
+	sort.Float64s(values)
+
+	result.Min = values[0]
+	result.Max = values[len(values)-1]
+	result.Average = total / float64(len(values))
+	result.P50 = percentile(values, 50)
+	result.P95 = percentile(values, 95)
+	result.P99 = percentile(values, 99)
+	return result
+}
+
+// Between returns the samples taken within from and to, inclusive. A zero bound leaves the range open.
+func Between(samples []client.ResponseTime, from, to time.Time) []client.ResponseTime {
+	var result []client.ResponseTime
+	for _, sample := range samples {
+		if !from.IsZero() && sample.At.Before(from) || !to.IsZero() && sample.At.After(to) {
+			continue
+		}
+		result = append(result, sample)
+	}
+	return result
+}
+
+// Merge pools the samples of all regions, for statistics across the whole monitor
+func Merge(regions []client.RegionResponseTimes) []client.ResponseTime {
+	var result []client.ResponseTime
+	for _, region := range regions {
+		result = append(result, region.ResponseTimes...)
+	}
+	return result
+}
+
+// ByRegion summarizes every region with samples, ordered by region
+func ByRegion(regions []client.RegionResponseTimes) []RegionLatency {
+	var result []RegionLatency
+	for _, region := range regions {
+		if len(region.ResponseTimes) == 0 {
+			continue
+		}
+		result = append(result, RegionLatency{Region: region.Region, LatencyStats: Summarize(region.ResponseTimes)})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Region < result[j].Region })
+	return result
+}
+
+// Daily rolls the samples up per calendar day in loc (UTC when nil), oldest day first
+func Daily(samples []client.ResponseTime, loc *time.Location) []DailyLatency {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	var days = map[time.Time][]client.ResponseTime{}
+	for _, sample := range samples {
+		var at = sample.At.In(loc)
+		var day = time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, loc)
+		days[day] = append(days[day], sample)
+	}
+
+	var result = make([]DailyLatency, 0, len(days))
+	for day, daySamples := range days {
+		result = append(result, DailyLatency{Day: day, LatencyStats: Summarize(daySamples)})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Day.Before(result[j].Day) })
+	return result
+}
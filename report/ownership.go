@@ -0,0 +1,135 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+// DefaultOwnerKeys are the metadata keys naming the owner of a monitor, the first one set wins
+var DefaultOwnerKeys = []string{"owner", "team"}
+
+type OwnershipOptions struct {
+	// Defaults to DefaultOwnerKeys, compared case-insensitively
+	Keys []string
+
+	// Probe certificates and count those expiring within this many days, zero skips probing
+	SSLDays int
+
+	// Passed on to the certificate probes
+	SSL SSLReportOptions
+}
+
+type OwnerSummary struct {
+	Owner    string
+	Monitors []client.Monitor
+	Down     []client.Monitor
+	Paused   int
+
+	// Certificates expiring within OwnershipOptions.SSLDays
+	ExpiringCertificates []CertificateStatus
+}
+
+type OwnershipReport struct {
+	GeneratedAt time.Time
+
+	// Sorted by owner, monitors without owner are summarized under Unassigned
+	Owners []OwnerSummary
+
+	// Monitors no owner key is set on
+	Unowned []client.Monitor
+}
+
+// FleetOwnership summarizes the monitors of the account per owner, the owner being read from monitor metadata
+func FleetOwnership(ctx context.Context, c *client.BetterstackClient, opts OwnershipOptions) (OwnershipReport, error) {
+	var monitors, monsErr = c.Monitors().List(ctx)
+	if monsErr != nil {
+		return OwnershipReport{}, fmt.Errorf("failed to list monitors: %v", monsErr)
+	}
+	var records, metadataErr = c.Metadata().List(ctx, client.OwnerTypeMonitor, client.Blanc)
+	if metadataErr != nil {
+		return OwnershipReport{}, fmt.Errorf("failed to list metadata: %v", metadataErr)
+	}
+	return Ownership(monitors, records, opts), nil
+}
+
+// Ownership groups monitors by the owner metadata records name
+func Ownership(monitors []client.Monitor, records []client.MetadataRecord, opts OwnershipOptions) OwnershipReport {
+	var keys = opts.Keys
+	if len(keys) == 0 {
+		keys = DefaultOwnerKeys
+	}
+	var lowered = make([]string, 0, len(keys))
+	for _, key := range keys {
+		lowered = append(lowered, strings.ToLower(key))
+	}
+
+	// Monitor ID to owner, the key listed first takes precedence
+	var owners = map[string]string{}
+	var priorities = map[string]int{}
+	for _, record := range records {
+		if record.OwnerType != client.OwnerTypeMonitor || funk.IsEmpty(strings.TrimSpace(record.Value)) {
+			continue
+		}
+		var priority = funk.IndexOfString(lowered, strings.ToLower(record.Key))
+		if priority < 0 {
+			continue
+		}
+		var id = client.StringID(record.OwnerID)
+		if current, found := priorities[id]; found && current <= priority {
+			continue
+		}
+		owners[id] = strings.TrimSpace(record.Value)
+		priorities[id] = priority
+	}
+
+	var ownerOf = func(monitor client.Monitor) string {
+		if owner, found := owners[monitor.ID]; found {
+			return owner
+		}
+		return Unassigned
+	}
+
+	var result = OwnershipReport{GeneratedAt: time.Now()}
+	var summaries = map[string]*OwnerSummary{}
+	for _, monitor := range monitors {
+		var owner = ownerOf(monitor)
+		if owner == Unassigned {
+			result.Unowned = append(result.Unowned, monitor)
+		}
+
+		var summary, found = summaries[owner]
+		if !found {
+			summary = &OwnerSummary{Owner: owner}
+			summaries[owner] = summary
+		}
+		summary.Monitors = append(summary.Monitors, monitor)
+		if monitor.Status == client.MonitorStatusDown {
+			summary.Down = append(summary.Down, monitor)
+		}
+		if monitor.Paused {
+			summary.Paused++
+		}
+	}
+
+	if opts.SSLDays > 0 {
+		var sslOpts = opts.SSL
+		sslOpts.Days = opts.SSLDays
+		sslOpts.GroupBy = ownerOf
+		for owner, certificates := range SSLExpiration(monitors, sslOpts).Expiring {
+			summaries[owner].ExpiringCertificates = certificates
+		}
+	}
+
+	for _, summary := range summaries {
+		result.Owners = append(result.Owners, *summary)
+	}
+	sort.Slice(result.Owners, func(i, j int) bool { return result.Owners[i].Owner < result.Owners[j].Owner })
+
+	return result
+}
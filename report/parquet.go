@@ -0,0 +1,250 @@
+package report
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// Parquet physical and converted types, repetitions and encodings used by the writer, as numbered by the format
+const parquetInt64 = 2
+const parquetByteArray = 6
+const parquetConvertedUTF8 = 0
+const parquetConvertedTimestampMillis = 9
+const parquetRequired = 0
+const parquetOptional = 1
+const parquetEncodingPlain = 0
+const parquetEncodingRLE = 3
+
+// Thrift compact protocol field types
+const thriftI32 = 5
+const thriftI64 = 6
+const thriftBinary = 8
+const thriftList = 9
+const thriftStruct = 12
+
+// parquetColumn holds the values of one column, nil for null. Values are string for UTF8 columns, time.Time for
+// timestamps and int64 otherwise.
+type parquetColumn struct {
+	name      string
+	physical  int
+	converted int // -1 for none
+	optional  bool
+	values    []any
+}
+
+// writeParquet writes the columns as a Parquet file with a single row group and one uncompressed, plain
+// encoded data page per column. All columns must have the same number of values.
+func writeParquet(w io.Writer, columns []parquetColumn) error {
+	var rows int
+	if len(columns) > 0 {
+		rows = len(columns[0].values)
+	}
+
+	var file bytes.Buffer
+	file.WriteString("PAR1")
+
+	var chunks = make([][]byte, 0, len(columns))
+	var groupSize int64
+	for _, column := range columns {
+		var page = parquetPage(column)
+		var header thriftWriter
+		header.i32(1, 0) // DATA_PAGE
+		header.i32(2, int32(len(page)))
+		header.i32(3, int32(len(page)))
+		header.begin(5)
+		header.i32(1, int32(len(column.values)))
+		header.i32(2, parquetEncodingPlain)
+		header.i32(3, parquetEncodingRLE)
+		header.i32(4, parquetEncodingRLE)
+		header.end()
+		header.stop()
+
+		var offset = int64(file.Len())
+		file.Write(header.Bytes())
+		file.Write(page)
+		var size = int64(header.Len() + len(page))
+		groupSize += size
+
+		var chunk thriftWriter
+		chunk.i64(2, offset)
+		chunk.begin(3)
+		chunk.i32(1, int32(column.physical))
+		chunk.listI32(2, parquetEncodingPlain, parquetEncodingRLE)
+		chunk.listBinary(3, column.name)
+		chunk.i32(4, 0) // UNCOMPRESSED
+		chunk.i64(5, int64(len(column.values)))
+		chunk.i64(6, size)
+		chunk.i64(7, size)
+		chunk.i64(9, offset)
+		chunk.end()
+		chunk.stop()
+		chunks = append(chunks, chunk.Bytes())
+	}
+
+	var meta thriftWriter
+	meta.i32(1, 1)
+	meta.listHeader(2, thriftStruct, len(columns)+1)
+	meta.element(func() {
+		meta.binary(4, "schema")
+		meta.i32(5, int32(len(columns)))
+	})
+	for _, column := range columns {
+		meta.element(func() {
+			meta.i32(1, int32(column.physical))
+			var repetition int32 = parquetRequired
+			if column.optional {
+				repetition = parquetOptional
+			}
+			meta.i32(3, repetition)
+			meta.binary(4, column.name)
+			if column.converted >= 0 {
+				meta.i32(6, int32(column.converted))
+			}
+		})
+	}
+	meta.i64(3, int64(rows))
+	meta.listHeader(4, thriftStruct, 1)
+	meta.element(func() {
+		meta.listHeader(1, thriftStruct, len(chunks))
+		for _, chunk := range chunks {
+			meta.raw(chunk)
+		}
+		meta.i64(2, groupSize)
+		meta.i64(3, int64(rows))
+	})
+	meta.binary(6, "github.com/qameta/betterstack")
+	meta.stop()
+
+	file.Write(meta.Bytes())
+	_ = binary.Write(&file, binary.LittleEndian, uint32(meta.Len()))
+	file.WriteString("PAR1")
+
+	var _, writeErr = file.WriteTo(w)
+	return writeErr
+}
+
+// parquetPage encodes the definition levels of optional columns followed by the plain encoded non-null values
+func parquetPage(column parquetColumn) []byte {
+	var page bytes.Buffer
+	if column.optional {
+		// Bit-packed run of all levels, bit width 1, eight levels per byte
+		var groups = (len(column.values) + 7) / 8
+		var levels = make([]byte, groups)
+		for i, value := range column.values {
+			if value != nil {
+				levels[i/8] |= 1 << (i % 8)
+			}
+		}
+		var run = binary.AppendUvarint(nil, uint64(groups<<1|1))
+		_ = binary.Write(&page, binary.LittleEndian, uint32(len(run)+len(levels)))
+		page.Write(run)
+		page.Write(levels)
+	}
+
+	for _, value := range column.values {
+		switch typed := value.(type) {
+		case string:
+			_ = binary.Write(&page, binary.LittleEndian, uint32(len(typed)))
+			page.WriteString(typed)
+		case time.Time:
+			_ = binary.Write(&page, binary.LittleEndian, typed.UnixMilli())
+		case int64:
+			_ = binary.Write(&page, binary.LittleEndian, typed)
+		}
+	}
+	return page.Bytes()
+}
+
+// thriftWriter encodes structs in the Thrift compact protocol, the encoding of Parquet metadata
+type thriftWriter struct {
+	bytes.Buffer
+	last  int16
+	stack []int16
+}
+
+func (t *thriftWriter) field(id int16, kind byte) {
+	if delta := id - t.last; delta > 0 && delta <= 15 {
+		t.WriteByte(byte(delta)<<4 | kind)
+	} else {
+		t.WriteByte(kind)
+		t.varint(int64(id))
+	}
+	t.last = id
+}
+
+func (t *thriftWriter) varint(value int64) {
+	t.Write(binary.AppendUvarint(nil, uint64((value<<1)^(value>>63))))
+}
+
+func (t *thriftWriter) i32(id int16, value int32) {
+	t.field(id, thriftI32)
+	t.varint(int64(value))
+}
+
+func (t *thriftWriter) i64(id int16, value int64) {
+	t.field(id, thriftI64)
+	t.varint(value)
+}
+
+func (t *thriftWriter) binary(id int16, value string) {
+	t.field(id, thriftBinary)
+	t.Write(binary.AppendUvarint(nil, uint64(len(value))))
+	t.WriteString(value)
+}
+
+func (t *thriftWriter) listHeader(id int16, kind byte, size int) {
+	t.field(id, thriftList)
+	if size < 15 {
+		t.WriteByte(byte(size)<<4 | kind)
+	} else {
+		t.WriteByte(0xf0 | kind)
+		t.Write(binary.AppendUvarint(nil, uint64(size)))
+	}
+}
+
+func (t *thriftWriter) listI32(id int16, values ...int32) {
+	t.listHeader(id, thriftI32, len(values))
+	for _, value := range values {
+		t.varint(int64(value))
+	}
+}
+
+func (t *thriftWriter) listBinary(id int16, values ...string) {
+	t.listHeader(id, thriftBinary, len(values))
+	for _, value := range values {
+		t.Write(binary.AppendUvarint(nil, uint64(len(value))))
+		t.WriteString(value)
+	}
+}
+
+// begin starts a struct field, end closes it
+func (t *thriftWriter) begin(id int16) {
+	t.field(id, thriftStruct)
+	t.stack = append(t.stack, t.last)
+	t.last = 0
+}
+
+func (t *thriftWriter) end() {
+	t.stop()
+	t.last = t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+}
+
+// element writes a struct as list element
+func (t *thriftWriter) element(fields func()) {
+	t.stack = append(t.stack, t.last)
+	t.last = 0
+	fields()
+	t.end()
+}
+
+// raw appends an encoded struct, including its stop byte, as list element
+func (t *thriftWriter) raw(encoded []byte) {
+	t.Write(encoded)
+}
+
+func (t *thriftWriter) stop() {
+	t.WriteByte(0)
+}
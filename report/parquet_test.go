@@ -0,0 +1,238 @@
+package report
+
+import (
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+// smallColumns covers a required and an optional UTF8 column and an optional TIMESTAMP_MILLIS column, with nulls
+func smallColumns() []parquetColumn {
+	var started = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	return []parquetColumn{
+		{name: "id", physical: parquetByteArray, converted: parquetConvertedUTF8, values: []any{"1", "2", "3"}},
+		{name: "cause", physical: parquetByteArray, converted: parquetConvertedUTF8, optional: true,
+			values: []any{"Timeout", nil, "Kötü"}},
+		{name: "started_at", physical: parquetInt64, converted: parquetConvertedTimestampMillis, optional: true,
+			values: []any{started, started.Add(time.Minute), nil}},
+	}
+}
+
+func TestWriteParquetGolden(t *testing.T) {
+	var file bytes.Buffer
+	if writeErr := writeParquet(&file, smallColumns()); writeErr != nil {
+		t.Fatal(writeErr)
+	}
+
+	var golden = filepath.Join("testdata", "small.parquet")
+	if *update {
+		if writeErr := os.WriteFile(golden, file.Bytes(), 0o644); writeErr != nil {
+			t.Fatal(writeErr)
+		}
+	}
+	var expected, readErr = os.ReadFile(golden)
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	if !bytes.Equal(file.Bytes(), expected) {
+		t.Errorf("file differs from %s, run with -update after checking the change:\n got %x\nwant %x", golden,
+			file.Bytes(), expected)
+	}
+}
+
+func TestParquetPage(t *testing.T) {
+	var columns = smallColumns()
+	var cases = []struct {
+		column   parquetColumn
+		expected []byte
+	}{
+		// Plain byte arrays, each prefixed by its length
+		{columns[0], []byte{1, 0, 0, 0, '1', 1, 0, 0, 0, '2', 1, 0, 0, 0, '3'}},
+		// 2 bytes of levels: one bit-packed group (header 0x03), levels 1, 0, 1; then the two non-null values
+		{columns[1], append([]byte{2, 0, 0, 0, 0x03, 0b101, 7, 0, 0, 0}, append([]byte("Timeout"),
+			append([]byte{6, 0, 0, 0}, []byte("Kötü")...)...)...)},
+	}
+	for _, tc := range cases {
+		if page := parquetPage(tc.column); !bytes.Equal(page, tc.expected) {
+			t.Errorf("%s: expected %x, got %x", tc.column.name, tc.expected, page)
+		}
+	}
+
+	var page = parquetPage(columns[2])
+	if len(page) != 4+2+2*8 || page[5] != 0b011 {
+		t.Fatalf("started_at: unexpected levels or size: %x", page)
+	}
+	if millis := int64(binary.LittleEndian.Uint64(page[6:])); millis != time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC).UnixMilli() {
+		t.Errorf("started_at: expected milliseconds since the epoch, got %d", millis)
+	}
+}
+
+func TestParquetFooterRoundTrip(t *testing.T) {
+	var file bytes.Buffer
+	if writeErr := writeParquet(&file, smallColumns()); writeErr != nil {
+		t.Fatal(writeErr)
+	}
+	var data = file.Bytes()
+	if string(data[:4]) != "PAR1" || string(data[len(data)-4:]) != "PAR1" {
+		t.Fatalf("missing magic: %x", data)
+	}
+	var footerLength = int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	var footerStart = len(data) - 8 - footerLength
+	var meta = decodeThrift(t, data[footerStart:len(data)-8])
+
+	if meta[1] != int64(1) || meta[3] != int64(3) || meta[6] != "github.com/qameta/betterstack" {
+		t.Errorf("unexpected version, num_rows or created_by: %v", meta)
+	}
+
+	var schema = meta[2].([]any)
+	var expectedSchema = []map[int16]any{
+		{4: "schema", 5: int64(3)},
+		{1: int64(parquetByteArray), 3: int64(parquetRequired), 4: "id", 6: int64(parquetConvertedUTF8)},
+		{1: int64(parquetByteArray), 3: int64(parquetOptional), 4: "cause", 6: int64(parquetConvertedUTF8)},
+		{1: int64(parquetInt64), 3: int64(parquetOptional), 4: "started_at", 6: int64(parquetConvertedTimestampMillis)},
+	}
+	if fmt.Sprint(schema) != fmt.Sprint(expectedSchema) {
+		t.Errorf("expected schema %v, got %v", expectedSchema, schema)
+	}
+
+	var groups = meta[4].([]any)
+	if len(groups) != 1 {
+		t.Fatalf("expected 1 row group, got %d", len(groups))
+	}
+	var group = groups[0].(map[int16]any)
+	var chunks = group[1].([]any)
+	if group[3] != int64(3) || len(chunks) != 3 {
+		t.Fatalf("unexpected row group: %v", group)
+	}
+
+	// Chunks follow each other from the magic to the footer, each starting with its page header
+	var offset, total int64 = 4, 0
+	for i, entity := range chunks {
+		var chunk = entity.(map[int16]any)
+		var column = chunk[3].(map[int16]any)
+		var name = smallColumns()[i].name
+		if chunk[2] != offset || column[9] != offset || fmt.Sprint(column[3]) != fmt.Sprint([]any{name}) ||
+			column[4] != int64(0) || column[5] != int64(3) || column[6] != column[7] {
+			t.Errorf("%s: unexpected chunk at %d: %v", name, offset, chunk)
+		}
+
+		var size = column[6].(int64)
+		var header, headerLength = decodeThriftPrefix(t, data[offset:offset+size])
+		var pageSize = int64(len(parquetPage(smallColumns()[i])))
+		if header[1] != int64(0) || header[2] != pageSize || int64(headerLength)+pageSize != size ||
+			header[5].(map[int16]any)[1] != int64(3) {
+			t.Errorf("%s: unexpected page header %v", name, header)
+		}
+		offset += size
+		total += size
+	}
+	if offset != int64(footerStart) || group[2] != total {
+		t.Errorf("chunks end at %d, total %v, footer starts at %d", offset, group[2], footerStart)
+	}
+}
+
+func decodeThrift(t *testing.T, data []byte) map[int16]any {
+	var decoded, length = decodeThriftPrefix(t, data)
+	if length != len(data) {
+		t.Fatalf("%d bytes left after the struct", len(data)-length)
+	}
+	return decoded
+}
+
+// decodeThriftPrefix decodes the compact protocol struct at the start of data, returning its fields by ID and its
+// length. Integers decode as int64, binaries as string, lists as []any and structs as map[int16]any.
+func decodeThriftPrefix(t *testing.T, data []byte) (map[int16]any, int) {
+	var reader = thriftReader{data: data}
+	var decoded = reader.structure()
+	if reader.err != nil {
+		t.Fatalf("failed to decode %x: %v", data, reader.err)
+	}
+	return decoded, reader.pos
+}
+
+type thriftReader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (r *thriftReader) byte() byte {
+	if r.pos >= len(r.data) {
+		r.err = fmt.Errorf("unexpected end at %d", r.pos)
+		return 0
+	}
+	r.pos++
+	return r.data[r.pos-1]
+}
+
+func (r *thriftReader) uvarint() uint64 {
+	var value, length = binary.Uvarint(r.data[min(r.pos, len(r.data)):])
+	if length <= 0 {
+		r.err = fmt.Errorf("invalid varint at %d", r.pos)
+		return 0
+	}
+	r.pos += length
+	return value
+}
+
+func (r *thriftReader) zigzag() int64 {
+	var value = r.uvarint()
+	return int64(value>>1) ^ -int64(value&1)
+}
+
+func (r *thriftReader) structure() map[int16]any {
+	var result = map[int16]any{}
+	var last int16
+	for r.err == nil {
+		var header = r.byte()
+		if header == 0 {
+			return result
+		}
+		var id = last + int16(header>>4)
+		if header>>4 == 0 {
+			id = int16(r.zigzag())
+		}
+		result[id] = r.value(header & 0x0f)
+		last = id
+	}
+	return result
+}
+
+func (r *thriftReader) value(kind byte) any {
+	switch kind {
+	case 1, 2:
+		return kind == 1
+	case thriftI32, thriftI64:
+		return r.zigzag()
+	case thriftBinary:
+		var length = int(r.uvarint())
+		if r.pos+length > len(r.data) {
+			r.err = fmt.Errorf("binary of %d bytes at %d past the end", length, r.pos)
+			return nil
+		}
+		r.pos += length
+		return string(r.data[r.pos-length : r.pos])
+	case thriftList:
+		var header = r.byte()
+		var size = int(header >> 4)
+		if size == 15 {
+			size = int(r.uvarint())
+		}
+		var list = make([]any, 0, size)
+		for range size {
+			list = append(list, r.value(header&0x0f))
+		}
+		return list
+	case thriftStruct:
+		return r.structure()
+	}
+	r.err = fmt.Errorf("unsupported type %d at %d", kind, r.pos)
+	return nil
+}
@@ -0,0 +1,483 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+// A4 in points
+const pdfWidth = 595.0
+const pdfHeight = 842.0
+const pdfMargin = 50.0
+
+type pdfColor [3]float64
+
+var pdfBlack = pdfColor{0, 0, 0}
+var pdfGrey = pdfColor{0.55, 0.55, 0.55}
+var pdfLight = pdfColor{0.88, 0.88, 0.88}
+var pdfGreen = pdfColor{0.18, 0.65, 0.35}
+var pdfAmber = pdfColor{0.95, 0.65, 0.1}
+var pdfRed = pdfColor{0.85, 0.2, 0.2}
+var pdfBlue = pdfColor{0.2, 0.45, 0.8}
+
+// pdfDocument lays out text, tables and charts top-down on A4 pages using the standard Helvetica fonts, so no
+// font needs to be embedded. Coordinates are points from the top left corner.
+type pdfDocument struct {
+	pages []*bytes.Buffer
+	y     float64
+}
+
+func newPDF() *pdfDocument {
+	var d = &pdfDocument{}
+	d.newPage()
+	return d
+}
+
+func (d *pdfDocument) newPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+	d.y = pdfMargin
+}
+
+func (d *pdfDocument) page() *bytes.Buffer {
+	return d.pages[len(d.pages)-1]
+}
+
+// ensure starts a new page when less than height is left on the current one
+func (d *pdfDocument) ensure(height float64) {
+	if d.y+height > pdfHeight-pdfMargin {
+		d.newPage()
+	}
+}
+
+func (d *pdfDocument) text(x, y, size float64, bold bool, color pdfColor, s string) {
+	var font = "F1"
+	if bold {
+		font = "F2"
+	}
+	fmt.Fprintf(d.page(), "%.3f %.3f %.3f rg BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n",
+		color[0], color[1], color[2], font, size, x, pdfHeight-y, pdfEscape(s))
+}
+
+func (d *pdfDocument) rect(x, y, width, height float64, color pdfColor) {
+	fmt.Fprintf(d.page(), "%.3f %.3f %.3f rg %.2f %.2f %.2f %.2f re f\n",
+		color[0], color[1], color[2], x, pdfHeight-y-height, width, height)
+}
+
+func (d *pdfDocument) polyline(points [][2]float64, width float64, color pdfColor) {
+	if len(points) == 0 {
+		return
+	}
+	var b = d.page()
+	fmt.Fprintf(b, "%.3f %.3f %.3f RG %.2f w %.2f %.2f m", color[0], color[1], color[2], width, points[0][0], pdfHeight-points[0][1])
+	if len(points) == 1 {
+		fmt.Fprintf(b, " %.2f %.2f l", points[0][0]+0.5, pdfHeight-points[0][1])
+	}
+	for _, point := range points[1:] {
+		fmt.Fprintf(b, " %.2f %.2f l", point[0], pdfHeight-point[1])
+	}
+	b.WriteString(" S\n")
+}
+
+// paragraph writes text wrapped to the page width and moves below it
+func (d *pdfDocument) paragraph(size float64, bold bool, color pdfColor, s string) {
+	for _, line := range pdfWrap(s, size, pdfWidth-2*pdfMargin) {
+		d.ensure(size * 1.4)
+		d.y += size * 1.4
+		d.text(pdfMargin, d.y, size, bold, color, line)
+	}
+}
+
+func (d *pdfDocument) space(height float64) {
+	d.y += height
+}
+
+// table writes rows below a bold header, unless header is nil. Columns get the given widths in points and cut
+// overlong cells.
+func (d *pdfDocument) table(widths []float64, header []string, rows [][]string) {
+	const size = 9.0
+	const height = size * 1.6
+
+	var row = func(cells []string, bold bool) {
+		d.ensure(height)
+		d.y += height
+		var x = pdfMargin
+		for i, cell := range cells {
+			if i < len(widths) {
+				d.text(x, d.y-size*0.4, size, bold, pdfBlack, pdfFit(cell, size, widths[i]-6))
+				x += widths[i]
+			}
+		}
+	}
+
+	if header != nil {
+		d.ensure(height * 2)
+		row(header, true)
+		d.rect(pdfMargin, d.y+1, sum(widths), 0.6, pdfGrey)
+	}
+	for _, cells := range rows {
+		row(cells, false)
+	}
+}
+
+// barChart draws one bar per value between floor and ceiling, colored by color
+func (d *pdfDocument) barChart(title string, labels []string, values []float64, floor, ceiling float64, color func(value float64) pdfColor) {
+	const height = 110.0
+	d.ensure(height + 40)
+	d.paragraph(10, true, pdfBlack, title)
+	d.space(8)
+
+	var width = pdfWidth - 2*pdfMargin - 40
+	var left, top = pdfMargin + 40, d.y
+	d.axes(left, top, width, height, floor, ceiling, "%.1f%%")
+
+	if len(values) > 0 {
+		var slot = width / float64(len(values))
+		for i, value := range values {
+			var share = math.Max(0, math.Min(1, (value-floor)/(ceiling-floor)))
+			var barHeight = math.Max(share*height, 0.8)
+			d.rect(left+float64(i)*slot+slot*0.15, top+height-barHeight, slot*0.7, barHeight, color(value))
+		}
+		d.labels(left, top+height, slot, labels)
+	}
+	d.y = top + height + 22
+}
+
+// lineChart draws each series as a line over the labels, series share the scale from zero to their maximum
+func (d *pdfDocument) lineChart(title string, labels []string, series map[string][]float64, colors map[string]pdfColor, unit string) {
+	const height = 110.0
+	d.ensure(height + 52)
+	d.paragraph(10, true, pdfBlack, title)
+	d.space(8)
+
+	var ceiling float64
+	for _, values := range series {
+		for _, value := range values {
+			ceiling = math.Max(ceiling, value)
+		}
+	}
+	ceiling = niceCeiling(ceiling)
+
+	var width = pdfWidth - 2*pdfMargin - 40
+	var left, top = pdfMargin + 40, d.y
+	d.axes(left, top, width, height, 0, ceiling, "%.0f"+unit)
+
+	var slot = width / float64(max(len(labels), 1))
+	var names = funk.Keys(series).([]string)
+	sort.Strings(names)
+	var legend = left
+	for _, name := range names {
+		var points [][2]float64
+		for i, value := range series[name] {
+			points = append(points, [2]float64{left + float64(i)*slot + slot/2, top + height - value/ceiling*height})
+		}
+		d.polyline(points, 1.4, colors[name])
+		d.rect(legend, top+height+24, 8, 8, colors[name])
+		d.text(legend+11, top+height+31, 8, false, pdfBlack, name)
+		legend += 60
+	}
+	d.labels(left, top+height, slot, labels)
+	d.y = top + height + 40
+}
+
+func (d *pdfDocument) axes(left, top, width, height, floor, ceiling float64, format string) {
+	for i := 0; i <= 4; i++ {
+		var y = top + height - float64(i)/4*height
+		d.rect(left, y, width, 0.4, pdfLight)
+		d.text(pdfMargin, y+3, 7, false, pdfGrey, fmt.Sprintf(format, floor+(ceiling-floor)*float64(i)/4))
+	}
+}
+
+// labels writes the labels below the slots, skipping labels when they don't fit
+func (d *pdfDocument) labels(left, y, slot float64, labels []string) {
+	var every = int(math.Ceil(30 / slot))
+	for i, label := range labels {
+		if i%max(every, 1) == 0 {
+			d.text(left+float64(i)*slot, y+10, 7, false, pdfGrey, label)
+		}
+	}
+}
+
+// WriteTo writes the document as PDF 1.4
+func (d *pdfDocument) WriteTo(w io.Writer) (int64, error) {
+	var out bytes.Buffer
+	var offsets []int
+	var object = func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// Objects 1 to 4 are catalog, page tree and fonts, each page adds its page and content objects
+	var kids = make([]string, 0, len(d.pages))
+	for i := range d.pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 5+2*i))
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	for i, page := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pdfWidth, pdfHeight, 6+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.Len(), page.String()))
+	}
+
+	var xref = out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return out.WriteTo(w)
+}
+
+// pdfEscape encodes s for a literal string in WinAnsiEncoding, characters outside Latin-1 become '?'
+func pdfEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\t':
+			b.WriteByte(' ')
+		case r < 32 || (r > 126 && r < 160) || r > 255:
+			b.WriteByte('?')
+		default:
+			b.WriteByte(byte(r))
+		}
+	}
+	return b.String()
+}
+
+// pdfTextWidth estimates the width of s in Helvetica, close enough for wrapping and cutting
+func pdfTextWidth(s string, size float64) float64 {
+	return float64(len([]rune(s))) * size * 0.52
+}
+
+func pdfWrap(s string, size, width float64) []string {
+	var result []string
+	var line string
+	for _, word := range strings.Fields(s) {
+		if funk.NotEmpty(line) && pdfTextWidth(line+" "+word, size) > width {
+			result = append(result, line)
+			line = client.Blanc
+		}
+		if funk.NotEmpty(line) {
+			line += " "
+		}
+		line += word
+	}
+	if funk.NotEmpty(line) || len(result) == 0 {
+		result = append(result, line)
+	}
+	return result
+}
+
+func pdfFit(s string, size, width float64) string {
+	if pdfTextWidth(s, size) <= width {
+		return s
+	}
+	var runes = []rune(s)
+	for len(runes) > 0 && pdfTextWidth(string(runes)+"...", size) > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "..."
+}
+
+func sum(values []float64) float64 {
+	var result float64
+	for _, value := range values {
+		result += value
+	}
+	return result
+}
+
+// niceCeiling rounds up to 1, 2 or 5 times a power of ten
+func niceCeiling(value float64) float64 {
+	if value <= 0 {
+		return 1
+	}
+	var magnitude = math.Pow(10, math.Floor(math.Log10(value)))
+	for _, step := range []float64{1, 2, 5, 10} {
+		if value <= step*magnitude {
+			return step * magnitude
+		}
+	}
+	return 10 * magnitude
+}
+
+func availabilityColor(value float64) pdfColor {
+	switch {
+	case value >= 99.9:
+		return pdfGreen
+	case value >= 99:
+		return pdfAmber
+	}
+	return pdfRed
+}
+
+// availabilityFloor is the lower end of an availability chart, low enough to show the worst day
+func availabilityFloor(values []float64) float64 {
+	var lowest = 100.0
+	for _, value := range values {
+		lowest = math.Min(lowest, value)
+	}
+	for _, floor := range []float64{99, 95, 90, 50} {
+		if lowest >= floor {
+			return floor
+		}
+	}
+	return 0
+}
+
+func formatDuration(d time.Duration) string {
+	switch {
+	case d <= 0:
+		return "-"
+	case d < time.Minute:
+		return d.Round(time.Second).String()
+	}
+	return d.Round(time.Minute).String()
+}
+
+// RenderUptimePDF writes the uptime report as PDF: a summary of all monitors, then per monitor charts of the daily
+// availability and response times and the list of incidents
+func RenderUptimePDF(w io.Writer, report UptimeReport) error {
+	var d = newPDF()
+
+	var title = report.Title
+	if funk.IsEmpty(title) {
+		title = "Uptime report"
+	}
+	d.paragraph(20, true, pdfBlack, title)
+	d.paragraph(10, false, pdfGrey, fmt.Sprintf("%s - %s, generated %s", report.From.Format("2006-01-02"),
+		report.To.Format("2006-01-02"), report.GeneratedAt.Format("2006-01-02 15:04 MST")))
+	d.space(12)
+	d.paragraph(12, true, pdfBlack, fmt.Sprintf("Average availability %.3f%%, %d incidents", report.Availability(), report.Incidents()))
+	d.space(8)
+
+	var rows [][]string
+	for _, monitor := range report.Monitors {
+		rows = append(rows, []string{
+			monitor.Monitor.PronounceableName,
+			fmt.Sprintf("%.3f%%", monitor.SLA.Availability),
+			formatDuration(time.Duration(monitor.SLA.TotalDowntime) * time.Second),
+			fmt.Sprintf("%d", len(monitor.Incidents)),
+		})
+	}
+	d.table([]float64{265, 80, 80, 70}, []string{"Monitor", "Availability", "Downtime", "Incidents"}, rows)
+
+	for _, monitor := range report.Monitors {
+		d.newPage()
+		d.paragraph(14, true, pdfBlack, monitor.Monitor.PronounceableName)
+		if funk.NotEmpty(monitor.Monitor.URL) {
+			d.paragraph(9, false, pdfGrey, monitor.Monitor.URL)
+		}
+		d.paragraph(10, false, pdfBlack, fmt.Sprintf("Availability %.3f%%, downtime %s, %d incidents",
+			monitor.SLA.Availability, formatDuration(time.Duration(monitor.SLA.TotalDowntime)*time.Second), len(monitor.Incidents)))
+		d.space(12)
+
+		var labels []string
+		var values []float64
+		for _, day := range monitor.Daily {
+			labels = append(labels, day.Day.Format("01-02"))
+			values = append(values, day.Availability)
+		}
+		d.barChart("Daily availability", labels, values, availabilityFloor(values), 100, availabilityColor)
+
+		if len(monitor.Latency) > 0 {
+			var dayLabels []string
+			var p50, p95 []float64
+			for _, day := range monitor.Latency {
+				dayLabels = append(dayLabels, day.Day.Format("01-02"))
+				p50 = append(p50, day.P50*1000)
+				p95 = append(p95, day.P95*1000)
+			}
+			d.lineChart("Response time", dayLabels, map[string][]float64{"p50": p50, "p95": p95},
+				map[string]pdfColor{"p50": pdfBlue, "p95": pdfAmber}, " ms")
+		}
+
+		if len(monitor.Incidents) > 0 {
+			d.paragraph(10, true, pdfBlack, "Incidents")
+			var incidentRows [][]string
+			for _, incident := range monitor.Incidents {
+				var started, duration = "-", "ongoing"
+				if incident.StartedAt != nil {
+					started = incident.StartedAt.Format("2006-01-02 15:04")
+					if incident.ResolvedAt != nil {
+						duration = formatDuration(incident.ResolvedAt.Sub(*incident.StartedAt))
+					}
+				}
+				incidentRows = append(incidentRows, []string{started, duration, incident.Cause})
+			}
+			d.table([]float64{110, 80, 305}, []string{"Started", "Duration", "Cause"}, incidentRows)
+		}
+	}
+
+	var _, writeErr = d.WriteTo(w)
+	return writeErr
+}
+
+// RenderPostmortemPDF writes the facts of the postmortem as PDF: summary, timeline and response times around the
+// incident
+func RenderPostmortemPDF(w io.Writer, postmortem Postmortem) error {
+	var d = newPDF()
+	var incident = postmortem.Incident
+
+	d.paragraph(20, true, pdfBlack, "Postmortem: "+incident.Name)
+	if postmortem.Monitor != nil && funk.NotEmpty(postmortem.Monitor.URL) {
+		d.paragraph(9, false, pdfGrey, postmortem.Monitor.URL)
+	}
+	d.space(12)
+
+	var facts [][]string
+	if incident.StartedAt != nil {
+		facts = append(facts, []string{"Started", incident.StartedAt.Format("2006-01-02 15:04:05 MST")})
+	}
+	if funk.NotEmpty(incident.Cause) {
+		facts = append(facts, []string{"Cause", incident.Cause})
+	}
+	facts = append(facts, []string{"Time to acknowledge", formatDuration(postmortem.TimeToAcknowledge())})
+	if incident.ResolvedAt != nil {
+		facts = append(facts, []string{"Duration", formatDuration(postmortem.Duration())})
+	} else {
+		facts = append(facts, []string{"Duration", "ongoing"})
+	}
+	if len(incident.Regions) > 0 {
+		facts = append(facts, []string{"Regions", strings.Join(incident.Regions, ", ")})
+	}
+	d.table([]float64{130, 365}, nil, facts)
+	d.space(16)
+
+	d.paragraph(12, true, pdfBlack, "Timeline")
+	var timeline [][]string
+	for _, entry := range postmortem.Timeline() {
+		timeline = append(timeline, []string{entry.At.Format("2006-01-02 15:04:05"), entry.Event})
+	}
+	d.table([]float64{110, 385}, []string{"Time", "Event"}, timeline)
+	d.space(16)
+
+	if len(postmortem.Latency) > 0 {
+		d.paragraph(12, true, pdfBlack, fmt.Sprintf("Response times within %s of the incident", postmortem.Window))
+		var rows [][]string
+		for _, region := range postmortem.Latency {
+			rows = append(rows, []string{region.Region, fmt.Sprintf("%d", region.Samples),
+				fmt.Sprintf("%.0f ms", region.P50*1000), fmt.Sprintf("%.0f ms", region.P95*1000), fmt.Sprintf("%.0f ms", region.Max*1000)})
+		}
+		d.table([]float64{135, 90, 90, 90, 90}, []string{"Region", "Samples", "p50", "p95", "Max"}, rows)
+	}
+
+	var _, writeErr = d.WriteTo(w)
+	return writeErr
+}
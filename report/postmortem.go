@@ -0,0 +1,196 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+// DefaultPostmortemWindow is how far around the incident response times are summarized
+const DefaultPostmortemWindow = 30 * time.Minute
+
+type TimelineEntry struct {
+	At    time.Time
+	Event string
+}
+
+type Postmortem struct {
+	Incident client.Incident
+
+	// Nil when the incident has no monitor or it was deleted since
+	Monitor *client.Monitor
+
+	Comments []client.IncidentComment
+
+	// Response times within Window around the incident, empty when the API no longer keeps them
+	Latency []RegionLatency
+	Window  time.Duration
+}
+
+// Duration from start to resolution, zero while the incident is open
+func (p Postmortem) Duration() time.Duration {
+	if p.Incident.StartedAt == nil || p.Incident.ResolvedAt == nil {
+		return 0
+	}
+	return p.Incident.ResolvedAt.Sub(*p.Incident.StartedAt)
+}
+
+// TimeToAcknowledge from start to acknowledgement, zero when nobody acknowledged
+func (p Postmortem) TimeToAcknowledge() time.Duration {
+	if p.Incident.StartedAt == nil || p.Incident.AcknowledgedAt == nil {
+		return 0
+	}
+	return p.Incident.AcknowledgedAt.Sub(*p.Incident.StartedAt)
+}
+
+// CollectPostmortem gathers the incident, its comments, the affected monitor and its response times around the
+// incident. window defaults to DefaultPostmortemWindow.
+func CollectPostmortem(ctx context.Context, c *client.BetterstackClient, incidentID string, window time.Duration) (Postmortem, error) {
+	if window <= 0 {
+		window = DefaultPostmortemWindow
+	}
+	var result = Postmortem{Window: window}
+
+	var incident, incidentErr = c.Incidents().Get(ctx, incidentID)
+	if incidentErr != nil {
+		return result, fmt.Errorf("failed to get incident: %v", incidentErr)
+	}
+	result.Incident = incident.Data.Attributes
+
+	var comments, commentsErr = c.Incidents().Comments(ctx, incidentID)
+	if commentsErr != nil {
+		return result, fmt.Errorf("failed to list incident comments: %v", commentsErr)
+	}
+	result.Comments = comments
+
+	if monitorID := incident.Data.Relationships["monitor"].Data.ID; funk.NotEmpty(monitorID) {
+		if monitor, monitorErr := c.Monitors().Get(ctx, monitorID); monitorErr == nil {
+			result.Monitor = &monitor.Data.Attributes
+		}
+		if responseTimes, timesErr := c.Monitors().ResponseTimes(ctx, monitorID); timesErr == nil {
+			result.Latency = latencyAround(responseTimes.Data.Attributes.Regions, result.Incident, window)
+		}
+	}
+
+	return result, nil
+}
+
+// Timeline lists start, acknowledgement, comments and resolution in order
+func (p Postmortem) Timeline() []TimelineEntry {
+	var incident = p.Incident
+	var result []TimelineEntry
+	if incident.StartedAt != nil {
+		var event = "Incident started"
+		if funk.NotEmpty(incident.Cause) {
+			event += ": " + incident.Cause
+		}
+		result = append(result, TimelineEntry{At: *incident.StartedAt, Event: event})
+	}
+	if incident.AcknowledgedAt != nil {
+		result = append(result, TimelineEntry{At: *incident.AcknowledgedAt, Event: "Acknowledged" + by(incident.AcknowledgedBy)})
+	}
+	for _, comment := range p.Comments {
+		if comment.CreatedAt == nil {
+			continue
+		}
+		var event = "Comment" + by(comment.UserEmail) + ": " + strings.Join(strings.Fields(comment.Content), " ")
+		result = append(result, TimelineEntry{At: *comment.CreatedAt, Event: event})
+	}
+	if incident.ResolvedAt != nil {
+		result = append(result, TimelineEntry{At: *incident.ResolvedAt, Event: "Resolved" + by(incident.ResolvedBy)})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool { return result[i].At.Before(result[j].At) })
+	return result
+}
+
+func by(who string) string {
+	if funk.IsEmpty(who) {
+		return client.Blanc
+	}
+	return " by " + who
+}
+
+func latencyAround(regions []client.RegionResponseTimes, incident client.Incident, window time.Duration) []RegionLatency {
+	if incident.StartedAt == nil {
+		return nil
+	}
+	var from = incident.StartedAt.Add(-window)
+	var to = time.Now()
+	if incident.ResolvedAt != nil {
+		to = incident.ResolvedAt.Add(window)
+	}
+
+	var result []RegionLatency
+	for _, region := range regions {
+		var samples = Between(region.ResponseTimes, from, to)
+		if len(samples) > 0 {
+			result = append(result, RegionLatency{Region: region.Region, LatencyStats: Summarize(samples)})
+		}
+	}
+	return result
+}
+
+var postmortemTemplate = template.Must(template.New("postmortem").Funcs(template.FuncMap{
+	"time": func(t time.Time) string { return t.UTC().Format("2006-01-02 15:04:05 MST") },
+	"ms":   func(seconds float64) string { return fmt.Sprintf("%.0f ms", seconds*1000) },
+	// Pipes would break the table
+	"cell": func(text string) string { return strings.ReplaceAll(text, "|", `\|`) },
+}).Parse(`# Postmortem: {{ .Incident.Name }}
+
+_Draft generated from incident {{ .Incident.ID }}. The timeline is factual, everything marked TODO needs a human._
+
+## Summary
+
+TODO: what happened, in two sentences.
+
+## Impact
+
+{{ if .Monitor }}- Monitor: {{ .Monitor.PronounceableName }} ({{ .Monitor.URL }})
+{{ else if .Incident.URL }}- URL: {{ .Incident.URL }}
+{{ end }}{{ if .Incident.StartedAt }}- Started: {{ time .Incident.StartedAt.UTC }}
+{{ end }}{{ if .Incident.ResolvedAt }}- Resolved: {{ time .Incident.ResolvedAt.UTC }}
+- Duration: {{ .Duration }}
+{{ else }}- Status: {{ .Incident.Status }}
+{{ end }}{{ if .TimeToAcknowledge }}- Time to acknowledge: {{ .TimeToAcknowledge }}
+{{ end }}{{ if .Incident.Cause }}- Cause reported by the check: {{ .Incident.Cause }}
+{{ end }}{{ if .Incident.Regions }}- Regions: {{ range $i, $region := .Incident.Regions }}{{ if $i }}, {{ end }}{{ $region }}{{ end }}
+{{ end }}- Customer impact: TODO
+
+## Timeline (UTC)
+
+| Time | Event |
+|------|-------|
+{{ range .Timeline }}| {{ time .At }} | {{ cell .Event }} |
+{{ end }}
+{{- if .Latency }}
+## Response times ({{ .Window }} around the incident)
+
+| Region | Samples | Min | Average | p95 | Max |
+|--------|---------|-----|---------|-----|-----|
+{{ range .Latency }}| {{ .Region }} | {{ .Samples }} | {{ ms .Min }} | {{ ms .Average }} | {{ ms .P95 }} | {{ ms .Max }} |
+{{ end }}{{ end }}
+## Root cause
+
+TODO
+
+## Resolution
+
+TODO
+
+## Action items
+
+- [ ] TODO
+`))
+
+// RenderPostmortem writes the Markdown postmortem skeleton
+func RenderPostmortem(w io.Writer, postmortem Postmortem) error {
+	return postmortemTemplate.Execute(w, postmortem)
+}
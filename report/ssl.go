@@ -0,0 +1,201 @@
+package report
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+const Unassigned = "unassigned"
+
+const defaultProbeTimeout = 10 * time.Second
+const defaultConcurrency = 8
+
+// CertificateStatus is the outcome of probing the certificate served for one monitor
+type CertificateStatus struct {
+	Monitor  client.Monitor
+	Address  string
+	Subject  string
+	Issuer   string
+	NotAfter time.Time
+	DaysLeft int
+	Err      error
+}
+
+type SSLReportOptions struct {
+	// Certificates expiring within this many days are reported
+	Days int
+
+	// Timeout of a single TLS handshake, defaults to 10 seconds
+	Timeout time.Duration
+
+	// How many endpoints are probed in parallel, defaults to 8
+	Concurrency int
+
+	// Returns the owner a monitor is reported under, defaults to the monitor team name
+	GroupBy func(monitor client.Monitor) string
+
+	// Reference point for expiration, defaults to time.Now()
+	Now time.Time
+}
+
+type SSLExpirationReport struct {
+	GeneratedAt time.Time
+	Days        int
+
+	// Certificates expiring within Days, grouped by owner and sorted by expiration date
+	Expiring map[string][]CertificateStatus
+
+	// Endpoints where the certificate could not be retrieved
+	Failed []CertificateStatus
+}
+
+// FleetSSLExpiration lists all monitors of the account and reports certificates expiring within opts.Days
+func FleetSSLExpiration(ctx context.Context, c *client.BetterstackClient, opts SSLReportOptions) (SSLExpirationReport, error) {
+	var monitors, monsErr = c.Monitors().List(ctx)
+	if monsErr != nil {
+		return SSLExpirationReport{}, fmt.Errorf("failed to list monitors: %v", monsErr)
+	}
+	return SSLExpiration(monitors, opts), nil
+}
+
+// SSLExpiration probes the certificate of every HTTPS monitor and reports those expiring within opts.Days.
+// Monitors without an https URL are skipped.
+func SSLExpiration(monitors []client.Monitor, opts SSLReportOptions) SSLExpirationReport {
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultProbeTimeout
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultConcurrency
+	}
+	if opts.GroupBy == nil {
+		opts.GroupBy = ByTeam
+	}
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+
+	var result = SSLExpirationReport{
+		GeneratedAt: opts.Now,
+		Days:        opts.Days,
+		Expiring:    map[string][]CertificateStatus{},
+	}
+
+	var statuses = make(chan CertificateStatus)
+	var semaphore = make(chan struct{}, opts.Concurrency)
+	var wg sync.WaitGroup
+
+	for _, monitor := range monitors {
+		var address, ok = HTTPSAddress(monitor.URL)
+		if !ok {
+			continue
+		}
+
+		wg.Add(1)
+		go func(monitor client.Monitor, address string) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			var status = CertificateStatus{Monitor: monitor, Address: address}
+			var cert, certErr = ProbeCertificate(address, opts.Timeout)
+			if certErr != nil {
+				status.Err = certErr
+			} else {
+				status.Subject = cert.Subject.CommonName
+				status.Issuer = cert.Issuer.CommonName
+				status.NotAfter = cert.NotAfter
+				status.DaysLeft = int(cert.NotAfter.Sub(opts.Now).Hours() / 24)
+			}
+			statuses <- status
+		}(monitor, address)
+	}
+
+	go func() {
+		wg.Wait()
+		close(statuses)
+	}()
+
+	for status := range statuses {
+		if status.Err != nil {
+			result.Failed = append(result.Failed, status)
+			continue
+		}
+		if status.DaysLeft > opts.Days {
+			continue
+		}
+		var owner = opts.GroupBy(status.Monitor)
+		result.Expiring[owner] = append(result.Expiring[owner], status)
+	}
+
+	for owner := range result.Expiring {
+		var certs = result.Expiring[owner]
+		sort.Slice(certs, func(i, j int) bool {
+			return certs[i].NotAfter.Before(certs[j].NotAfter)
+		})
+	}
+	sort.Slice(result.Failed, func(i, j int) bool {
+		return result.Failed[i].Address < result.Failed[j].Address
+	})
+
+	return result
+}
+
+// ProbeCertificate performs a TLS handshake with address (host:port) and returns the leaf certificate. The chain is
+// not verified, so expired and self-signed certificates are still returned.
+func ProbeCertificate(address string, timeout time.Duration) (*x509.Certificate, error) {
+	var host, _, splitErr = net.SplitHostPort(address)
+	if splitErr != nil {
+		return nil, fmt.Errorf("invalid address %s: %v", address, splitErr)
+	}
+
+	var dialer = &net.Dialer{Timeout: timeout}
+	var conn, dialErr = tls.DialWithDialer(dialer, "tcp", address, &tls.Config{
+		ServerName:         host,
+		InsecureSkipVerify: true,
+	})
+	if dialErr != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %v", address, dialErr)
+	}
+	defer conn.Close()
+
+	var certs = conn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificate presented by %s", address)
+	}
+
+	return certs[0], nil
+}
+
+// ByTeam groups monitors by their team name
+func ByTeam(monitor client.Monitor) string {
+	if funk.IsEmpty(monitor.TeamName) {
+		return Unassigned
+	}
+	return monitor.TeamName
+}
+
+// HTTPSAddress returns host:port of an https URL, the port defaulting to 443
+func HTTPSAddress(rawURL string) (string, bool) {
+	var parsedURL, parseErr = url.Parse(rawURL)
+	if parseErr != nil || !strings.EqualFold(parsedURL.Scheme, "https") || funk.IsEmpty(parsedURL.Hostname()) {
+		return client.Blanc, false
+	}
+
+	var port = parsedURL.Port()
+	if funk.IsEmpty(port) {
+		port = "443"
+	}
+
+	return net.JoinHostPort(parsedURL.Hostname(), port), true
+}
@@ -0,0 +1,184 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+// DefaultSummaryDays is how far ahead the account summary looks for expiring certificates and domains
+const DefaultSummaryDays = 30
+
+type SummaryOptions struct {
+	// Certificates and domains expiring within this many days are listed, defaults to DefaultSummaryDays
+	Days int
+
+	// Skip the TLS probes or the WHOIS lookups, both take a while on large accounts
+	SkipCertificates bool
+	SkipDomains      bool
+
+	// Timeout of a single probe or lookup, defaults to 10 seconds
+	Timeout time.Duration
+
+	// Reference point for expiration, defaults to time.Now()
+	Now time.Time
+}
+
+// GroupCount tallies the monitors of one group, monitors without a group are counted under Unassigned
+type GroupCount struct {
+	ID     string
+	Name   string
+	Total  int
+	Up     int
+	Down   int
+	Paused int
+}
+
+// AccountSummary is the fleet health of an account at a glance
+type AccountSummary struct {
+	GeneratedAt time.Time
+	Total       int
+
+	// Monitors by status, paused monitors count as paused whatever their last status was
+	ByStatus map[string]int
+	ByType   map[string]int
+
+	// Sorted by group name, Unassigned last
+	ByGroup []GroupCount
+
+	Days int
+
+	// Certificates expiring within Days, soonest first
+	Certificates []CertificateStatus
+
+	// Domains expiring within Days, soonest first
+	Domains []DomainStatus
+
+	// Certificates and domains which could not be checked
+	Unchecked int
+}
+
+// Up, Down, Paused and Maintenance are the counts dashboards show first
+func (s AccountSummary) Up() int {
+	return s.ByStatus[client.MonitorStatusUp]
+}
+
+func (s AccountSummary) Down() int {
+	return s.ByStatus[client.MonitorStatusDown]
+}
+
+func (s AccountSummary) Paused() int {
+	return s.ByStatus[client.MonitorStatusPaused]
+}
+
+func (s AccountSummary) Maintenance() int {
+	return s.ByStatus[client.MonitorStatusMaintenance]
+}
+
+// GetAccountSummary lists the monitors and groups of the account and summarizes them, probing certificates and
+// domains unless opts skip them
+func GetAccountSummary(ctx context.Context, c *client.BetterstackClient, opts SummaryOptions) (AccountSummary, error) {
+	var monitors, monsErr = c.Monitors().List(ctx)
+	if monsErr != nil {
+		return AccountSummary{}, fmt.Errorf("failed to list monitors: %v", monsErr)
+	}
+	var groups, groupsErr = c.MonitorGroups().List(ctx)
+	if groupsErr != nil {
+		return AccountSummary{}, fmt.Errorf("failed to list monitor groups: %v", groupsErr)
+	}
+	return Summary(monitors, groups, opts), nil
+}
+
+// Summary counts the monitors by status, type and group and collects upcoming certificate and domain expirations
+func Summary(monitors []client.Monitor, groups []client.MonitorGroup, opts SummaryOptions) AccountSummary {
+	if opts.Days <= 0 {
+		opts.Days = DefaultSummaryDays
+	}
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+
+	var result = AccountSummary{
+		GeneratedAt: opts.Now,
+		Total:       len(monitors),
+		ByStatus:    map[string]int{},
+		ByType:      map[string]int{},
+		Days:        opts.Days,
+	}
+
+	var groupNames = map[string]string{}
+	for _, group := range groups {
+		groupNames[group.ID] = group.Name
+	}
+	var byGroup = map[string]*GroupCount{}
+
+	for _, monitor := range monitors {
+		var status = monitor.Status
+		if monitor.Paused {
+			status = client.MonitorStatusPaused
+		}
+		result.ByStatus[status]++
+		result.ByType[monitor.MonitorType]++
+
+		var groupID = monitor.GroupID()
+		var count, found = byGroup[groupID]
+		if !found {
+			count = &GroupCount{ID: groupID, Name: groupNames[groupID]}
+			if funk.IsEmpty(groupID) {
+				count.Name = Unassigned
+			} else if funk.IsEmpty(count.Name) {
+				count.Name = groupID
+			}
+			byGroup[groupID] = count
+		}
+		count.Total++
+		switch status {
+		case client.MonitorStatusUp:
+			count.Up++
+		case client.MonitorStatusDown:
+			count.Down++
+		case client.MonitorStatusPaused:
+			count.Paused++
+		}
+	}
+
+	for _, count := range byGroup {
+		result.ByGroup = append(result.ByGroup, *count)
+	}
+	sort.Slice(result.ByGroup, func(i, j int) bool {
+		var left, right = result.ByGroup[i], result.ByGroup[j]
+		if funk.IsEmpty(left.ID) != funk.IsEmpty(right.ID) {
+			return funk.IsEmpty(right.ID)
+		}
+		return left.Name < right.Name
+	})
+
+	if !opts.SkipCertificates {
+		var ssl = SSLExpiration(monitors, SSLReportOptions{Days: opts.Days, Timeout: opts.Timeout, Now: opts.Now})
+		for _, certs := range ssl.Expiring {
+			result.Certificates = append(result.Certificates, certs...)
+		}
+		sort.Slice(result.Certificates, func(i, j int) bool {
+			return result.Certificates[i].NotAfter.Before(result.Certificates[j].NotAfter)
+		})
+		result.Unchecked += len(ssl.Failed)
+	}
+
+	if !opts.SkipDomains {
+		// Domains come sorted by expiration
+		for _, domain := range DomainExpiration(monitors, DomainReportOptions{Timeout: opts.Timeout, Now: opts.Now}).Domains {
+			switch {
+			case domain.Err != nil:
+				result.Unchecked++
+			case domain.DaysLeft <= opts.Days:
+				result.Domains = append(result.Domains, domain)
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,166 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+)
+
+type UptimeOptions struct {
+	// Shown on the report, e.g. the name of the customer
+	Title string
+
+	// Location days are rolled up in, defaults to UTC
+	Location *time.Location
+
+	// Monitors the report covers, all by default
+	Filters []client.MonitorFilter
+}
+
+// DailyAvailability is the share of a day no incident of the monitor was open, in percent
+type DailyAvailability struct {
+	Day          time.Time
+	Availability float64
+	Downtime     time.Duration
+}
+
+type MonitorUptime struct {
+	Monitor client.Monitor
+
+	// Availability as the API computes it for the whole period
+	SLA client.MonitorSLA
+
+	// Availability per day, derived from the incidents
+	Daily []DailyAvailability
+
+	// Response times per day, only for the days the API still keeps samples of
+	Latency []DailyLatency
+
+	Incidents []client.Incident
+}
+
+type UptimeReport struct {
+	Title       string
+	From        time.Time
+	To          time.Time
+	GeneratedAt time.Time
+	Monitors    []MonitorUptime
+}
+
+// Availability returns the average availability of the monitors in percent, 100 for no monitors
+func (r UptimeReport) Availability() float64 {
+	if len(r.Monitors) == 0 {
+		return 100
+	}
+	var sum float64
+	for _, monitor := range r.Monitors {
+		sum += monitor.SLA.Availability
+	}
+	return sum / float64(len(r.Monitors))
+}
+
+// Incidents returns the number of incidents of all monitors
+func (r UptimeReport) Incidents() int {
+	var result int
+	for _, monitor := range r.Monitors {
+		result += len(monitor.Incidents)
+	}
+	return result
+}
+
+// CollectUptime gathers availability, incidents and response times of the monitors between from and to, sorted by
+// monitor name
+func CollectUptime(ctx context.Context, c *client.BetterstackClient, from, to time.Time, opts UptimeOptions) (UptimeReport, error) {
+	var result = UptimeReport{Title: opts.Title, From: from, To: to, GeneratedAt: time.Now()}
+
+	var monitors, monsErr = c.Monitors().List(ctx, opts.Filters...)
+	if monsErr != nil {
+		return result, fmt.Errorf("failed to list monitors: %v", monsErr)
+	}
+	sort.SliceStable(monitors, func(i, j int) bool { return monitors[i].PronounceableName < monitors[j].PronounceableName })
+
+	for _, monitor := range monitors {
+		var sla, slaErr = c.Monitors().SLA(ctx, monitor.ID, from, to)
+		if slaErr != nil {
+			return result, fmt.Errorf("failed to get SLA of monitor %s: %v", monitor.ID, slaErr)
+		}
+		var incidents, incidentsErr = c.Incidents().ListForMonitor(ctx, monitor.ID, from, to)
+		if incidentsErr != nil {
+			return result, fmt.Errorf("failed to list incidents of monitor %s: %v", monitor.ID, incidentsErr)
+		}
+
+		var uptime = MonitorUptime{
+			Monitor:   monitor,
+			SLA:       sla.Data.Attributes,
+			Daily:     DailyDowntime(incidents, from, to, opts.Location),
+			Incidents: incidents,
+		}
+		if times, timesErr := c.Monitors().ResponseTimes(ctx, monitor.ID); timesErr == nil {
+			uptime.Latency = Daily(Between(Merge(times.Data.Attributes.Regions), from, to), opts.Location)
+		}
+		result.Monitors = append(result.Monitors, uptime)
+	}
+
+	return result, nil
+}
+
+// DailyDowntime computes the availability of every day between from and to in loc (UTC when nil) from the
+// incidents. Open incidents last until to, overlapping incidents count once.
+func DailyDowntime(incidents []client.Incident, from, to time.Time, loc *time.Location) []DailyAvailability {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	var intervals [][2]time.Time
+	for _, incident := range incidents {
+		if incident.StartedAt == nil {
+			continue
+		}
+		var end = to
+		if incident.ResolvedAt != nil && incident.ResolvedAt.Before(to) {
+			end = *incident.ResolvedAt
+		}
+		intervals = append(intervals, [2]time.Time{*incident.StartedAt, end})
+	}
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i][0].Before(intervals[j][0]) })
+
+	var result []DailyAvailability
+	var start = from.In(loc)
+	for day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc); day.Before(to); day = day.AddDate(0, 0, 1) {
+		var dayStart, dayEnd = later(day, from), earlier(day.AddDate(0, 0, 1), to)
+
+		var downtime time.Duration
+		var covered = dayStart
+		for _, interval := range intervals {
+			var s, e = later(interval[0], covered), earlier(interval[1], dayEnd)
+			if e.After(s) {
+				downtime += e.Sub(s)
+				covered = e
+			}
+		}
+
+		var availability = 100.0
+		if length := dayEnd.Sub(dayStart); length > 0 {
+			availability = 100 * (1 - float64(downtime)/float64(length))
+		}
+		result = append(result, DailyAvailability{Day: day, Availability: availability, Downtime: downtime})
+	}
+	return result
+}
+
+func later(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func earlier(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,249 @@
+package routing
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+const ChannelEmail = "email"
+const ChannelSMS = "sms"
+const ChannelCall = "call"
+const ChannelPush = "push"
+const ChannelIntegration = "integration"
+
+const StepTypeEscalation = "escalation"
+
+const MemberUser = "user"
+const MemberCurrentOnCall = "current_on_call"
+const MemberEntireTeam = "entire_team"
+
+// Schedule answers who is on call at a given time. CalendarID is empty for the default calendar of the team.
+type Schedule interface {
+	OnCall(calendarID string, at time.Time) []string
+	TeamMembers() []string
+	UserName(userID string) string
+}
+
+type Shift struct {
+	// Empty for the default calendar
+	CalendarID string
+	User       string
+	From       time.Time
+	To         time.Time
+}
+
+// StaticSchedule is a Schedule made of explicit shifts, e.g. transcribed from an upcoming rotation
+type StaticSchedule struct {
+	Shifts []Shift
+	Team   []string
+
+	// User names by user ID, used to render user step members
+	Users map[string]string
+}
+
+func (s StaticSchedule) OnCall(calendarID string, at time.Time) []string {
+	var result []string
+	for _, shift := range s.Shifts {
+		if shift.CalendarID == calendarID && !at.Before(shift.From) && at.Before(shift.To) {
+			result = append(result, shift.User)
+		}
+	}
+	return result
+}
+
+func (s StaticSchedule) TeamMembers() []string {
+	return s.Team
+}
+
+func (s StaticSchedule) UserName(userID string) string {
+	if name, found := s.Users[userID]; found {
+		return name
+	}
+	return "user " + userID
+}
+
+// CurrentSchedule builds a schedule out of who is on call right now. It answers the same for any time, so it only
+// fits simulations of incidents happening now.
+func CurrentSchedule(ctx context.Context, c *client.BetterstackClient, team []string) (StaticSchedule, error) {
+	var calendars, calendarsErr = c.OnCall().List(ctx)
+	if calendarsErr != nil {
+		return StaticSchedule{}, fmt.Errorf("failed to list on-call calendars: %v", calendarsErr)
+	}
+
+	var result = StaticSchedule{Team: team, Users: map[string]string{}}
+	var forever = time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, calendar := range calendars {
+		for _, user := range calendar.OnCallUsers {
+			var name = displayName(user)
+			result.Users[user.ID] = name
+			result.Shifts = append(result.Shifts, Shift{CalendarID: calendar.ID, User: name, To: forever})
+			if calendar.DefaultCalendar {
+				result.Shifts = append(result.Shifts, Shift{User: name, To: forever})
+			}
+		}
+	}
+
+	return result, nil
+}
+
+type Notification struct {
+	At time.Time
+
+	// Index of the policy step, starting at 1, 0 for monitors without a policy
+	Step int
+
+	// Which pass over the policy, starting at 1
+	Repeat int
+
+	Recipient string
+	Channels  []string
+}
+
+type Simulation struct {
+	Notifications []Notification
+
+	// Things the simulation could not model, e.g. branching steps
+	Notes []string
+}
+
+// Recipients returns everybody who would be notified, in the order they would first be reached
+func (s Simulation) Recipients() []string {
+	var result []string
+	for _, notification := range s.Notifications {
+		if !funk.ContainsString(result, notification.Recipient) {
+			result = append(result, notification.Recipient)
+		}
+	}
+	return result
+}
+
+// Simulate walks the escalation policy for an incident of the monitor starting at the given time, assuming nobody
+// acknowledges it. Monitors without a policy notify the current on-call person immediately and the entire team
+// after team_wait, the way Better Stack handles them.
+func Simulate(monitor client.Monitor, policy *client.Policy, at time.Time, schedule Schedule) Simulation {
+	var result Simulation
+	var channels = monitorChannels(monitor)
+
+	if len(channels) == 0 {
+		result.Notes = append(result.Notes, "monitor has email, sms, call and push disabled")
+	}
+
+	if policy == nil {
+		result.Notifications = append(result.Notifications, notify(at, 0, 1, schedule.OnCall(client.Blanc, at), channels)...)
+		if monitor.TeamWait > 0 {
+			var teamAt = at.Add(time.Duration(monitor.TeamWait) * time.Second)
+			result.Notifications = append(result.Notifications, notify(teamAt, 0, 1, schedule.TeamMembers(), channels)...)
+		}
+		finish(&result)
+		return result
+	}
+
+	var cursor = at
+	for repeat := 1; repeat <= policy.RepeatCount+1; repeat++ {
+		if repeat > 1 {
+			cursor = cursor.Add(time.Duration(policy.RepeatDelay) * time.Second)
+		}
+
+		for index, step := range policy.Steps {
+			cursor = cursor.Add(time.Duration(step.WaitBefore) * time.Second)
+
+			if step.Type != StepTypeEscalation {
+				if repeat == 1 {
+					result.Notes = append(result.Notes, fmt.Sprintf("step %d is a %s step and is not simulated",
+						index+1, step.Type))
+				}
+				continue
+			}
+
+			for _, member := range step.StepMembers {
+				var recipients, memberChannels = resolveMember(member, cursor, schedule, channels)
+				if len(recipients) == 0 {
+					result.Notes = append(result.Notes, fmt.Sprintf("step %d member %s reaches nobody at %s",
+						index+1, member.Type, cursor.Format(time.RFC3339)))
+				}
+				result.Notifications = append(result.Notifications,
+					notify(cursor, index+1, repeat, recipients, memberChannels)...)
+			}
+		}
+	}
+
+	finish(&result)
+	return result
+}
+
+func resolveMember(member client.PolicyStepMember, at time.Time, schedule Schedule, channels []string) ([]string, []string) {
+	var id = client.StringID(member.ID)
+	switch member.Type {
+	case MemberUser:
+		return []string{schedule.UserName(id)}, channels
+	case MemberCurrentOnCall:
+		return schedule.OnCall(id, at), channels
+	case MemberEntireTeam:
+		return schedule.TeamMembers(), channels
+	}
+	if strings.HasPrefix(member.Type, "all_") {
+		return []string{strings.TrimSuffix(strings.TrimPrefix(member.Type, "all_"), "_integrations") + " integrations"},
+			[]string{ChannelIntegration}
+	}
+	return []string{member.Type}, channels
+}
+
+func notify(at time.Time, step, repeat int, recipients, channels []string) []Notification {
+	var result []Notification
+	for _, recipient := range recipients {
+		result = append(result, Notification{
+			At:        at,
+			Step:      step,
+			Repeat:    repeat,
+			Recipient: recipient,
+			Channels:  channels,
+		})
+	}
+	return result
+}
+
+func finish(simulation *Simulation) {
+	sort.SliceStable(simulation.Notifications, func(i, j int) bool {
+		return simulation.Notifications[i].At.Before(simulation.Notifications[j].At)
+	})
+	if len(simulation.Notifications) == 0 {
+		simulation.Notes = append(simulation.Notes, "nobody would be notified")
+	}
+}
+
+func monitorChannels(monitor client.Monitor) []string {
+	var result []string
+	if monitor.Email {
+		result = append(result, ChannelEmail)
+	}
+	if monitor.SMS {
+		result = append(result, ChannelSMS)
+	}
+	if monitor.Call {
+		result = append(result, ChannelCall)
+	}
+	if monitor.Push {
+		result = append(result, ChannelPush)
+	}
+	return result
+}
+
+func displayName(user client.User) string {
+	var name = strings.TrimSpace(user.FirstName + " " + user.LastName)
+	if funk.NotEmpty(user.Email) {
+		if funk.IsEmpty(name) {
+			return user.Email
+		}
+		return fmt.Sprintf("%s <%s>", name, user.Email)
+	}
+	if funk.IsEmpty(name) {
+		return "user " + user.ID
+	}
+	return name
+}
@@ -0,0 +1,446 @@
+package rules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/qameta/betterstack/dependencies"
+	"github.com/qameta/betterstack/webhooks"
+	"github.com/thoas/go-funk"
+	"gopkg.in/yaml.v3"
+)
+
+const ActionAcknowledge = "acknowledge"
+const ActionResolve = "resolve"
+const ActionComment = "comment"
+
+// ActionResolveWhenRecovered resolves the incident once the incident of MonitorID is resolved
+const ActionResolveWhenRecovered = "resolve_when_recovered"
+
+const DefaultActor = "betterstack-rules"
+
+// DependencySuppress acknowledges incidents whose upstream dependency is down and skips the rules for them
+const DependencySuppress = "suppress"
+
+// DependencyAnnotate comments on incidents whose upstream dependency is down, the rules still apply
+const DependencyAnnotate = "annotate"
+
+const DefaultDependencyText = "Upstream dependency down: {{ range $i, $id := .Upstream }}{{ if $i }}, {{ end }}{{ $id }}{{ end }}"
+
+// Config is the YAML document rules are loaded from:
+//
+//	rules:
+//	  - name: acknowledge flapping checks
+//	    when:
+//	      event: started
+//	      flapping: {count: 3, within: 30m}
+//	    actions:
+//	      - type: acknowledge
+//	      - type: comment
+//	        text: "Flapping, see https://runbooks.example.com/{{ .MonitorID }}"
+//	dependencies:
+//	  mode: suppress
+type Config struct {
+	// Name incidents are acknowledged and resolved as, defaults to DefaultActor
+	Actor string `yaml:"actor"`
+	Rules []Rule `yaml:"rules"`
+
+	// Handling of incidents started while a monitor they depend on is down, off by default
+	Dependencies Dependencies `yaml:"dependencies"`
+}
+
+// Dependencies configures suppression of downstream incidents, the graph is read from monitor metadata, see
+// the dependencies package
+type Dependencies struct {
+	// DependencySuppress or DependencyAnnotate, empty turns it off
+	Mode string `yaml:"mode"`
+
+	// Comment text, a text/template rendered with a Suppression, defaults to DefaultDependencyText
+	Text string `yaml:"text"`
+
+	text *template.Template
+}
+
+// Suppression is what the dependency comment is rendered with
+type Suppression struct {
+	webhooks.Event
+
+	// The monitors the incident's monitor depends on which are down, nearest first
+	Upstream []string
+}
+
+type Rule struct {
+	Name    string    `yaml:"name"`
+	When    Condition `yaml:"when"`
+	Actions []Action  `yaml:"actions"`
+}
+
+// Condition matches incident events. All set fields have to match.
+type Condition struct {
+	// Event kind: started, acknowledged or resolved
+	Event string `yaml:"event"`
+
+	MonitorIDs    []string `yaml:"monitor_ids"`
+	TeamName      string   `yaml:"team_name"`
+	CauseContains string   `yaml:"cause_contains"`
+
+	// Regular expression the incident name has to match
+	NameMatches string `yaml:"name_matches"`
+
+	// The monitor started at least Count incidents within the duration, including this one
+	Flapping *Flapping `yaml:"flapping"`
+
+	name *regexp.Regexp
+}
+
+type Flapping struct {
+	Count  int           `yaml:"count"`
+	Within time.Duration `yaml:"within"`
+}
+
+type Action struct {
+	Type string `yaml:"type"`
+
+	// Comment text, a text/template rendered with the webhooks.Event
+	Text string `yaml:"text"`
+
+	// Upstream monitor for resolve_when_recovered
+	MonitorID string `yaml:"monitor_id"`
+
+	text *template.Template
+}
+
+func LoadFile(path string) (Config, error) {
+	var data, readErr = os.ReadFile(path)
+	if readErr != nil {
+		return Config{}, fmt.Errorf("failed to read rules: %v", readErr)
+	}
+	return Parse(data)
+}
+
+func Parse(data []byte) (Config, error) {
+	var result Config
+	if unmErr := yaml.Unmarshal(data, &result); unmErr != nil {
+		return result, fmt.Errorf("failed to parse rules: %v", unmErr)
+	}
+	return result, nil
+}
+
+// Engine evaluates rules against incident events and executes matching actions through the Incidents API
+type Engine struct {
+	client *client.BetterstackClient
+	actor  string
+	rules  []Rule
+
+	mu sync.Mutex
+	// Incident start times per monitor, for flapping detection
+	starts map[string][]time.Time
+	// Incident IDs waiting for the recovery of an upstream monitor, keyed by the upstream monitor ID
+	waiting map[string][]string
+	// Longest flapping window of all rules, older start times are forgotten
+	horizon time.Duration
+
+	dependencies Dependencies
+	graph        dependencies.Graph
+	// Monitors with an unresolved incident
+	down map[string]bool
+}
+
+func NewEngine(c *client.BetterstackClient, config Config) (*Engine, error) {
+	var engine = &Engine{
+		client:  c,
+		actor:   config.Actor,
+		starts:  map[string][]time.Time{},
+		waiting: map[string][]string{},
+		down:    map[string]bool{},
+	}
+	if funk.IsEmpty(engine.actor) {
+		engine.actor = DefaultActor
+	}
+
+	var depsErr error
+	if engine.dependencies, depsErr = compileDependencies(config.Dependencies); depsErr != nil {
+		return nil, fmt.Errorf("dependencies: %v", depsErr)
+	}
+
+	for i, rule := range config.Rules {
+		if compileErr := compile(&rule); compileErr != nil {
+			return nil, fmt.Errorf("rule %d (%s): %v", i+1, rule.Name, compileErr)
+		}
+		if rule.When.Flapping != nil && rule.When.Flapping.Within > engine.horizon {
+			engine.horizon = rule.When.Flapping.Within
+		}
+		engine.rules = append(engine.rules, rule)
+	}
+
+	return engine, nil
+}
+
+func compile(rule *Rule) error {
+	if funk.NotEmpty(rule.When.NameMatches) {
+		var compiled, compileErr = regexp.Compile(rule.When.NameMatches)
+		if compileErr != nil {
+			return fmt.Errorf("invalid name_matches: %v", compileErr)
+		}
+		rule.When.name = compiled
+	}
+
+	if flapping := rule.When.Flapping; flapping != nil && (flapping.Count < 2 || flapping.Within <= 0) {
+		return errors.New("flapping requires count of at least 2 and a positive within")
+	}
+
+	if len(rule.Actions) == 0 {
+		return errors.New("rule has no actions")
+	}
+
+	for i := range rule.Actions {
+		var action = &rule.Actions[i]
+		switch action.Type {
+		case ActionAcknowledge, ActionResolve:
+		case ActionComment:
+			var parsed, parseErr = template.New("comment").Parse(action.Text)
+			if parseErr != nil {
+				return fmt.Errorf("invalid comment template: %v", parseErr)
+			}
+			action.text = parsed
+		case ActionResolveWhenRecovered:
+			if funk.IsEmpty(action.MonitorID) {
+				return errors.New("resolve_when_recovered requires monitor_id")
+			}
+		default:
+			return fmt.Errorf("unknown action type: %q", action.Type)
+		}
+	}
+
+	return nil
+}
+
+func compileDependencies(deps Dependencies) (Dependencies, error) {
+	switch deps.Mode {
+	case client.Blanc:
+		return deps, nil
+	case DependencySuppress, DependencyAnnotate:
+	default:
+		return deps, fmt.Errorf("unknown mode: %q", deps.Mode)
+	}
+
+	var text = deps.Text
+	if funk.IsEmpty(text) {
+		text = DefaultDependencyText
+	}
+	var parsed, parseErr = template.New("dependencies").Parse(text)
+	if parseErr != nil {
+		return deps, fmt.Errorf("invalid text template: %v", parseErr)
+	}
+	deps.text = parsed
+	return deps, nil
+}
+
+// SetDependencies replaces the dependency graph downstream incidents are found with
+func (e *Engine) SetDependencies(graph dependencies.Graph) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.graph = graph
+}
+
+// LoadDependencies reads the dependency graph from monitor metadata, and takes monitors which are down right now
+// as down until their incident resolves
+func (e *Engine) LoadDependencies(ctx context.Context) error {
+	var graph, loadErr = dependencies.Load(ctx, e.client)
+	if loadErr != nil {
+		return loadErr
+	}
+	var monitors, monsErr = e.client.Monitors().List(ctx)
+	if monsErr != nil {
+		return fmt.Errorf("failed to list monitors: %v", monsErr)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.graph = graph
+	for _, monitor := range monitors {
+		if monitor.Status == client.MonitorStatusDown {
+			e.down[monitor.ID] = true
+		}
+	}
+	return nil
+}
+
+// Handle implements webhooks.Handler. Errors of all failed actions are joined into the returned error.
+func (e *Engine) Handle(ctx context.Context, event webhooks.Event) error {
+	var errs []error
+
+	if event.Kind() == webhooks.EventResolved {
+		errs = append(errs, e.resolveDependents(ctx, event.MonitorID)...)
+	}
+
+	var upstream = e.recordDown(event)
+	if len(upstream) > 0 {
+		var suppressed, depsErr = e.suppress(ctx, event, upstream)
+		if depsErr != nil {
+			errs = append(errs, fmt.Errorf("dependencies: %v", depsErr))
+		}
+		if suppressed {
+			e.recordStart(event)
+			return errors.Join(errs...)
+		}
+	}
+
+	var startCount = e.recordStart(event)
+
+	for _, rule := range e.rules {
+		if !rule.When.matches(event, startCount) {
+			continue
+		}
+		for _, action := range rule.Actions {
+			if actErr := e.execute(ctx, action, event); actErr != nil {
+				errs = append(errs, fmt.Errorf("rule %s: %s: %v", rule.Name, action.Type, actErr))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// recordDown tracks which monitors are down and returns the down monitors the monitor of a started incident
+// depends on
+func (e *Engine) recordDown(event webhooks.Event) []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	switch event.Kind() {
+	case webhooks.EventResolved:
+		delete(e.down, event.MonitorID)
+		return nil
+	case webhooks.EventStarted:
+		e.down[event.MonitorID] = true
+	default:
+		return nil
+	}
+
+	if funk.IsEmpty(e.dependencies.Mode) {
+		return nil
+	}
+	return e.graph.DownUpstream(event.MonitorID, func(id string) bool { return e.down[id] })
+}
+
+// suppress comments on the incident of a monitor whose upstream is down, and acknowledges it in suppress mode.
+// It returns true when the rules must be skipped.
+func (e *Engine) suppress(ctx context.Context, event webhooks.Event, upstream []string) (bool, error) {
+	var builder strings.Builder
+	if execErr := e.dependencies.text.Execute(&builder, Suppression{Event: event, Upstream: upstream}); execErr != nil {
+		return false, fmt.Errorf("failed to render comment: %v", execErr)
+	}
+	if commentErr := e.client.Incidents().Comment(ctx, event.Incident.ID, builder.String()); commentErr != nil {
+		return false, commentErr
+	}
+
+	if e.dependencies.Mode != DependencySuppress {
+		return false, nil
+	}
+	if _, ackErr := e.client.Incidents().Acknowledge(ctx, event.Incident.ID, e.actor); ackErr != nil {
+		return false, ackErr
+	}
+	return true, nil
+}
+
+// recordStart remembers when monitors started incidents and returns a counter of the incidents the monitor of the
+// event started within a given duration
+func (e *Engine) recordStart(event webhooks.Event) func(within time.Duration) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var now = event.OccurredAt()
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	var kept []time.Time
+	for _, at := range e.starts[event.MonitorID] {
+		if now.Sub(at) <= e.horizon {
+			kept = append(kept, at)
+		}
+	}
+	if event.Kind() == webhooks.EventStarted {
+		kept = append(kept, now)
+	}
+	e.starts[event.MonitorID] = kept
+
+	return func(within time.Duration) int {
+		var count int
+		for _, at := range kept {
+			if now.Sub(at) <= within {
+				count++
+			}
+		}
+		return count
+	}
+}
+
+func (e *Engine) execute(ctx context.Context, action Action, event webhooks.Event) error {
+	switch action.Type {
+	case ActionAcknowledge:
+		var _, ackErr = e.client.Incidents().Acknowledge(ctx, event.Incident.ID, e.actor)
+		return ackErr
+	case ActionResolve:
+		var _, resolveErr = e.client.Incidents().Resolve(ctx, event.Incident.ID, e.actor)
+		return resolveErr
+	case ActionComment:
+		var builder strings.Builder
+		if execErr := action.text.Execute(&builder, event); execErr != nil {
+			return fmt.Errorf("failed to render comment: %v", execErr)
+		}
+		return e.client.Incidents().Comment(ctx, event.Incident.ID, builder.String())
+	case ActionResolveWhenRecovered:
+		e.mu.Lock()
+		e.waiting[action.MonitorID] = append(e.waiting[action.MonitorID], event.Incident.ID)
+		e.mu.Unlock()
+	}
+	return nil
+}
+
+func (e *Engine) resolveDependents(ctx context.Context, upstreamMonitorID string) []error {
+	e.mu.Lock()
+	var incidentIDs = e.waiting[upstreamMonitorID]
+	delete(e.waiting, upstreamMonitorID)
+	e.mu.Unlock()
+
+	var errs []error
+	for _, incidentID := range incidentIDs {
+		if _, resolveErr := e.client.Incidents().Resolve(ctx, incidentID, e.actor); resolveErr != nil {
+			errs = append(errs, fmt.Errorf("failed to resolve incident %s after monitor %s recovered: %v",
+				incidentID, upstreamMonitorID, resolveErr))
+		}
+	}
+	return errs
+}
+
+func (c Condition) matches(event webhooks.Event, startCount func(within time.Duration) int) bool {
+	if funk.NotEmpty(c.Event) && !strings.EqualFold(c.Event, event.Kind()) {
+		return false
+	}
+	if len(c.MonitorIDs) > 0 && !funk.ContainsString(c.MonitorIDs, event.MonitorID) {
+		return false
+	}
+	if funk.NotEmpty(c.TeamName) && c.TeamName != event.Incident.TeamName {
+		return false
+	}
+	if funk.NotEmpty(c.CauseContains) && !strings.Contains(event.Incident.Cause, c.CauseContains) {
+		return false
+	}
+	if c.name != nil && !c.name.MatchString(event.Incident.Name) {
+		return false
+	}
+	if c.Flapping != nil && startCount(c.Flapping.Within) < c.Flapping.Count {
+		return false
+	}
+	return true
+}
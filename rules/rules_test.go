@@ -0,0 +1,216 @@
+package rules
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/qameta/betterstack/webhooks"
+)
+
+// incidentsAPI records the incident actions as "<path> <body>" and answers them with the incident
+type incidentsAPI struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (a *incidentsAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body, _ = io.ReadAll(r.Body)
+	a.mu.Lock()
+	a.calls = append(a.calls, r.URL.Path+" "+string(body))
+	a.mu.Unlock()
+
+	if strings.HasSuffix(r.URL.Path, "/comments") {
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	_, _ = w.Write([]byte(`{"data":{"id":"1","attributes":{}}}`))
+}
+
+func (a *incidentsAPI) recorded() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]string(nil), a.calls...)
+}
+
+func newTestEngine(t *testing.T, rules string) (*Engine, *incidentsAPI) {
+	var api = &incidentsAPI{}
+	var server = httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	var config, parseErr = Parse([]byte(rules))
+	if parseErr != nil {
+		t.Fatal(parseErr)
+	}
+	var engine, engineErr = NewEngine(client.NewClient("token", client.WithBaseURL(server.URL)), config)
+	if engineErr != nil {
+		t.Fatal(engineErr)
+	}
+	return engine, api
+}
+
+var t0 = time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+
+func started(incidentID, monitorID string, at time.Time) webhooks.Event {
+	return webhooks.Event{
+		MonitorID: monitorID,
+		Incident: client.Incident{ID: incidentID, Status: client.IncidentStatusStarted, StartedAt: &at,
+			Name: "Shop", Cause: "Status 500", TeamName: "web"},
+	}
+}
+
+func resolved(incidentID, monitorID string, at time.Time) webhooks.Event {
+	var event = started(incidentID, monitorID, at.Add(-time.Minute))
+	event.Incident.Status = client.IncidentStatusResolved
+	event.Incident.ResolvedAt = &at
+	return event
+}
+
+func handle(t *testing.T, engine *Engine, events ...webhooks.Event) {
+	for _, event := range events {
+		if handleErr := engine.Handle(context.Background(), event); handleErr != nil {
+			t.Fatalf("incident %s: %v", event.Incident.ID, handleErr)
+		}
+	}
+}
+
+func TestFlapping(t *testing.T) {
+	var engine, api = newTestEngine(t, `
+rules:
+  - name: flapping
+    when:
+      event: started
+      flapping: {count: 3, within: 30m}
+    actions:
+      - type: acknowledge
+`)
+
+	handle(t, engine,
+		started("1", "10", t0), resolved("1", "10", t0.Add(5*time.Minute)),
+		started("2", "10", t0.Add(10*time.Minute)),
+		started("3", "20", t0.Add(15*time.Minute)),
+		started("4", "10", t0.Add(30*time.Minute)),
+		// 1 and 2 are older than 30 minutes by now
+		started("5", "10", t0.Add(45*time.Minute)),
+	)
+
+	var expected = []string{`/api/v2/incidents/4/acknowledge {"acknowledged_by":"betterstack-rules"}`}
+	if calls := api.recorded(); strings.Join(calls, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expected only the third start within 30m acknowledged, got %v", calls)
+	}
+}
+
+func TestConditionMatches(t *testing.T) {
+	var event = started("1", "10", t0)
+	var starts = func(within time.Duration) int {
+		return int(within / time.Minute)
+	}
+	var cases = []struct {
+		condition Condition
+		expected  bool
+	}{
+		{Condition{}, true},
+		{Condition{Event: "Started"}, true},
+		{Condition{Event: webhooks.EventResolved}, false},
+		{Condition{MonitorIDs: []string{"20", "10"}}, true},
+		{Condition{MonitorIDs: []string{"20"}}, false},
+		{Condition{TeamName: "web"}, true},
+		{Condition{TeamName: "api"}, false},
+		{Condition{CauseContains: "500"}, true},
+		{Condition{CauseContains: "Timeout"}, false},
+		{Condition{name: regexp.MustCompile("^Sh")}, true},
+		{Condition{name: regexp.MustCompile("^API")}, false},
+		{Condition{Flapping: &Flapping{Count: 3, Within: 3 * time.Minute}}, true},
+		{Condition{Flapping: &Flapping{Count: 3, Within: 2 * time.Minute}}, false},
+		{Condition{Event: webhooks.EventStarted, TeamName: "web", CauseContains: "Timeout"}, false},
+	}
+	for i, tc := range cases {
+		if matched := tc.condition.matches(event, starts); matched != tc.expected {
+			t.Errorf("case %d %+v: expected %v, got %v", i+1, tc.condition, tc.expected, matched)
+		}
+	}
+}
+
+func TestCommentTemplate(t *testing.T) {
+	var engine, api = newTestEngine(t, `
+rules:
+  - name: runbook
+    when: {cause_contains: "500"}
+    actions:
+      - type: comment
+        text: "{{ .Incident.Cause }} on {{ .MonitorID }}, see https://runbooks.example.com/{{ .Incident.TeamName }}"
+`)
+
+	handle(t, engine, started("1", "10", t0))
+
+	var expected = `/api/v2/incidents/1/comments {"content":"Status 500 on 10, see https://runbooks.example.com/web"}`
+	if calls := api.recorded(); len(calls) != 1 || calls[0] != expected {
+		t.Errorf("expected %s, got %v", expected, calls)
+	}
+}
+
+func TestResolveWhenRecovered(t *testing.T) {
+	var engine, api = newTestEngine(t, `
+rules:
+  - name: follow the database
+    when: {event: started, monitor_ids: ["20", "30"]}
+    actions:
+      - type: resolve_when_recovered
+        monitor_id: "10"
+`)
+
+	handle(t, engine, started("1", "10", t0), started("2", "20", t0), started("3", "30", t0))
+	if calls := api.recorded(); len(calls) != 0 {
+		t.Fatalf("expected nothing resolved while the upstream is down, got %v", calls)
+	}
+
+	handle(t, engine, resolved("2", "20", t0.Add(time.Minute)), resolved("1", "10", t0.Add(2*time.Minute)))
+	var expected = []string{
+		`/api/v2/incidents/2/resolve {"resolved_by":"betterstack-rules"}`,
+		`/api/v2/incidents/3/resolve {"resolved_by":"betterstack-rules"}`,
+	}
+	if calls := api.recorded(); strings.Join(calls, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expected the waiting incidents resolved, got %v", calls)
+	}
+
+	handle(t, engine, resolved("1", "10", t0.Add(3*time.Minute)))
+	if calls := api.recorded(); len(calls) != len(expected) {
+		t.Errorf("expected the waiting incidents resolved once, got %v", calls)
+	}
+}
+
+func TestNewEngineRejectsInvalidRules(t *testing.T) {
+	var cases = []struct {
+		rules    string
+		expected string
+	}{
+		{`[{name: a, actions: [{type: page}]}]`, `unknown action type: "page"`},
+		{`[{name: a, when: {name_matches: "shop("}, actions: [{type: resolve}]}]`, "invalid name_matches"},
+		{`[{name: a, when: {flapping: {count: 1, within: 1h}}, actions: [{type: resolve}]}]`, "flapping requires"},
+		{`[{name: a, actions: [{type: comment, text: "{{ .MonitorID "}]}]`, "invalid comment template"},
+		{`[{name: a, actions: [{type: resolve_when_recovered}]}]`, "requires monitor_id"},
+		{`[{name: a}]`, "rule has no actions"},
+	}
+	for _, tc := range cases {
+		var config, parseErr = Parse([]byte("rules: " + tc.rules))
+		if parseErr != nil {
+			t.Fatalf("%s: %v", tc.rules, parseErr)
+		}
+		var _, engineErr = NewEngine(client.NewClient("token"), config)
+		if engineErr == nil || !strings.Contains(engineErr.Error(), tc.expected) {
+			t.Errorf("%s: expected an error containing %q, got %v", tc.rules, tc.expected, engineErr)
+		}
+	}
+
+	var config, _ = Parse([]byte("dependencies: {mode: ignore}"))
+	if _, engineErr := NewEngine(client.NewClient("token"), config); engineErr == nil {
+		t.Error("expected an unknown dependencies mode to be rejected")
+	}
+}
@@ -0,0 +1,583 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: betterstack/v1/betterstack.proto
+
+package betterstackv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BetterstackService_ListMonitors_FullMethodName        = "/betterstack.v1.BetterstackService/ListMonitors"
+	BetterstackService_GetMonitor_FullMethodName          = "/betterstack.v1.BetterstackService/GetMonitor"
+	BetterstackService_CreateMonitor_FullMethodName       = "/betterstack.v1.BetterstackService/CreateMonitor"
+	BetterstackService_UpdateMonitor_FullMethodName       = "/betterstack.v1.BetterstackService/UpdateMonitor"
+	BetterstackService_DeleteMonitor_FullMethodName       = "/betterstack.v1.BetterstackService/DeleteMonitor"
+	BetterstackService_PauseMonitor_FullMethodName        = "/betterstack.v1.BetterstackService/PauseMonitor"
+	BetterstackService_ResumeMonitor_FullMethodName       = "/betterstack.v1.BetterstackService/ResumeMonitor"
+	BetterstackService_ListMonitorGroups_FullMethodName   = "/betterstack.v1.BetterstackService/ListMonitorGroups"
+	BetterstackService_CreateMonitorGroup_FullMethodName  = "/betterstack.v1.BetterstackService/CreateMonitorGroup"
+	BetterstackService_ListIncidents_FullMethodName       = "/betterstack.v1.BetterstackService/ListIncidents"
+	BetterstackService_GetIncident_FullMethodName         = "/betterstack.v1.BetterstackService/GetIncident"
+	BetterstackService_AcknowledgeIncident_FullMethodName = "/betterstack.v1.BetterstackService/AcknowledgeIncident"
+	BetterstackService_ResolveIncident_FullMethodName     = "/betterstack.v1.BetterstackService/ResolveIncident"
+)
+
+// BetterstackServiceClient is the client API for BetterstackService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BetterstackService exposes the operations of the Go client to services written in other languages. Bearer tokens
+// stay on the sidecar running the service, callers never see them.
+type BetterstackServiceClient interface {
+	ListMonitors(ctx context.Context, in *ListMonitorsRequest, opts ...grpc.CallOption) (*ListMonitorsResponse, error)
+	GetMonitor(ctx context.Context, in *GetMonitorRequest, opts ...grpc.CallOption) (*Monitor, error)
+	CreateMonitor(ctx context.Context, in *CreateMonitorRequest, opts ...grpc.CallOption) (*Monitor, error)
+	UpdateMonitor(ctx context.Context, in *UpdateMonitorRequest, opts ...grpc.CallOption) (*Monitor, error)
+	DeleteMonitor(ctx context.Context, in *DeleteMonitorRequest, opts ...grpc.CallOption) (*DeleteMonitorResponse, error)
+	PauseMonitor(ctx context.Context, in *PauseMonitorRequest, opts ...grpc.CallOption) (*Monitor, error)
+	ResumeMonitor(ctx context.Context, in *ResumeMonitorRequest, opts ...grpc.CallOption) (*Monitor, error)
+	ListMonitorGroups(ctx context.Context, in *ListMonitorGroupsRequest, opts ...grpc.CallOption) (*ListMonitorGroupsResponse, error)
+	CreateMonitorGroup(ctx context.Context, in *CreateMonitorGroupRequest, opts ...grpc.CallOption) (*MonitorGroup, error)
+	ListIncidents(ctx context.Context, in *ListIncidentsRequest, opts ...grpc.CallOption) (*ListIncidentsResponse, error)
+	GetIncident(ctx context.Context, in *GetIncidentRequest, opts ...grpc.CallOption) (*Incident, error)
+	AcknowledgeIncident(ctx context.Context, in *AcknowledgeIncidentRequest, opts ...grpc.CallOption) (*Incident, error)
+	ResolveIncident(ctx context.Context, in *ResolveIncidentRequest, opts ...grpc.CallOption) (*Incident, error)
+}
+
+type betterstackServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBetterstackServiceClient(cc grpc.ClientConnInterface) BetterstackServiceClient {
+	return &betterstackServiceClient{cc}
+}
+
+func (c *betterstackServiceClient) ListMonitors(ctx context.Context, in *ListMonitorsRequest, opts ...grpc.CallOption) (*ListMonitorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMonitorsResponse)
+	err := c.cc.Invoke(ctx, BetterstackService_ListMonitors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterstackServiceClient) GetMonitor(ctx context.Context, in *GetMonitorRequest, opts ...grpc.CallOption) (*Monitor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Monitor)
+	err := c.cc.Invoke(ctx, BetterstackService_GetMonitor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterstackServiceClient) CreateMonitor(ctx context.Context, in *CreateMonitorRequest, opts ...grpc.CallOption) (*Monitor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Monitor)
+	err := c.cc.Invoke(ctx, BetterstackService_CreateMonitor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterstackServiceClient) UpdateMonitor(ctx context.Context, in *UpdateMonitorRequest, opts ...grpc.CallOption) (*Monitor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Monitor)
+	err := c.cc.Invoke(ctx, BetterstackService_UpdateMonitor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterstackServiceClient) DeleteMonitor(ctx context.Context, in *DeleteMonitorRequest, opts ...grpc.CallOption) (*DeleteMonitorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMonitorResponse)
+	err := c.cc.Invoke(ctx, BetterstackService_DeleteMonitor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterstackServiceClient) PauseMonitor(ctx context.Context, in *PauseMonitorRequest, opts ...grpc.CallOption) (*Monitor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Monitor)
+	err := c.cc.Invoke(ctx, BetterstackService_PauseMonitor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterstackServiceClient) ResumeMonitor(ctx context.Context, in *ResumeMonitorRequest, opts ...grpc.CallOption) (*Monitor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Monitor)
+	err := c.cc.Invoke(ctx, BetterstackService_ResumeMonitor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterstackServiceClient) ListMonitorGroups(ctx context.Context, in *ListMonitorGroupsRequest, opts ...grpc.CallOption) (*ListMonitorGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMonitorGroupsResponse)
+	err := c.cc.Invoke(ctx, BetterstackService_ListMonitorGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterstackServiceClient) CreateMonitorGroup(ctx context.Context, in *CreateMonitorGroupRequest, opts ...grpc.CallOption) (*MonitorGroup, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MonitorGroup)
+	err := c.cc.Invoke(ctx, BetterstackService_CreateMonitorGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterstackServiceClient) ListIncidents(ctx context.Context, in *ListIncidentsRequest, opts ...grpc.CallOption) (*ListIncidentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIncidentsResponse)
+	err := c.cc.Invoke(ctx, BetterstackService_ListIncidents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterstackServiceClient) GetIncident(ctx context.Context, in *GetIncidentRequest, opts ...grpc.CallOption) (*Incident, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Incident)
+	err := c.cc.Invoke(ctx, BetterstackService_GetIncident_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterstackServiceClient) AcknowledgeIncident(ctx context.Context, in *AcknowledgeIncidentRequest, opts ...grpc.CallOption) (*Incident, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Incident)
+	err := c.cc.Invoke(ctx, BetterstackService_AcknowledgeIncident_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterstackServiceClient) ResolveIncident(ctx context.Context, in *ResolveIncidentRequest, opts ...grpc.CallOption) (*Incident, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Incident)
+	err := c.cc.Invoke(ctx, BetterstackService_ResolveIncident_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BetterstackServiceServer is the server API for BetterstackService service.
+// All implementations must embed UnimplementedBetterstackServiceServer
+// for forward compatibility.
+//
+// BetterstackService exposes the operations of the Go client to services written in other languages. Bearer tokens
+// stay on the sidecar running the service, callers never see them.
+type BetterstackServiceServer interface {
+	ListMonitors(context.Context, *ListMonitorsRequest) (*ListMonitorsResponse, error)
+	GetMonitor(context.Context, *GetMonitorRequest) (*Monitor, error)
+	CreateMonitor(context.Context, *CreateMonitorRequest) (*Monitor, error)
+	UpdateMonitor(context.Context, *UpdateMonitorRequest) (*Monitor, error)
+	DeleteMonitor(context.Context, *DeleteMonitorRequest) (*DeleteMonitorResponse, error)
+	PauseMonitor(context.Context, *PauseMonitorRequest) (*Monitor, error)
+	ResumeMonitor(context.Context, *ResumeMonitorRequest) (*Monitor, error)
+	ListMonitorGroups(context.Context, *ListMonitorGroupsRequest) (*ListMonitorGroupsResponse, error)
+	CreateMonitorGroup(context.Context, *CreateMonitorGroupRequest) (*MonitorGroup, error)
+	ListIncidents(context.Context, *ListIncidentsRequest) (*ListIncidentsResponse, error)
+	GetIncident(context.Context, *GetIncidentRequest) (*Incident, error)
+	AcknowledgeIncident(context.Context, *AcknowledgeIncidentRequest) (*Incident, error)
+	ResolveIncident(context.Context, *ResolveIncidentRequest) (*Incident, error)
+	mustEmbedUnimplementedBetterstackServiceServer()
+}
+
+// UnimplementedBetterstackServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBetterstackServiceServer struct{}
+
+func (UnimplementedBetterstackServiceServer) ListMonitors(context.Context, *ListMonitorsRequest) (*ListMonitorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMonitors not implemented")
+}
+func (UnimplementedBetterstackServiceServer) GetMonitor(context.Context, *GetMonitorRequest) (*Monitor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMonitor not implemented")
+}
+func (UnimplementedBetterstackServiceServer) CreateMonitor(context.Context, *CreateMonitorRequest) (*Monitor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMonitor not implemented")
+}
+func (UnimplementedBetterstackServiceServer) UpdateMonitor(context.Context, *UpdateMonitorRequest) (*Monitor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMonitor not implemented")
+}
+func (UnimplementedBetterstackServiceServer) DeleteMonitor(context.Context, *DeleteMonitorRequest) (*DeleteMonitorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMonitor not implemented")
+}
+func (UnimplementedBetterstackServiceServer) PauseMonitor(context.Context, *PauseMonitorRequest) (*Monitor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseMonitor not implemented")
+}
+func (UnimplementedBetterstackServiceServer) ResumeMonitor(context.Context, *ResumeMonitorRequest) (*Monitor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeMonitor not implemented")
+}
+func (UnimplementedBetterstackServiceServer) ListMonitorGroups(context.Context, *ListMonitorGroupsRequest) (*ListMonitorGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMonitorGroups not implemented")
+}
+func (UnimplementedBetterstackServiceServer) CreateMonitorGroup(context.Context, *CreateMonitorGroupRequest) (*MonitorGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMonitorGroup not implemented")
+}
+func (UnimplementedBetterstackServiceServer) ListIncidents(context.Context, *ListIncidentsRequest) (*ListIncidentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIncidents not implemented")
+}
+func (UnimplementedBetterstackServiceServer) GetIncident(context.Context, *GetIncidentRequest) (*Incident, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncident not implemented")
+}
+func (UnimplementedBetterstackServiceServer) AcknowledgeIncident(context.Context, *AcknowledgeIncidentRequest) (*Incident, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeIncident not implemented")
+}
+func (UnimplementedBetterstackServiceServer) ResolveIncident(context.Context, *ResolveIncidentRequest) (*Incident, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveIncident not implemented")
+}
+func (UnimplementedBetterstackServiceServer) mustEmbedUnimplementedBetterstackServiceServer() {}
+func (UnimplementedBetterstackServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeBetterstackServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BetterstackServiceServer will
+// result in compilation errors.
+type UnsafeBetterstackServiceServer interface {
+	mustEmbedUnimplementedBetterstackServiceServer()
+}
+
+func RegisterBetterstackServiceServer(s grpc.ServiceRegistrar, srv BetterstackServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBetterstackServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BetterstackService_ServiceDesc, srv)
+}
+
+func _BetterstackService_ListMonitors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMonitorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterstackServiceServer).ListMonitors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterstackService_ListMonitors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterstackServiceServer).ListMonitors(ctx, req.(*ListMonitorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterstackService_GetMonitor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMonitorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterstackServiceServer).GetMonitor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterstackService_GetMonitor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterstackServiceServer).GetMonitor(ctx, req.(*GetMonitorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterstackService_CreateMonitor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMonitorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterstackServiceServer).CreateMonitor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterstackService_CreateMonitor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterstackServiceServer).CreateMonitor(ctx, req.(*CreateMonitorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterstackService_UpdateMonitor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMonitorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterstackServiceServer).UpdateMonitor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterstackService_UpdateMonitor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterstackServiceServer).UpdateMonitor(ctx, req.(*UpdateMonitorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterstackService_DeleteMonitor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMonitorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterstackServiceServer).DeleteMonitor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterstackService_DeleteMonitor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterstackServiceServer).DeleteMonitor(ctx, req.(*DeleteMonitorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterstackService_PauseMonitor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseMonitorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterstackServiceServer).PauseMonitor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterstackService_PauseMonitor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterstackServiceServer).PauseMonitor(ctx, req.(*PauseMonitorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterstackService_ResumeMonitor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeMonitorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterstackServiceServer).ResumeMonitor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterstackService_ResumeMonitor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterstackServiceServer).ResumeMonitor(ctx, req.(*ResumeMonitorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterstackService_ListMonitorGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMonitorGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterstackServiceServer).ListMonitorGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterstackService_ListMonitorGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterstackServiceServer).ListMonitorGroups(ctx, req.(*ListMonitorGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterstackService_CreateMonitorGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMonitorGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterstackServiceServer).CreateMonitorGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterstackService_CreateMonitorGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterstackServiceServer).CreateMonitorGroup(ctx, req.(*CreateMonitorGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterstackService_ListIncidents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIncidentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterstackServiceServer).ListIncidents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterstackService_ListIncidents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterstackServiceServer).ListIncidents(ctx, req.(*ListIncidentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterstackService_GetIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncidentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterstackServiceServer).GetIncident(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterstackService_GetIncident_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterstackServiceServer).GetIncident(ctx, req.(*GetIncidentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterstackService_AcknowledgeIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeIncidentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterstackServiceServer).AcknowledgeIncident(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterstackService_AcknowledgeIncident_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterstackServiceServer).AcknowledgeIncident(ctx, req.(*AcknowledgeIncidentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterstackService_ResolveIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveIncidentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterstackServiceServer).ResolveIncident(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterstackService_ResolveIncident_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterstackServiceServer).ResolveIncident(ctx, req.(*ResolveIncidentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BetterstackService_ServiceDesc is the grpc.ServiceDesc for BetterstackService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BetterstackService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "betterstack.v1.BetterstackService",
+	HandlerType: (*BetterstackServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListMonitors",
+			Handler:    _BetterstackService_ListMonitors_Handler,
+		},
+		{
+			MethodName: "GetMonitor",
+			Handler:    _BetterstackService_GetMonitor_Handler,
+		},
+		{
+			MethodName: "CreateMonitor",
+			Handler:    _BetterstackService_CreateMonitor_Handler,
+		},
+		{
+			MethodName: "UpdateMonitor",
+			Handler:    _BetterstackService_UpdateMonitor_Handler,
+		},
+		{
+			MethodName: "DeleteMonitor",
+			Handler:    _BetterstackService_DeleteMonitor_Handler,
+		},
+		{
+			MethodName: "PauseMonitor",
+			Handler:    _BetterstackService_PauseMonitor_Handler,
+		},
+		{
+			MethodName: "ResumeMonitor",
+			Handler:    _BetterstackService_ResumeMonitor_Handler,
+		},
+		{
+			MethodName: "ListMonitorGroups",
+			Handler:    _BetterstackService_ListMonitorGroups_Handler,
+		},
+		{
+			MethodName: "CreateMonitorGroup",
+			Handler:    _BetterstackService_CreateMonitorGroup_Handler,
+		},
+		{
+			MethodName: "ListIncidents",
+			Handler:    _BetterstackService_ListIncidents_Handler,
+		},
+		{
+			MethodName: "GetIncident",
+			Handler:    _BetterstackService_GetIncident_Handler,
+		},
+		{
+			MethodName: "AcknowledgeIncident",
+			Handler:    _BetterstackService_AcknowledgeIncident_Handler,
+		},
+		{
+			MethodName: "ResolveIncident",
+			Handler:    _BetterstackService_ResolveIncident_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "betterstack/v1/betterstack.proto",
+}
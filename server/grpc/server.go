@@ -0,0 +1,393 @@
+// Package grpc serves the monitor, monitor group and incident operations of the client over gRPC, so services
+// written in other languages share its retries, caching and rate limiting through a sidecar. The contract is
+// proto/betterstack/v1/betterstack.proto, the stubs in betterstackv1 are generated with buf.
+package grpc
+
+//go:generate buf generate
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/qameta/betterstack/server/grpc/betterstackv1"
+	"github.com/thoas/go-funk"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Server implements BetterstackService with the client. The Better Stack token stays inside the server, put
+// authentication of the callers in front of it, e.g. an interceptor or a mesh policy.
+type Server struct {
+	betterstackv1.UnimplementedBetterstackServiceServer
+	client *client.BetterstackClient
+}
+
+func NewServer(c *client.BetterstackClient) *Server {
+	return &Server{client: c}
+}
+
+// Register adds the service to a gRPC server
+func (s *Server) Register(registrar grpclib.ServiceRegistrar) {
+	betterstackv1.RegisterBetterstackServiceServer(registrar, s)
+}
+
+func (s *Server) ListMonitors(ctx context.Context, request *betterstackv1.ListMonitorsRequest) (*betterstackv1.ListMonitorsResponse, error) {
+	var filters []client.MonitorFilter
+	switch request.GetFilterType() {
+	case client.Blanc:
+	case "url":
+		filters = append(filters, client.URLContains(request.GetFilterValue()))
+	case "pronounceable_name":
+		filters = append(filters, func(monitor client.Monitor) bool {
+			return strings.Contains(monitor.PronounceableName, request.GetFilterValue())
+		})
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter type: %s", request.GetFilterType())
+	}
+
+	var monitors, listErr = s.client.Monitors().List(ctx, filters...)
+	if listErr != nil {
+		return nil, statusError(listErr)
+	}
+	var result = &betterstackv1.ListMonitorsResponse{Monitors: make([]*betterstackv1.Monitor, 0, len(monitors))}
+	for _, monitor := range monitors {
+		result.Monitors = append(result.Monitors, monitorMessage(monitor))
+	}
+	return result, nil
+}
+
+func (s *Server) GetMonitor(ctx context.Context, request *betterstackv1.GetMonitorRequest) (*betterstackv1.Monitor, error) {
+	if funk.IsEmpty(request.GetId()) {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	var monitor, getErr = s.client.Monitors().Get(ctx, request.GetId())
+	if getErr != nil {
+		return nil, statusError(getErr)
+	}
+	return monitorMessage(monitor.Data.Attributes), nil
+}
+
+func (s *Server) CreateMonitor(ctx context.Context, request *betterstackv1.CreateMonitorRequest) (*betterstackv1.Monitor, error) {
+	if request.GetMonitor() == nil {
+		return nil, status.Error(codes.InvalidArgument, "monitor is required")
+	}
+	var monitor = monitorModel(request.GetMonitor())
+	monitor.ID = client.Blanc
+	var created, createErr = s.client.Monitors().Create(ctx, monitor)
+	if createErr != nil {
+		return nil, statusError(createErr)
+	}
+	return monitorMessage(created.Data.Attributes), nil
+}
+
+func (s *Server) UpdateMonitor(ctx context.Context, request *betterstackv1.UpdateMonitorRequest) (*betterstackv1.Monitor, error) {
+	if funk.IsEmpty(request.GetId()) || request.GetMonitor() == nil {
+		return nil, status.Error(codes.InvalidArgument, "id and monitor are required")
+	}
+	var updated, updateErr = s.client.Monitors().Update(ctx, request.GetId(), monitorModel(request.GetMonitor()))
+	if updateErr != nil {
+		return nil, statusError(updateErr)
+	}
+	return monitorMessage(updated.Data.Attributes), nil
+}
+
+func (s *Server) DeleteMonitor(ctx context.Context, request *betterstackv1.DeleteMonitorRequest) (*betterstackv1.DeleteMonitorResponse, error) {
+	if funk.IsEmpty(request.GetId()) {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if deleteErr := s.client.Monitors().Delete(ctx, request.GetId()); deleteErr != nil {
+		return nil, statusError(deleteErr)
+	}
+	return &betterstackv1.DeleteMonitorResponse{}, nil
+}
+
+func (s *Server) PauseMonitor(ctx context.Context, request *betterstackv1.PauseMonitorRequest) (*betterstackv1.Monitor, error) {
+	if funk.IsEmpty(request.GetId()) {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	var paused, pauseErr = s.client.Monitors().Pause(ctx, request.GetId())
+	if pauseErr != nil {
+		return nil, statusError(pauseErr)
+	}
+	return monitorMessage(paused.Data.Attributes), nil
+}
+
+func (s *Server) ResumeMonitor(ctx context.Context, request *betterstackv1.ResumeMonitorRequest) (*betterstackv1.Monitor, error) {
+	if funk.IsEmpty(request.GetId()) {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	var resumed, resumeErr = s.client.Monitors().Resume(ctx, request.GetId())
+	if resumeErr != nil {
+		return nil, statusError(resumeErr)
+	}
+	return monitorMessage(resumed.Data.Attributes), nil
+}
+
+func (s *Server) ListMonitorGroups(ctx context.Context, _ *betterstackv1.ListMonitorGroupsRequest) (*betterstackv1.ListMonitorGroupsResponse, error) {
+	var groups, listErr = s.client.MonitorGroups().List(ctx)
+	if listErr != nil {
+		return nil, statusError(listErr)
+	}
+	var result = &betterstackv1.ListMonitorGroupsResponse{MonitorGroups: make([]*betterstackv1.MonitorGroup, 0, len(groups))}
+	for _, group := range groups {
+		result.MonitorGroups = append(result.MonitorGroups, groupMessage(group))
+	}
+	return result, nil
+}
+
+func (s *Server) CreateMonitorGroup(ctx context.Context, request *betterstackv1.CreateMonitorGroupRequest) (*betterstackv1.MonitorGroup, error) {
+	var group = request.GetMonitorGroup()
+	if funk.IsEmpty(group.GetName()) {
+		return nil, status.Error(codes.InvalidArgument, "monitor group name is required")
+	}
+	var created, createErr = s.client.MonitorGroups().Create(ctx, client.MonitorGroup{
+		Name:      group.GetName(),
+		TeamName:  group.GetTeamName(),
+		SortIndex: int(group.GetSortIndex()),
+		Paused:    group.GetPaused(),
+	})
+	if createErr != nil {
+		return nil, statusError(createErr)
+	}
+	var result = created.Data.Attributes
+	result.ID = created.Data.ID
+	return groupMessage(result), nil
+}
+
+// ListIncidents returns one page of incidents, pages start at 1
+func (s *Server) ListIncidents(ctx context.Context, request *betterstackv1.ListIncidentsRequest) (*betterstackv1.ListIncidentsResponse, error) {
+	var page, pageErr = s.client.Incidents().ListPage(ctx, int(request.GetPage()), timeValue(request.GetFrom()),
+		timeValue(request.GetTo()))
+	if pageErr != nil {
+		return nil, statusError(pageErr)
+	}
+	var result = &betterstackv1.ListIncidentsResponse{
+		Incidents: make([]*betterstackv1.Incident, 0, len(page.Data)),
+		HasNext:   page.Pagination.HasNext(),
+	}
+	for _, incident := range page.Data {
+		result.Incidents = append(result.Incidents, incidentMessage(incident.Attributes, incident.Relationships["monitor"].Data.ID))
+	}
+	return result, nil
+}
+
+func (s *Server) GetIncident(ctx context.Context, request *betterstackv1.GetIncidentRequest) (*betterstackv1.Incident, error) {
+	if funk.IsEmpty(request.GetId()) {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	return incidentResult(s.client.Incidents().Get(ctx, request.GetId()))
+}
+
+func (s *Server) AcknowledgeIncident(ctx context.Context, request *betterstackv1.AcknowledgeIncidentRequest) (*betterstackv1.Incident, error) {
+	if funk.IsEmpty(request.GetId()) {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	return incidentResult(s.client.Incidents().Acknowledge(ctx, request.GetId(), request.GetAcknowledgedBy()))
+}
+
+func (s *Server) ResolveIncident(ctx context.Context, request *betterstackv1.ResolveIncidentRequest) (*betterstackv1.Incident, error) {
+	if funk.IsEmpty(request.GetId()) {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	return incidentResult(s.client.Incidents().Resolve(ctx, request.GetId(), request.GetResolvedBy()))
+}
+
+func incidentResult(response client.IncidentResponse, err error) (*betterstackv1.Incident, error) {
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return incidentMessage(response.Data.Attributes, response.Data.Relationships["monitor"].Data.ID), nil
+}
+
+// statusError maps client errors to gRPC codes, API errors by their HTTP status
+func statusError(err error) error {
+	switch {
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	case errors.Is(err, client.ErrReadOnlyClient) || errors.Is(err, client.ErrDeleteVetoed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, client.ErrCircuitOpen):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, client.ErrRateLimited):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, client.ErrConflict):
+		return status.Error(codes.Aborted, err.Error())
+	}
+
+	switch code := client.StatusCode(err); {
+	case code == http.StatusNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case code == http.StatusUnauthorized:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case code == http.StatusForbidden:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case code == http.StatusBadRequest || code == http.StatusUnprocessableEntity:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case code == http.StatusConflict:
+		return status.Error(codes.Aborted, err.Error())
+	case code == http.StatusTooManyRequests:
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case code >= http.StatusInternalServerError:
+		return status.Error(codes.Unavailable, err.Error())
+	case code == 0:
+		// Validation in the client, nothing was sent
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
+
+func monitorMessage(monitor client.Monitor) *betterstackv1.Monitor {
+	var headers = make([]*betterstackv1.RequestHeader, 0, len(monitor.RequestHeaders))
+	for _, header := range monitor.RequestHeaders {
+		headers = append(headers, &betterstackv1.RequestHeader{Name: header.Name})
+	}
+	return &betterstackv1.Monitor{
+		Id:                  monitor.ID,
+		TeamName:            monitor.TeamName,
+		MonitorType:         monitor.MonitorType,
+		Url:                 monitor.URL,
+		PronounceableName:   monitor.PronounceableName,
+		Email:               monitor.Email,
+		Sms:                 monitor.SMS,
+		Call:                monitor.Call,
+		Push:                monitor.Push,
+		CheckFrequency:      int32(monitor.CheckFrequency),
+		RequestHeaders:      headers,
+		ExpectedStatusCodes: int32s(monitor.ExpectedStatusCodes),
+		DomainExpiration:    int32(monitor.DomainExpiration),
+		SslExpiration:       int32(monitor.SSLExpiration),
+		PolicyId:            monitor.PolicyID,
+		FollowRedirects:     monitor.FollowRedirects,
+		RequiredKeyword:     monitor.RequiredKeyword,
+		TeamWait:            int32(monitor.TeamWait),
+		Paused:              monitor.Paused,
+		Port:                int32(monitor.Port),
+		Regions:             monitor.Regions,
+		MonitorGroupId:      monitor.GroupID(),
+		RecoveryPeriod:      int32(monitor.RecoveryPeriod),
+		VerifySsl:           monitor.VerifySSL,
+		ConfirmationPeriod:  int32(monitor.ConfirmationPeriod),
+		HttpMethod:          monitor.HTTPMethod,
+		RequestTimeout:      int32(monitor.RequestTimeout),
+		RequestBody:         monitor.RequestBody,
+		MaintenanceDays:     monitor.MaintenanceDays,
+		MaintenanceFrom:     monitor.MaintenanceFrom,
+		MaintenanceTo:       monitor.MaintenanceTo,
+		MaintenanceTimezone: monitor.MaintenanceTimezone,
+		RememberCookies:     monitor.RememberCookies,
+		PlaywrightScript:    monitor.PlaywrightScript,
+		ScenarioName:        monitor.ScenarioName,
+		Status:              monitor.Status,
+	}
+}
+
+func monitorModel(message *betterstackv1.Monitor) client.Monitor {
+	var headers []client.RequestHeader
+	for _, header := range message.GetRequestHeaders() {
+		headers = append(headers, client.RequestHeader{Name: header.GetName(), Value: header.GetValue()})
+	}
+	var codes []int
+	for _, code := range message.GetExpectedStatusCodes() {
+		codes = append(codes, int(code))
+	}
+	var monitor = client.Monitor{
+		ID:                  message.GetId(),
+		TeamName:            message.GetTeamName(),
+		MonitorType:         message.GetMonitorType(),
+		URL:                 message.GetUrl(),
+		PronounceableName:   message.GetPronounceableName(),
+		Email:               message.GetEmail(),
+		SMS:                 message.GetSms(),
+		Call:                message.GetCall(),
+		Push:                message.GetPush(),
+		CheckFrequency:      int(message.GetCheckFrequency()),
+		RequestHeaders:      headers,
+		ExpectedStatusCodes: codes,
+		DomainExpiration:    int(message.GetDomainExpiration()),
+		SSLExpiration:       int(message.GetSslExpiration()),
+		PolicyID:            message.GetPolicyId(),
+		FollowRedirects:     message.GetFollowRedirects(),
+		RequiredKeyword:     message.GetRequiredKeyword(),
+		TeamWait:            int(message.GetTeamWait()),
+		Paused:              message.GetPaused(),
+		Port:                int(message.GetPort()),
+		Regions:             message.GetRegions(),
+		RecoveryPeriod:      int(message.GetRecoveryPeriod()),
+		VerifySSL:           message.GetVerifySsl(),
+		ConfirmationPeriod:  int(message.GetConfirmationPeriod()),
+		HTTPMethod:          message.GetHttpMethod(),
+		RequestTimeout:      int(message.GetRequestTimeout()),
+		RequestBody:         message.GetRequestBody(),
+		AuthUsername:        message.GetAuthUsername(),
+		AuthPassword:        message.GetAuthPassword(),
+		MaintenanceDays:     message.GetMaintenanceDays(),
+		MaintenanceFrom:     message.GetMaintenanceFrom(),
+		MaintenanceTo:       message.GetMaintenanceTo(),
+		MaintenanceTimezone: message.GetMaintenanceTimezone(),
+		RememberCookies:     message.GetRememberCookies(),
+		PlaywrightScript:    message.GetPlaywrightScript(),
+		ScenarioName:        message.GetScenarioName(),
+	}
+	if funk.NotEmpty(message.GetMonitorGroupId()) {
+		monitor.MonitorGroupID = message.GetMonitorGroupId()
+	}
+	return monitor
+}
+
+func groupMessage(group client.MonitorGroup) *betterstackv1.MonitorGroup {
+	return &betterstackv1.MonitorGroup{
+		Id:        group.ID,
+		Name:      group.Name,
+		TeamName:  group.TeamName,
+		SortIndex: int32(group.SortIndex),
+		Paused:    group.Paused,
+		CreatedAt: timestamp(group.CreatedAt),
+		UpdatedAt: timestamp(group.UpdatedAt),
+	}
+}
+
+func incidentMessage(incident client.Incident, monitorID string) *betterstackv1.Incident {
+	return &betterstackv1.Incident{
+		Id:             incident.ID,
+		Name:           incident.Name,
+		Url:            incident.URL,
+		Cause:          incident.Cause,
+		Status:         incident.Status,
+		TeamName:       incident.TeamName,
+		MonitorId:      monitorID,
+		StartedAt:      timestamp(incident.StartedAt),
+		AcknowledgedAt: timestamp(incident.AcknowledgedAt),
+		AcknowledgedBy: incident.AcknowledgedBy,
+		ResolvedAt:     timestamp(incident.ResolvedAt),
+		ResolvedBy:     incident.ResolvedBy,
+		Regions:        incident.Regions,
+	}
+}
+
+func timestamp(value *time.Time) *timestamppb.Timestamp {
+	if value == nil {
+		return nil
+	}
+	return timestamppb.New(*value)
+}
+
+func timeValue(value *timestamppb.Timestamp) time.Time {
+	if value == nil {
+		return time.Time{}
+	}
+	return value.AsTime()
+}
+
+func int32s(values []int) []int32 {
+	var result = make([]int32, 0, len(values))
+	for _, value := range values {
+		result = append(result, int32(value))
+	}
+	return result
+}
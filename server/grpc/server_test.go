@@ -0,0 +1,93 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/qameta/betterstack/client"
+	"github.com/qameta/betterstack/server/grpc/betterstackv1"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newTestService(t *testing.T, api http.HandlerFunc) betterstackv1.BetterstackServiceClient {
+	var server = httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	var listener = bufconn.Listen(1 << 20)
+	var grpcServer = grpclib.NewServer()
+	NewServer(client.NewClient("token", client.WithBaseURL(server.URL))).Register(grpcServer)
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+
+	var conn, dialErr = grpclib.NewClient("passthrough:///bufnet",
+		grpclib.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpclib.WithTransportCredentials(insecure.NewCredentials()))
+	if dialErr != nil {
+		t.Fatal(dialErr)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return betterstackv1.NewBetterstackServiceClient(conn)
+}
+
+func TestGetMonitorHidesCredentials(t *testing.T) {
+	var service = newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"id":"42","attributes":{"url":"https://example.com","pronounceable_name":"shop",
+			"auth_username":"bob","auth_password":"secret","request_headers":[{"name":"X-Key","value":"secret"}],
+			"monitor_group_id":7}}}`))
+	})
+
+	var monitor, getErr = service.GetMonitor(context.Background(), &betterstackv1.GetMonitorRequest{Id: "42"})
+	if getErr != nil {
+		t.Fatal(getErr)
+	}
+	if monitor.GetId() != "42" || monitor.GetPronounceableName() != "shop" || monitor.GetMonitorGroupId() != "7" {
+		t.Errorf("unexpected monitor: %v", monitor)
+	}
+	if monitor.GetAuthUsername() != "" || monitor.GetAuthPassword() != "" || monitor.GetRequestHeaders()[0].GetValue() != "" {
+		t.Errorf("credentials leaked: %v", monitor)
+	}
+}
+
+func TestStatusCodes(t *testing.T) {
+	var cases = []struct {
+		status int
+		code   codes.Code
+	}{
+		{http.StatusNotFound, codes.NotFound},
+		{http.StatusUnauthorized, codes.Unauthenticated},
+		{http.StatusForbidden, codes.PermissionDenied},
+		{http.StatusUnprocessableEntity, codes.InvalidArgument},
+		{http.StatusBadGateway, codes.Unavailable},
+	}
+	for _, tc := range cases {
+		var service = newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tc.status)
+			_, _ = w.Write([]byte(`{"errors":"failed"}`))
+		})
+		var _, getErr = service.GetMonitor(context.Background(), &betterstackv1.GetMonitorRequest{Id: "42"})
+		if status.Code(getErr) != tc.code {
+			t.Errorf("HTTP %d: expected %v, got %v", tc.status, tc.code, getErr)
+		}
+	}
+}
+
+func TestInvalidArguments(t *testing.T) {
+	var service = newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected API request %s %s", r.Method, r.URL)
+	})
+	var _, getErr = service.GetMonitor(context.Background(), &betterstackv1.GetMonitorRequest{})
+	if status.Code(getErr) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument, got %v", getErr)
+	}
+	var _, listErr = service.ListMonitors(context.Background(), &betterstackv1.ListMonitorsRequest{FilterType: "team"})
+	if status.Code(listErr) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument, got %v", listErr)
+	}
+}
@@ -0,0 +1,149 @@
+package rest
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/qameta/betterstack/client"
+)
+
+const OpenAPIVersion = "3.0.3"
+
+var pathParameter = regexp.MustCompile(`\{([a-z_]+)\}`)
+
+type route struct {
+	Method      string
+	Path        string
+	Summary     string
+	Query       []string
+	RequestBody any
+	Response    any
+	Status      int
+	handler     http.HandlerFunc
+}
+
+func (f *Facade) routes() []route {
+	return []route{
+		{Method: http.MethodGet, Path: "/monitors", Summary: "List monitors", Response: []MonitorView{},
+			Status: http.StatusOK, handler: f.listMonitors},
+		{Method: http.MethodPost, Path: "/monitors", Summary: "Create a monitor from a template",
+			RequestBody: CreateFromTemplateRequest{}, Response: MonitorView{}, Status: http.StatusCreated,
+			handler: f.createMonitor},
+		{Method: http.MethodGet, Path: "/monitors/{id}", Summary: "Get a monitor", Response: MonitorView{},
+			Status: http.StatusOK, handler: f.getMonitor},
+		{Method: http.MethodGet, Path: "/monitors/{id}/sla", Summary: "Get the SLA of a monitor",
+			Query: []string{"from", "to"}, Response: SLAView{}, Status: http.StatusOK, handler: f.getSLA},
+		{Method: http.MethodPost, Path: "/groups/{id}/pause", Summary: "Pause a monitor group", Response: GroupView{},
+			Status: http.StatusOK, handler: f.pauseGroup},
+		{Method: http.MethodPost, Path: "/groups/{id}/resume", Summary: "Resume a monitor group",
+			Response: GroupView{}, Status: http.StatusOK, handler: f.resumeGroup},
+		{Method: http.MethodGet, Path: "/openapi.json", Summary: "OpenAPI document of this API",
+			Status: http.StatusOK, handler: f.openAPI},
+	}
+}
+
+// OpenAPI generates the OpenAPI document describing the routes, schemas are derived from the view types
+func OpenAPI(routes []route) map[string]any {
+	var paths = map[string]any{}
+
+	for _, r := range routes {
+		var operations, _ = paths[r.Path].(map[string]any)
+		if operations == nil {
+			operations = map[string]any{}
+			paths[r.Path] = operations
+		}
+
+		var parameters []any
+		for _, match := range pathParameter.FindAllStringSubmatch(r.Path, -1) {
+			parameters = append(parameters, map[string]any{
+				"name": match[1], "in": "path", "required": true, "schema": map[string]any{"type": "string"},
+			})
+		}
+		for _, name := range r.Query {
+			parameters = append(parameters, map[string]any{
+				"name": name, "in": "query", "required": false,
+				"schema": map[string]any{"type": "string", "format": "date"},
+			})
+		}
+
+		var response = map[string]any{"description": http.StatusText(r.Status)}
+		if r.Response != nil {
+			response["content"] = map[string]any{"application/json": map[string]any{"schema": schema(r.Response)}}
+		}
+
+		var operation = map[string]any{
+			"summary": r.Summary,
+			"responses": map[string]any{
+				strconv.Itoa(r.Status): response,
+				"default": map[string]any{
+					"description": "Error",
+					"content": map[string]any{
+						"application/json": map[string]any{"schema": schema(ErrorView{})},
+					},
+				},
+			},
+		}
+		if len(parameters) > 0 {
+			operation["parameters"] = parameters
+		}
+		if r.RequestBody != nil {
+			operation["requestBody"] = map[string]any{
+				"required": true,
+				"content":  map[string]any{"application/json": map[string]any{"schema": schema(r.RequestBody)}},
+			}
+		}
+
+		operations[strings.ToLower(r.Method)] = operation
+	}
+
+	return map[string]any{
+		"openapi": OpenAPIVersion,
+		"info": map[string]any{
+			"title":   "Better Stack facade",
+			"version": "1.0.0",
+		},
+		"paths": paths,
+	}
+}
+
+func schema(value any) map[string]any {
+	return typeSchema(reflect.TypeOf(value))
+}
+
+func typeSchema(t reflect.Type) map[string]any {
+	switch t.Kind() {
+	case reflect.Slice:
+		return map[string]any{"type": "array", "items": typeSchema(t.Elem())}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Struct:
+		var properties = map[string]any{}
+		var required []string
+		for i := 0; i < t.NumField(); i++ {
+			var field = t.Field(i)
+			var tag = strings.Split(field.Tag.Get("json"), ",")
+			if tag[0] == client.Blanc || tag[0] == "-" {
+				continue
+			}
+			properties[tag[0]] = typeSchema(field.Type)
+			if len(tag) == 1 {
+				required = append(required, tag[0])
+			}
+		}
+		var result = map[string]any{"type": "object", "properties": properties}
+		if len(required) > 0 {
+			result["required"] = required
+		}
+		return result
+	}
+	return map[string]any{}
+}
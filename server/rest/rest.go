@@ -0,0 +1,287 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+const dateFormat = "2006-01-02"
+
+// statusClientClosedRequest answers requests whose caller went away before the API answered, as nginx does
+const statusClientClosedRequest = 499
+
+// maxRequestBody caps request bodies, a template reference with a name and URL is far smaller
+const maxRequestBody = 64 << 10
+
+// MonitorView is the simplified monitor representation served by the facade. Credentials configured on the monitor
+// (auth_password, request headers) are never exposed.
+type MonitorView struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	URL     string `json:"url"`
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Paused  bool   `json:"paused"`
+	GroupID string `json:"group_id,omitempty"`
+}
+
+type GroupView struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Paused bool   `json:"paused"`
+}
+
+type SLAView struct {
+	MonitorID         string  `json:"monitor_id"`
+	From              string  `json:"from,omitempty"`
+	To                string  `json:"to,omitempty"`
+	Availability      float64 `json:"availability"`
+	TotalDowntime     int     `json:"total_downtime"`
+	NumberOfIncidents int     `json:"number_of_incidents"`
+}
+
+// CreateFromTemplateRequest creates a monitor out of a registered template, only name and URL vary per monitor
+type CreateFromTemplateRequest struct {
+	Template string `json:"template"`
+	Name     string `json:"name"`
+	URL      string `json:"url"`
+	GroupID  string `json:"group_id,omitempty"`
+}
+
+type ErrorView struct {
+	Error string `json:"error"`
+}
+
+// Facade is an http.Handler exposing a simplified REST API over the client. The Better Stack token stays inside the
+// facade, put authentication of the facade callers in front of it.
+type Facade struct {
+	client    *client.BetterstackClient
+	templates map[string]client.Monitor
+	mux       *http.ServeMux
+}
+
+// NewFacade creates the facade. Templates are monitor definitions callers can instantiate by name.
+func NewFacade(c *client.BetterstackClient, templates map[string]client.Monitor) *Facade {
+	var facade = &Facade{
+		client:    c,
+		templates: templates,
+		mux:       http.NewServeMux(),
+	}
+	for _, route := range facade.routes() {
+		facade.mux.HandleFunc(route.Method+" "+route.Path, route.handler)
+	}
+	return facade
+}
+
+func (f *Facade) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mux.ServeHTTP(w, r)
+}
+
+func (f *Facade) listMonitors(w http.ResponseWriter, r *http.Request) {
+	var monitors, monsErr = f.client.Monitors().List(r.Context())
+	if monsErr != nil {
+		f.writeUpstreamError(w, monsErr)
+		return
+	}
+
+	var result = make([]MonitorView, 0, len(monitors))
+	for _, monitor := range monitors {
+		result = append(result, monitorView(monitor))
+	}
+	f.writeJSON(w, http.StatusOK, result)
+}
+
+func (f *Facade) getMonitor(w http.ResponseWriter, r *http.Request) {
+	var monitorResponse, monErr = f.client.Monitors().Get(r.Context(), r.PathValue("id"))
+	if monErr != nil {
+		f.writeUpstreamError(w, monErr)
+		return
+	}
+	f.writeJSON(w, http.StatusOK, monitorView(monitorResponse.Data.Attributes))
+}
+
+func (f *Facade) createMonitor(w http.ResponseWriter, r *http.Request) {
+	var body, readErr = io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBody))
+	var tooLarge *http.MaxBytesError
+	if errors.As(readErr, &tooLarge) {
+		f.writeError(w, http.StatusRequestEntityTooLarge, fmt.Errorf("request body larger than %d bytes", tooLarge.Limit))
+		return
+	}
+
+	var request CreateFromTemplateRequest
+	if readErr != nil {
+		f.writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request body: %v", readErr))
+		return
+	}
+	if decErr := json.Unmarshal(body, &request); decErr != nil {
+		f.writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request body: %v", decErr))
+		return
+	}
+
+	var template, found = f.templates[request.Template]
+	if !found {
+		f.writeError(w, http.StatusBadRequest, fmt.Errorf("unknown template: %q", request.Template))
+		return
+	}
+	if funk.IsEmpty(request.Name) || funk.IsEmpty(request.URL) {
+		f.writeError(w, http.StatusBadRequest, errors.New("name and url are required"))
+		return
+	}
+
+	var monitor = template
+	monitor.ID = client.Blanc
+	monitor.PronounceableName = request.Name
+	monitor.URL = request.URL
+	if funk.NotEmpty(request.GroupID) {
+		monitor.MonitorGroupID = request.GroupID
+	}
+
+	var created, createErr = f.client.Monitors().Create(r.Context(), monitor)
+	if createErr != nil {
+		f.writeUpstreamError(w, createErr)
+		return
+	}
+	f.writeJSON(w, http.StatusCreated, monitorView(created.Data.Attributes))
+}
+
+func (f *Facade) getSLA(w http.ResponseWriter, r *http.Request) {
+	var from, fromErr = parseDate(r.URL.Query().Get("from"))
+	var to, toErr = parseDate(r.URL.Query().Get("to"))
+	if fromErr != nil || toErr != nil {
+		f.writeError(w, http.StatusBadRequest, errors.New("from and to have to be dates formatted as YYYY-MM-DD"))
+		return
+	}
+
+	var id = r.PathValue("id")
+	var slaResponse, slaErr = f.client.Monitors().SLA(r.Context(), id, from, to)
+	if slaErr != nil {
+		f.writeUpstreamError(w, slaErr)
+		return
+	}
+
+	var sla = slaResponse.Data.Attributes
+	f.writeJSON(w, http.StatusOK, SLAView{
+		MonitorID:         id,
+		From:              r.URL.Query().Get("from"),
+		To:                r.URL.Query().Get("to"),
+		Availability:      sla.Availability,
+		TotalDowntime:     sla.TotalDowntime,
+		NumberOfIncidents: sla.NumberOfIncidents,
+	})
+}
+
+func (f *Facade) pauseGroup(w http.ResponseWriter, r *http.Request) {
+	f.setGroupPaused(w, r, true)
+}
+
+func (f *Facade) resumeGroup(w http.ResponseWriter, r *http.Request) {
+	f.setGroupPaused(w, r, false)
+}
+
+// setGroupPaused sets the flag of the group only, its monitors follow as the API applies it
+func (f *Facade) setGroupPaused(w http.ResponseWriter, r *http.Request, paused bool) {
+	var id = r.PathValue("id")
+	var result client.GroupPauseResult
+	var pauseErr error
+	if paused {
+		result, pauseErr = f.client.MonitorGroups().Pause(r.Context(), id, false)
+	} else {
+		result, pauseErr = f.client.MonitorGroups().Resume(r.Context(), id, false)
+	}
+	if pauseErr != nil {
+		f.writeUpstreamError(w, pauseErr)
+		return
+	}
+
+	f.writeJSON(w, http.StatusOK, GroupView{
+		ID:     id,
+		Name:   result.Group.Name,
+		Paused: result.Group.Paused,
+	})
+}
+
+func (f *Facade) openAPI(w http.ResponseWriter, _ *http.Request) {
+	f.writeJSON(w, http.StatusOK, OpenAPI(f.routes()))
+}
+
+func monitorView(monitor client.Monitor) MonitorView {
+	return MonitorView{
+		ID:      monitor.ID,
+		Name:    monitor.PronounceableName,
+		URL:     monitor.URL,
+		Type:    monitor.MonitorType,
+		Status:  monitor.Status,
+		Paused:  monitor.Paused,
+		GroupID: monitor.GroupID(),
+	}
+}
+
+func parseDate(value string) (time.Time, error) {
+	if funk.IsEmpty(value) {
+		return time.Time{}, nil
+	}
+	return time.Parse(dateFormat, value)
+}
+
+func (f *Facade) writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set(client.ContentType, client.ApplicationJSON)
+	w.WriteHeader(status)
+	if encErr := json.NewEncoder(w).Encode(body); encErr != nil {
+		f.client.Logger().Warn("failed to write response", "error", encErr)
+	}
+}
+
+func (f *Facade) writeError(w http.ResponseWriter, status int, err error) {
+	f.writeJSON(w, status, ErrorView{Error: err.Error()})
+}
+
+// writeUpstreamError answers a failed client call with the status matching its cause, like statusError of the gRPC
+// server. Only transport errors and 5xx responses of the API are a bad gateway.
+func (f *Facade) writeUpstreamError(w http.ResponseWriter, err error) {
+	var status = upstreamStatus(err)
+	if status == http.StatusTooManyRequests {
+		var wait = max(int(math.Ceil(client.RetryAfter(err).Seconds())), 1)
+		w.Header().Set("Retry-After", strconv.Itoa(wait))
+	}
+	f.writeError(w, status, err)
+}
+
+func upstreamStatus(err error) int {
+	var urlErr *url.Error
+	switch {
+	case errors.Is(err, context.Canceled):
+		return statusClientClosedRequest
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	case errors.Is(err, client.ErrReadOnlyClient) || errors.Is(err, client.ErrDeleteVetoed):
+		return http.StatusForbidden
+	case errors.Is(err, client.ErrCircuitOpen):
+		return http.StatusServiceUnavailable
+	case errors.Is(err, client.ErrRateLimited):
+		return http.StatusTooManyRequests
+	}
+
+	switch code := client.StatusCode(err); {
+	case code == http.StatusNotFound || code == http.StatusUnprocessableEntity || code == http.StatusTooManyRequests:
+		return code
+	case code >= http.StatusInternalServerError:
+		return http.StatusBadGateway
+	case code >= http.StatusBadRequest:
+		return http.StatusBadRequest
+	case errors.As(err, &urlErr):
+		return http.StatusBadGateway
+	}
+	// Validation in the client, nothing was sent
+	return http.StatusBadRequest
+}
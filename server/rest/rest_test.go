@@ -0,0 +1,115 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+)
+
+func newTestFacade(t *testing.T, api http.HandlerFunc, opts ...client.Option) *Facade {
+	var server = httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	var templates = map[string]client.Monitor{"status": {MonitorType: "status"}}
+	return NewFacade(client.NewClient("token", append([]client.Option{client.WithBaseURL(server.URL)}, opts...)...), templates)
+}
+
+func TestCreateMonitorRejectsLargeBodies(t *testing.T) {
+	var facade = newTestFacade(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected API request %s %s", r.Method, r.URL)
+	})
+	var body = `{"template":"status","name":"` + strings.Repeat("x", maxRequestBody) + `","url":"https://example.com"}`
+	var recorder = httptest.NewRecorder()
+	facade.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/monitors", strings.NewReader(body)))
+	if recorder.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected 413, got %d: %s", recorder.Code, recorder.Body)
+	}
+}
+
+func TestRequestContextReachesTheAPI(t *testing.T) {
+	var facade = newTestFacade(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	})
+	var ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	var recorder = httptest.NewRecorder()
+	facade.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/monitors", nil).WithContext(ctx))
+	if recorder.Code != statusClientClosedRequest || !strings.Contains(recorder.Body.String(), "context canceled") {
+		t.Errorf("expected the canceled request to fail, got %d: %s", recorder.Code, recorder.Body)
+	}
+}
+
+func TestUpstreamErrorStatus(t *testing.T) {
+	// The API answers GET /monitors/<status> with that status
+	var facade = newTestFacade(t, func(w http.ResponseWriter, r *http.Request) {
+		var status, _ = strconv.Atoi(path.Base(r.URL.Path))
+		if status == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", "7")
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"errors":"failed"}`))
+	}, client.WithRetry(client.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}),
+		client.WithRateLimitRetry(client.RateLimitPolicy{MaxRetries: 1, MaxWait: time.Second}))
+
+	var cases = []struct {
+		upstream int
+		expected int
+	}{
+		{http.StatusNotFound, http.StatusNotFound},
+		{http.StatusBadRequest, http.StatusBadRequest},
+		{http.StatusUnauthorized, http.StatusBadRequest},
+		{http.StatusUnprocessableEntity, http.StatusUnprocessableEntity},
+		{http.StatusTooManyRequests, http.StatusTooManyRequests},
+		{http.StatusInternalServerError, http.StatusBadGateway},
+		{http.StatusServiceUnavailable, http.StatusBadGateway},
+	}
+	for _, tc := range cases {
+		var recorder = httptest.NewRecorder()
+		facade.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/monitors/"+strconv.Itoa(tc.upstream), nil))
+		if recorder.Code != tc.expected {
+			t.Errorf("upstream %d: expected %d, got %d: %s", tc.upstream, tc.expected, recorder.Code, recorder.Body)
+		}
+		if tc.expected == http.StatusTooManyRequests && recorder.Header().Get("Retry-After") != "7" {
+			t.Errorf("upstream 429: expected Retry-After 7, got %q", recorder.Header().Get("Retry-After"))
+		}
+	}
+}
+
+func TestUnreachableAPIIsABadGateway(t *testing.T) {
+	var server = httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	var facade = NewFacade(client.NewClient("token", client.WithBaseURL(server.URL),
+		client.WithRetry(client.RetryPolicy{MaxAttempts: 1})), nil)
+
+	var recorder = httptest.NewRecorder()
+	facade.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/monitors/1", nil))
+	if recorder.Code != http.StatusBadGateway {
+		t.Errorf("expected 502, got %d: %s", recorder.Code, recorder.Body)
+	}
+
+	var ctx, cancel = context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	recorder = httptest.NewRecorder()
+	facade.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/monitors/1", nil).WithContext(ctx))
+	if recorder.Code != http.StatusGatewayTimeout {
+		t.Errorf("expected 504 for an expired deadline, got %d: %s", recorder.Code, recorder.Body)
+	}
+}
+
+func TestPauseGroup(t *testing.T) {
+	var facade = newTestFacade(t, func(w http.ResponseWriter, r *http.Request) {
+		var paused = strconv.FormatBool(r.Method == http.MethodPatch)
+		_, _ = w.Write([]byte(`{"data":{"id":"7","attributes":{"name":"shop","paused":` + paused + `}}}`))
+	})
+	var recorder = httptest.NewRecorder()
+	facade.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/groups/7/pause", nil))
+	if recorder.Code != http.StatusOK || !strings.Contains(recorder.Body.String(), `"paused":true`) {
+		t.Errorf("expected the group paused, got %d: %s", recorder.Code, recorder.Body)
+	}
+}
@@ -0,0 +1,203 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+// Aggregate states of a page and states of its resources
+const StateOperational = "operational"
+const StateDegraded = "degraded"
+const StateDowntime = "downtime"
+const StateMaintenance = "maintenance"
+
+// Path of the document the status page frontend renders from
+const documentPath = "/index.json"
+
+const maxDocumentSize = 10 << 20
+
+type StatusPage struct {
+	URL         string
+	CompanyName string
+	State       string
+	Sections    []Section
+}
+
+type Section struct {
+	ID        string
+	Name      string
+	Resources []Resource
+}
+
+type Resource struct {
+	ID   string
+	Name string
+
+	// Monitor, Heartbeat, WebhookIntegration...
+	Type   string
+	Status string
+
+	// Percentage over the period the page shows
+	Availability float64
+}
+
+// Operational returns true when the whole page reports no problem
+func (p *StatusPage) Operational() bool {
+	return p.State == StateOperational
+}
+
+// Affected lists resources in another state than operational
+func (p *StatusPage) Affected() []Resource {
+	var result []Resource
+	for _, section := range p.Sections {
+		for _, resource := range section.Resources {
+			if resource.Status != StateOperational {
+				result = append(result, resource)
+			}
+		}
+	}
+	return result
+}
+
+type document struct {
+	Data struct {
+		ID         string `json:"id"`
+		Attributes struct {
+			CompanyName    string `json:"company_name"`
+			AggregateState string `json:"aggregate_state"`
+		} `json:"attributes"`
+	} `json:"data"`
+	Included []struct {
+		ID         string          `json:"id"`
+		Type       string          `json:"type"`
+		Attributes json.RawMessage `json:"attributes"`
+	} `json:"included"`
+}
+
+type sectionAttributes struct {
+	Name     string `json:"name"`
+	Position int    `json:"position"`
+}
+
+type resourceAttributes struct {
+	SectionID    any     `json:"status_page_section_id"`
+	ResourceType string  `json:"resource_type"`
+	PublicName   string  `json:"public_name"`
+	Status       string  `json:"status"`
+	Availability float64 `json:"availability"`
+	Position     int     `json:"position"`
+}
+
+// Fetch reads a public Better Stack status page, such as https://status.example.com. No credentials are needed,
+// the page serves the same document its frontend renders. httpClient defaults to http.DefaultClient.
+func Fetch(ctx context.Context, pageURL string, httpClient *http.Client) (*StatusPage, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	var page, parseErr = url.Parse(pageURL)
+	if parseErr != nil || funk.IsEmpty(page.Host) {
+		return nil, fmt.Errorf("invalid status page URL: %s", pageURL)
+	}
+	if funk.IsEmpty(page.Scheme) {
+		page.Scheme = "https"
+	}
+	page.Path = strings.TrimSuffix(page.Path, "/") + documentPath
+
+	var request, reqErr = http.NewRequestWithContext(ctx, http.MethodGet, page.String(), nil)
+	if reqErr != nil {
+		return nil, fmt.Errorf("failed to create request: %v", reqErr)
+	}
+	request.Header.Set("Accept", "application/json")
+
+	var response, respErr = httpClient.Do(request)
+	if respErr != nil {
+		return nil, fmt.Errorf("failed to execute request: %v", respErr)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to execute request: %v", response.Status)
+	}
+
+	var doc document
+	if unmErr := json.NewDecoder(io.LimitReader(response.Body, maxDocumentSize)).Decode(&doc); unmErr != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %v", unmErr)
+	}
+
+	var result = &StatusPage{
+		URL:         pageURL,
+		CompanyName: doc.Data.Attributes.CompanyName,
+		State:       doc.Data.Attributes.AggregateState,
+	}
+
+	var positions = map[string]int{}
+	var sections = map[string]*Section{}
+	var resources []resourceAttributes
+	var resourceIDs []string
+	for _, included := range doc.Included {
+		switch included.Type {
+		case "status_page_section":
+			var attributes sectionAttributes
+			if unmErr := json.Unmarshal(included.Attributes, &attributes); unmErr != nil {
+				return nil, fmt.Errorf("failed to unmarshal section: %v", unmErr)
+			}
+			sections[included.ID] = &Section{ID: included.ID, Name: attributes.Name}
+			positions[included.ID] = attributes.Position
+		case "status_page_resource":
+			var attributes resourceAttributes
+			if unmErr := json.Unmarshal(included.Attributes, &attributes); unmErr != nil {
+				return nil, fmt.Errorf("failed to unmarshal resource: %v", unmErr)
+			}
+			resources = append(resources, attributes)
+			resourceIDs = append(resourceIDs, included.ID)
+		}
+	}
+
+	var order = make([]int, len(resources))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return resources[order[i]].Position < resources[order[j]].Position })
+
+	for _, i := range order {
+		var attributes = resources[i]
+		var sectionID = client.StringID(attributes.SectionID)
+		var section, found = sections[sectionID]
+		if !found {
+			// Resources outside of sections are shown on top of the page
+			section = &Section{ID: sectionID}
+			sections[sectionID] = section
+			positions[sectionID] = -1
+		}
+		section.Resources = append(section.Resources, Resource{
+			ID:           resourceIDs[i],
+			Name:         attributes.PublicName,
+			Type:         attributes.ResourceType,
+			Status:       attributes.Status,
+			Availability: attributes.Availability,
+		})
+	}
+
+	for _, section := range sections {
+		result.Sections = append(result.Sections, *section)
+	}
+	sort.Slice(result.Sections, func(i, j int) bool {
+		var left, right = result.Sections[i].ID, result.Sections[j].ID
+		if positions[left] != positions[right] {
+			return positions[left] < positions[right]
+		}
+		return left < right
+	})
+
+	return result, nil
+}
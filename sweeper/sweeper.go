@@ -0,0 +1,356 @@
+package sweeper
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+)
+
+const DefaultInterval = 500 * time.Millisecond
+const DefaultMaxAttempts = 3
+
+var ErrConfirmationMismatch = errors.New("confirmation token does not match the monitors to delete")
+
+// KeepFunc returns true for monitors which must survive the sweep
+type KeepFunc func(monitor client.Monitor) bool
+
+func KeepIDs(ids ...string) KeepFunc {
+	return func(monitor client.Monitor) bool {
+		for _, id := range ids {
+			if id == monitor.ID {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func KeepNameMatching(pattern *regexp.Regexp) KeepFunc {
+	return func(monitor client.Monitor) bool {
+		return pattern.MatchString(monitor.PronounceableName)
+	}
+}
+
+func KeepInGroup(groupID string) KeepFunc {
+	return func(monitor client.Monitor) bool {
+		return monitor.GroupID() == groupID
+	}
+}
+
+// Select returns the monitors no keep filter protects, i.e. those a sweep would delete
+func Select(monitors []client.Monitor, keep ...KeepFunc) []client.Monitor {
+	var result []client.Monitor
+	for _, monitor := range monitors {
+		var kept bool
+		for _, fn := range keep {
+			if fn(monitor) {
+				kept = true
+				break
+			}
+		}
+		if !kept {
+			result = append(result, monitor)
+		}
+	}
+	return result
+}
+
+// ConfirmationToken derives the token Sweep demands for deleting exactly the given monitors. Showing it to the
+// operator and requiring it back makes sure the deletion was reviewed, and that the reviewed set did not change.
+func ConfirmationToken(targets []client.Monitor) string {
+	var ids = make([]string, 0, len(targets))
+	for _, monitor := range targets {
+		ids = append(ids, monitor.ID)
+	}
+	sort.Strings(ids)
+
+	var sum = sha256.Sum256([]byte(strings.Join(ids, ",")))
+	return fmt.Sprintf("delete-%d-%s", len(ids), hex.EncodeToString(sum[:])[:12])
+}
+
+// Events of the trash file. Sweep records every monitor as pending before deleting it and the outcome once the
+// delete returned, Restore records the monitors it recreated.
+const EventPending = "pending"
+const EventDeleted = "deleted"
+const EventFailed = "failed"
+const EventRestored = "restored"
+
+// Entry is a line of the trash file. Pending entries carry the monitor as it was before the delete, the others
+// refer to it by MonitorID.
+type Entry struct {
+	Event      string          `json:"event"`
+	MonitorID  string          `json:"monitor_id"`
+	Monitor    *client.Monitor `json:"monitor,omitempty"`
+	Error      string          `json:"error,omitempty"`
+	RestoredID string          `json:"restored_id,omitempty"`
+	At         time.Time       `json:"at"`
+}
+
+type Sweeper struct {
+	Client *client.BetterstackClient
+
+	// JSON-lines file every monitor is recorded in before and after it is deleted, Restore recreates them
+	TrashPath string
+
+	// Minimum time between two deletes, defaults to DefaultInterval
+	Interval time.Duration
+
+	// Attempts per monitor, defaults to DefaultMaxAttempts
+	MaxAttempts int
+
+	// Retries and duration of the whole sweep, optional. An exhausted budget stops the sweep.
+	Budget *client.Budget
+
+	// Receives the warnings of failed attempts, defaults to the logger of Client
+	Logger client.Logger
+}
+
+type Result struct {
+	Deleted []client.Monitor
+	Failed  map[string]error
+}
+
+// Sweep deletes the targets when token equals ConfirmationToken(targets). It keeps going when single deletes fail
+// and reports them in Result.Failed.
+func (s *Sweeper) Sweep(parent context.Context, targets []client.Monitor, token string) (Result, error) {
+	var result = Result{Failed: map[string]error{}}
+
+	if token != ConfirmationToken(targets) {
+		return result, ErrConfirmationMismatch
+	}
+	if s.TrashPath == client.Blanc {
+		return result, errors.New("a trash path is required")
+	}
+
+	var interval = s.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	var maxAttempts = s.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+
+	var trash, openErr = os.OpenFile(s.TrashPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if openErr != nil {
+		return result, fmt.Errorf("failed to open trash: %v", openErr)
+	}
+	defer trash.Close()
+
+	var ctx, cancel = s.Budget.Context(parent)
+	defer cancel()
+
+	var ticker = time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var fail = func(monitor client.Monitor, failErr error) error {
+		result.Failed[monitor.ID] = failErr
+		return writeEntry(trash, Entry{Event: EventFailed, MonitorID: monitor.ID, Error: failErr.Error()})
+	}
+
+	for _, monitor := range targets {
+		if writeErr := writeEntry(trash, Entry{Event: EventPending, MonitorID: monitor.ID, Monitor: &monitor}); writeErr != nil {
+			return result, writeErr
+		}
+
+		var deleteErr error
+		for attempt := 1; attempt <= maxAttempts; attempt++ {
+			select {
+			case <-ctx.Done():
+				return result, s.Budget.Explain(ctx.Err())
+			case <-ticker.C:
+			}
+
+			deleteErr = s.Client.Monitors().Delete(ctx, monitor.ID)
+			if ctx.Err() != nil {
+				return result, s.Budget.Explain(ctx.Err())
+			}
+			if deleteErr == nil || errors.Is(deleteErr, client.ErrReadOnlyClient) || errors.Is(deleteErr, client.ErrDeleteVetoed) {
+				break
+			}
+			s.logger().Warn("delete failed", "monitor", monitor.ID, "attempt", attempt, "error", deleteErr)
+			if attempt < maxAttempts {
+				if budgetErr := s.Budget.Spend(deleteErr); budgetErr != nil {
+					return result, errors.Join(budgetErr, fail(monitor, deleteErr))
+				}
+			}
+		}
+
+		if deleteErr != nil {
+			if writeErr := fail(monitor, deleteErr); writeErr != nil {
+				return result, writeErr
+			}
+			continue
+		}
+		if writeErr := writeEntry(trash, Entry{Event: EventDeleted, MonitorID: monitor.ID}); writeErr != nil {
+			return result, writeErr
+		}
+		result.Deleted = append(result.Deleted, monitor)
+	}
+
+	return result, nil
+}
+
+// writeEntry appends the entry and syncs, so the trash survives a crash right after
+func writeEntry(trash *os.File, entry Entry) error {
+	entry.At = time.Now().UTC()
+	var line, serErr = json.Marshal(entry)
+	if serErr != nil {
+		return fmt.Errorf("failed to serialize trash entry: %v", serErr)
+	}
+	if _, writeErr := trash.Write(append(line, '\n')); writeErr != nil {
+		return fmt.Errorf("failed to write trash: %v", writeErr)
+	}
+	if syncErr := trash.Sync(); syncErr != nil {
+		return fmt.Errorf("failed to sync trash: %v", syncErr)
+	}
+	return nil
+}
+
+type RestoreResult struct {
+	Restored []client.Monitor
+
+	// Monitors left alone, by ID, with the reason: failed deletes, monitors restored before or still existing
+	Skipped map[string]string
+}
+
+// trashState is the last known state of a monitor in the trash file
+type trashState struct {
+	event   string
+	monitor *client.Monitor
+}
+
+// readTrash folds the trash file into the state of every monitor, in order of first appearance. Lines without
+// event, written by earlier versions, hold the bare monitor and count as pending.
+func readTrash(trashPath string) ([]string, map[string]*trashState, error) {
+	var trash, openErr = os.Open(trashPath)
+	if openErr != nil {
+		return nil, nil, fmt.Errorf("failed to open trash: %v", openErr)
+	}
+	defer trash.Close()
+
+	var order []string
+	var states = map[string]*trashState{}
+	var scanner = bufio.NewScanner(trash)
+	scanner.Buffer(make([]byte, 64<<10), 16<<20)
+
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry Entry
+		if unmErr := json.Unmarshal(scanner.Bytes(), &entry); unmErr != nil {
+			return nil, nil, fmt.Errorf("failed to read trash entry: %v", unmErr)
+		}
+		if entry.Event == client.Blanc {
+			var monitor client.Monitor
+			if unmErr := json.Unmarshal(scanner.Bytes(), &monitor); unmErr != nil {
+				return nil, nil, fmt.Errorf("failed to read trash entry: %v", unmErr)
+			}
+			entry = Entry{Event: EventPending, MonitorID: monitor.ID, Monitor: &monitor}
+		}
+
+		var state, known = states[entry.MonitorID]
+		if !known {
+			state = &trashState{}
+			states[entry.MonitorID] = state
+			order = append(order, entry.MonitorID)
+		}
+		state.event = entry.Event
+		if entry.Monitor != nil {
+			state.monitor = entry.Monitor
+		}
+	}
+	if scanErr := scanner.Err(); scanErr != nil {
+		return nil, nil, fmt.Errorf("failed to read trash: %v", scanErr)
+	}
+	return order, states, nil
+}
+
+// Restore recreates the monitors the trash file records as deleted, or as pending when a sweep was interrupted.
+// Failed deletes are skipped, as are monitors which were restored before or still exist, so running it again
+// restores nothing twice. Every recreated monitor is recorded in the trash file. The monitors get new IDs.
+func Restore(ctx context.Context, c *client.BetterstackClient, trashPath string) (RestoreResult, error) {
+	var result = RestoreResult{Skipped: map[string]string{}}
+	var order, states, readErr = readTrash(trashPath)
+	if readErr != nil {
+		return result, readErr
+	}
+
+	var trash, openErr = os.OpenFile(trashPath, os.O_APPEND|os.O_WRONLY, 0o600)
+	if openErr != nil {
+		return result, fmt.Errorf("failed to open trash: %v", openErr)
+	}
+	defer trash.Close()
+
+	var errs []error
+	for _, previousID := range order {
+		if ctx.Err() != nil {
+			return result, errors.Join(append(errs, ctx.Err())...)
+		}
+		var state = states[previousID]
+		switch {
+		case state.event == EventFailed:
+			result.Skipped[previousID] = "delete failed"
+			continue
+		case state.event == EventRestored:
+			result.Skipped[previousID] = "restored before"
+			continue
+		case state.monitor == nil:
+			errs = append(errs, fmt.Errorf("trash holds no monitor %s", previousID))
+			continue
+		}
+
+		var _, getErr = c.Monitors().Get(ctx, previousID)
+		switch {
+		case getErr == nil:
+			result.Skipped[previousID] = "still exists"
+			continue
+		case client.StatusCode(getErr) != http.StatusNotFound:
+			errs = append(errs, fmt.Errorf("failed to check monitor %s: %w", previousID, getErr))
+			continue
+		}
+
+		var monitor = *state.monitor
+		monitor.ID = client.Blanc
+		monitor.Status = client.Blanc
+		monitor.CreatedAt = nil
+		monitor.UpdatedAt = nil
+
+		var created, createErr = c.Monitors().Create(ctx, monitor)
+		if createErr != nil {
+			errs = append(errs, fmt.Errorf("failed to restore monitor %s: %w", previousID, createErr))
+			continue
+		}
+		result.Restored = append(result.Restored, created.Data.Attributes)
+		if created.Data.ID == client.Blanc {
+			// Dry-run, nothing to remember
+			continue
+		}
+		var entry = Entry{Event: EventRestored, MonitorID: previousID, RestoredID: created.Data.ID}
+		if writeErr := writeEntry(trash, entry); writeErr != nil {
+			return result, errors.Join(append(errs, writeErr)...)
+		}
+	}
+
+	return result, errors.Join(errs...)
+}
+
+func (s *Sweeper) logger() client.Logger {
+	if s.Logger != nil {
+		return s.Logger
+	}
+	return s.Client.Logger()
+}
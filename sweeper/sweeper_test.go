@@ -0,0 +1,184 @@
+package sweeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+)
+
+// fakeAPI serves the monitor endpoints Sweep and Restore use, deletes of the failing IDs answer 500
+type fakeAPI struct {
+	mu       sync.Mutex
+	monitors map[string]client.Monitor
+	failing  map[string]bool
+	created  int
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var id = strings.TrimPrefix(r.URL.Path, "/api/v2/monitors")
+	id = strings.TrimPrefix(id, "/")
+
+	switch {
+	case r.Method == http.MethodDelete && f.failing[id]:
+		w.WriteHeader(http.StatusInternalServerError)
+	case r.Method == http.MethodDelete:
+		delete(f.monitors, id)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet:
+		var monitor, found = f.monitors[id]
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": id, "attributes": monitor}})
+	case r.Method == http.MethodPost:
+		var monitor client.Monitor
+		var body, _ = io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &monitor)
+		f.created++
+		var newID = fmt.Sprintf("new-%d", f.created)
+		f.monitors[newID] = monitor
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": newID, "attributes": monitor}})
+	}
+}
+
+func newFakeAPI(t *testing.T, ids ...string) (*fakeAPI, *client.BetterstackClient) {
+	var api = &fakeAPI{monitors: map[string]client.Monitor{}, failing: map[string]bool{}}
+	for _, id := range ids {
+		api.monitors[id] = client.Monitor{ID: id, PronounceableName: "monitor " + id, URL: "https://example.com"}
+	}
+	var server = httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	return api, client.NewClient("token", client.WithBaseURL(server.URL))
+}
+
+func TestSweepRecordsOutcomes(t *testing.T) {
+	var api, c = newFakeAPI(t, "1", "2")
+	api.failing["2"] = true
+	var trashPath = filepath.Join(t.TempDir(), "trash.jsonl")
+	var targets = []client.Monitor{api.monitors["1"], api.monitors["2"]}
+	var output bytes.Buffer
+	var sweeper = Sweeper{Client: c, TrashPath: trashPath, Interval: time.Millisecond, MaxAttempts: 1,
+		Logger: slog.New(slog.NewTextHandler(&output, nil))}
+
+	var result, sweepErr = sweeper.Sweep(context.Background(), targets, ConfirmationToken(targets))
+	if sweepErr != nil {
+		t.Fatalf("sweep failed: %v", sweepErr)
+	}
+	if len(result.Deleted) != 1 || result.Failed["2"] == nil {
+		t.Fatalf("expected 1 deleted and 2 failed, got %+v", result)
+	}
+	if strings.Count(output.String(), "level=WARN") != 1 || !strings.Contains(output.String(), `msg="delete failed"`) {
+		t.Errorf("expected the failed delete logged, got %s", output.String())
+	}
+
+	var _, states, readErr = readTrash(trashPath)
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	if states["1"].event != EventDeleted || states["2"].event != EventFailed {
+		t.Errorf("unexpected trash states: 1=%s 2=%s", states["1"].event, states["2"].event)
+	}
+	if states["2"].monitor == nil || states["2"].monitor.PronounceableName != "monitor 2" {
+		t.Errorf("pending entry lost the monitor: %+v", states["2"].monitor)
+	}
+}
+
+func TestSweepRefusesWrongToken(t *testing.T) {
+	var _, c = newFakeAPI(t, "1")
+	var sweeper = Sweeper{Client: c, TrashPath: filepath.Join(t.TempDir(), "trash.jsonl")}
+	var _, sweepErr = sweeper.Sweep(context.Background(), []client.Monitor{{ID: "1"}}, "delete-1-000000000000")
+	if sweepErr != ErrConfirmationMismatch {
+		t.Fatalf("expected ErrConfirmationMismatch, got %v", sweepErr)
+	}
+}
+
+func TestRestoreIsIdempotent(t *testing.T) {
+	var api, c = newFakeAPI(t, "1", "2")
+	api.failing["2"] = true
+	var trashPath = filepath.Join(t.TempDir(), "trash.jsonl")
+	var targets = []client.Monitor{api.monitors["1"], api.monitors["2"]}
+	var sweeper = Sweeper{Client: c, TrashPath: trashPath, Interval: time.Millisecond, MaxAttempts: 1}
+	if _, sweepErr := sweeper.Sweep(context.Background(), targets, ConfirmationToken(targets)); sweepErr != nil {
+		t.Fatal(sweepErr)
+	}
+
+	var first, firstErr = Restore(context.Background(), c, trashPath)
+	if firstErr != nil {
+		t.Fatalf("restore failed: %v", firstErr)
+	}
+	if len(first.Restored) != 1 || first.Restored[0].PronounceableName != "monitor 1" {
+		t.Fatalf("expected monitor 1 restored, got %+v", first.Restored)
+	}
+	if first.Skipped["2"] != "delete failed" {
+		t.Errorf("expected the failed delete skipped, got %q", first.Skipped["2"])
+	}
+
+	var second, secondErr = Restore(context.Background(), c, trashPath)
+	if secondErr != nil {
+		t.Fatalf("second restore failed: %v", secondErr)
+	}
+	if len(second.Restored) != 0 || second.Skipped["1"] != "restored before" {
+		t.Errorf("second restore recreated monitors: %+v", second)
+	}
+	if api.created != 1 {
+		t.Errorf("expected 1 create, got %d", api.created)
+	}
+}
+
+func TestRestoreSkipsExistingMonitors(t *testing.T) {
+	var api, c = newFakeAPI(t, "1", "2")
+	var trashPath = filepath.Join(t.TempDir(), "trash.jsonl")
+
+	// An interrupted sweep leaves pending entries, only monitor 1 was deleted before it stopped
+	var trash, _ = os.Create(trashPath)
+	for _, id := range []string{"1", "2"} {
+		var monitor = api.monitors[id]
+		if writeErr := writeEntry(trash, Entry{Event: EventPending, MonitorID: id, Monitor: &monitor}); writeErr != nil {
+			t.Fatal(writeErr)
+		}
+	}
+	_ = trash.Close()
+	delete(api.monitors, "1")
+
+	var result, restoreErr = Restore(context.Background(), c, trashPath)
+	if restoreErr != nil {
+		t.Fatalf("restore failed: %v", restoreErr)
+	}
+	if len(result.Restored) != 1 || result.Skipped["2"] != "still exists" {
+		t.Errorf("expected monitor 1 restored and 2 skipped, got %+v", result)
+	}
+}
+
+func TestRestoreReadsBareMonitorLines(t *testing.T) {
+	var _, c = newFakeAPI(t)
+	var trashPath = filepath.Join(t.TempDir(), "trash.jsonl")
+	var line, _ = json.Marshal(client.Monitor{ID: "7", PronounceableName: "legacy", URL: "https://example.com", Status: "up"})
+	if writeErr := os.WriteFile(trashPath, append(line, '\n'), 0o600); writeErr != nil {
+		t.Fatal(writeErr)
+	}
+
+	var result, restoreErr = Restore(context.Background(), c, trashPath)
+	if restoreErr != nil {
+		t.Fatalf("restore failed: %v", restoreErr)
+	}
+	if len(result.Restored) != 1 || result.Restored[0].PronounceableName != "legacy" {
+		t.Errorf("expected the legacy entry restored, got %+v", result.Restored)
+	}
+}
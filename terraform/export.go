@@ -0,0 +1,188 @@
+package terraform
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+	"github.com/thoas/go-funk"
+)
+
+const ResourceMonitor = "betteruptime_monitor"
+const ResourceMonitorGroup = "betteruptime_monitor_group"
+
+var nonIdentifier = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// Attributes reported by the API which the provider does not accept as arguments
+var readOnlyAttributes = []string{"id", "status", "created_at", "updated_at"}
+
+type Export struct {
+	// betteruptime provider resource blocks
+	HCL string
+
+	// terraform import commands binding the rendered resources to the live ones
+	Imports []string
+}
+
+// ExportAccount fetches every monitor and monitor group of the account and renders them
+func ExportAccount(ctx context.Context, c *client.BetterstackClient) (Export, error) {
+	var groups, groupsErr = c.MonitorGroups().List(ctx)
+	if groupsErr != nil {
+		return Export{}, fmt.Errorf("failed to list monitor groups: %v", groupsErr)
+	}
+
+	var monitors, monsErr = c.Monitors().List(ctx)
+	if monsErr != nil {
+		return Export{}, fmt.Errorf("failed to list monitors: %v", monsErr)
+	}
+
+	return Render(monitors, groups)
+}
+
+// Render renders monitor groups and monitors as HCL. Monitors referencing one of the given groups point at the group
+// resource instead of the raw ID, so the exported configuration stays consistent when applied.
+func Render(monitors []client.Monitor, groups []client.MonitorGroup) (Export, error) {
+	var result Export
+	var builder strings.Builder
+	var names = map[string]bool{}
+	var groupRefs = map[string]string{}
+
+	for _, group := range groups {
+		var name = uniqueName(names, group.Name, "group")
+		groupRefs[group.ID] = fmt.Sprintf("%s.%s.id", ResourceMonitorGroup, name)
+
+		var attributes, attrErr = toAttributes(group)
+		if attrErr != nil {
+			return result, fmt.Errorf("failed to render monitor group %s: %v", group.ID, attrErr)
+		}
+		writeBlock(&builder, ResourceMonitorGroup, name, attributes, nil)
+
+		if funk.NotEmpty(group.ID) {
+			result.Imports = append(result.Imports, importCommand(ResourceMonitorGroup, name, group.ID))
+		}
+	}
+
+	for _, monitor := range monitors {
+		var name = uniqueName(names, monitor.PronounceableName, "monitor")
+
+		var attributes, attrErr = toAttributes(monitor)
+		if attrErr != nil {
+			return result, fmt.Errorf("failed to render monitor %s: %v", monitor.ID, attrErr)
+		}
+
+		var references = map[string]string{}
+		if ref, found := groupRefs[monitor.GroupID()]; found && funk.NotEmpty(monitor.GroupID()) {
+			references["monitor_group_id"] = ref
+		}
+		writeBlock(&builder, ResourceMonitor, name, attributes, references)
+
+		if funk.NotEmpty(monitor.ID) {
+			result.Imports = append(result.Imports, importCommand(ResourceMonitor, name, monitor.ID))
+		}
+	}
+
+	result.HCL = builder.String()
+	return result, nil
+}
+
+func toAttributes(entity any) (map[string]any, error) {
+	var serialized, serErr = json.Marshal(entity)
+	if serErr != nil {
+		return nil, serErr
+	}
+
+	var attributes map[string]any
+	if unmErr := json.Unmarshal(serialized, &attributes); unmErr != nil {
+		return nil, unmErr
+	}
+
+	for _, key := range readOnlyAttributes {
+		delete(attributes, key)
+	}
+	for key, value := range attributes {
+		if value == nil || value == client.Blanc {
+			delete(attributes, key)
+		}
+	}
+
+	return attributes, nil
+}
+
+func writeBlock(builder *strings.Builder, resource, name string, attributes map[string]any, references map[string]string) {
+	var keys = funk.Keys(attributes).([]string)
+	sort.Strings(keys)
+
+	fmt.Fprintf(builder, "resource %q %q {\n", resource, name)
+	for _, key := range keys {
+		if ref, found := references[key]; found {
+			fmt.Fprintf(builder, "  %s = %s\n", key, ref)
+			continue
+		}
+		fmt.Fprintf(builder, "  %s = %s\n", key, renderValue(attributes[key], "  "))
+	}
+	builder.WriteString("}\n\n")
+}
+
+func renderValue(value any, indent string) string {
+	switch typed := value.(type) {
+	case string:
+		return quote(typed)
+	case bool:
+		return strconv.FormatBool(typed)
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	case []any:
+		var items []string
+		for _, item := range typed {
+			items = append(items, renderValue(item, indent))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case map[string]any:
+		var keys = funk.Keys(typed).([]string)
+		sort.Strings(keys)
+		var fields []string
+		for _, key := range keys {
+			if typed[key] == nil || typed[key] == client.Blanc {
+				continue
+			}
+			fields = append(fields, fmt.Sprintf("%s = %s", key, renderValue(typed[key], indent)))
+		}
+		return "{ " + strings.Join(fields, ", ") + " }"
+	}
+	return quote(fmt.Sprintf("%v", value))
+}
+
+// quote renders an HCL string literal, escaping template sequences so values are taken verbatim
+func quote(value string) string {
+	var quoted = strconv.Quote(value)
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	quoted = strings.ReplaceAll(quoted, "%{", "%%{")
+	return quoted
+}
+
+func uniqueName(taken map[string]bool, source, fallback string) string {
+	var name = strings.Trim(nonIdentifier.ReplaceAllString(strings.ToLower(source), "_"), "_")
+	if funk.IsEmpty(name) {
+		name = fallback
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		name = fallback + "_" + name
+	}
+
+	var candidate = name
+	for i := 2; taken[candidate]; i++ {
+		candidate = fmt.Sprintf("%s_%d", name, i)
+	}
+	taken[candidate] = true
+
+	return candidate
+}
+
+func importCommand(resource, name, id string) string {
+	return fmt.Sprintf("terraform import %s.%s %s", resource, name, id)
+}
@@ -0,0 +1,134 @@
+package terraform
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+)
+
+type State struct {
+	Monitors      []client.Monitor
+	MonitorGroups []client.MonitorGroup
+}
+
+type stateFile struct {
+	Version   int             `json:"version"`
+	Resources []stateResource `json:"resources"`
+}
+
+type stateResource struct {
+	Mode      string          `json:"mode"`
+	Type      string          `json:"type"`
+	Name      string          `json:"name"`
+	Instances []stateInstance `json:"instances"`
+}
+
+type stateInstance struct {
+	Attributes map[string]any `json:"attributes"`
+}
+
+func ReadStateFile(path string) (State, error) {
+	var file, openErr = os.Open(path)
+	if openErr != nil {
+		return State{}, fmt.Errorf("failed to open state file: %v", openErr)
+	}
+	defer file.Close()
+
+	return ReadState(file)
+}
+
+// ReadState parses a Terraform state (format version 4) and returns the Better Stack monitors and monitor groups
+// managed by it. Data sources and resources of other providers are ignored.
+func ReadState(r io.Reader) (State, error) {
+	var result State
+	var state stateFile
+
+	if decErr := json.NewDecoder(r).Decode(&state); decErr != nil {
+		return result, fmt.Errorf("failed to unmarshal state: %v", decErr)
+	}
+
+	if state.Version != 4 {
+		return result, fmt.Errorf("unsupported state version: %d", state.Version)
+	}
+
+	for _, resource := range state.Resources {
+		if resource.Mode != "managed" {
+			continue
+		}
+
+		for _, instance := range resource.Instances {
+			switch resource.Type {
+			case ResourceMonitor:
+				var monitor client.Monitor
+				if convErr := fromAttributes(instance.Attributes, &monitor); convErr != nil {
+					return result, fmt.Errorf("failed to read %s.%s: %v", resource.Type, resource.Name, convErr)
+				}
+				result.Monitors = append(result.Monitors, monitor)
+			case ResourceMonitorGroup:
+				var group client.MonitorGroup
+				if convErr := fromAttributes(instance.Attributes, &group); convErr != nil {
+					return result, fmt.Errorf("failed to read %s.%s: %v", resource.Type, resource.Name, convErr)
+				}
+				result.MonitorGroups = append(result.MonitorGroups, group)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// fromAttributes decodes provider attributes into a client model. The provider stores some numeric attributes
+// (e.g. port) as strings, those are converted to numbers where the model expects them.
+func fromAttributes(attributes map[string]any, target any) error {
+	var kinds = fieldKinds(reflect.TypeOf(target).Elem())
+
+	for key, value := range attributes {
+		var text, isString = value.(string)
+		if !isString {
+			continue
+		}
+		switch kinds[key] {
+		case reflect.Int:
+			if text == client.Blanc {
+				delete(attributes, key)
+				continue
+			}
+			// Ports may be a comma separated list for smtp, pop and imap monitors, the first one is used
+			var number, numErr = strconv.Atoi(strings.SplitN(text, ",", 2)[0])
+			if numErr != nil {
+				return fmt.Errorf("attribute %s: %v", key, numErr)
+			}
+			attributes[key] = number
+		case reflect.Ptr:
+			if text == client.Blanc {
+				delete(attributes, key)
+			}
+		}
+	}
+
+	var serialized, serErr = json.Marshal(attributes)
+	if serErr != nil {
+		return serErr
+	}
+
+	return json.Unmarshal(serialized, target)
+}
+
+func fieldKinds(structType reflect.Type) map[string]reflect.Kind {
+	var result = map[string]reflect.Kind{}
+	for i := 0; i < structType.NumField(); i++ {
+		var field = structType.Field(i)
+		var name = strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == client.Blanc || name == "-" {
+			continue
+		}
+		result[name] = field.Type.Kind()
+	}
+	return result
+}
@@ -0,0 +1,209 @@
+package watcher
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/qameta/betterstack/client"
+)
+
+// DefaultFreshness is how old the status of any monitor may get
+const DefaultFreshness = time.Minute
+
+// DefaultRequestsPerMinute stays well below the API rate limit of a single token
+const DefaultRequestsPerMinute = 30
+
+// StatusChange reports a monitor whose status differs from the previous poll
+type StatusChange struct {
+	Monitor  client.Monitor
+	Previous string
+	Current  string
+	At       time.Time
+}
+
+// Watcher polls the status of every monitor of the account and reports changes. Large accounts list in many pages,
+// which are spread evenly over the freshness interval instead of being fetched in a burst, and over all clients,
+// so each token only carries its share of the requests. Monitors moving between pages while a round is underway
+// may be seen twice or missed until the next round.
+type Watcher struct {
+	// Clients of the same account with different tokens, at least one
+	Clients []*client.BetterstackClient
+
+	// Every monitor is polled at least this often, defaults to DefaultFreshness. When the page count and rate
+	// budget don't allow it, the round stretches and a warning is logged.
+	Freshness time.Duration
+
+	// Rate budget of each client, defaults to DefaultRequestsPerMinute
+	RequestsPerMinute int
+
+	// Called for every status change, synchronously. The first round only learns the statuses.
+	OnChange func(change StatusChange)
+
+	// Receives the warnings of failed rounds and pages, defaults to the logger of the first client
+	Logger client.Logger
+
+	mu       sync.RWMutex
+	statuses map[string]string
+
+	// Rotates which client fetches which page, so odd page counts don't always load the first client more
+	rounds int
+}
+
+// Run polls round after round until the context is cancelled
+func (w *Watcher) Run(ctx context.Context) error {
+	if len(w.Clients) == 0 {
+		return errors.New("watcher needs at least one client")
+	}
+
+	for {
+		var started = time.Now()
+		if roundErr := w.round(ctx, started); roundErr != nil && ctx.Err() == nil {
+			w.logger().Warn("status round failed", "error", roundErr)
+		}
+
+		var wait = time.NewTimer(time.Until(started.Add(w.freshness())))
+		select {
+		case <-ctx.Done():
+			wait.Stop()
+			return ctx.Err()
+		case <-wait.C:
+		}
+	}
+}
+
+// Statuses returns the last known status of every monitor, by ID
+func (w *Watcher) Statuses() map[string]string {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	var result = make(map[string]string, len(w.statuses))
+	for id, status := range w.statuses {
+		result[id] = status
+	}
+	return result
+}
+
+func (w *Watcher) freshness() time.Duration {
+	if w.Freshness > 0 {
+		return w.Freshness
+	}
+	return DefaultFreshness
+}
+
+// spacing returns the time between two page requests: the freshness interval split over the pages, but never
+// closer than the combined rate budget of the clients allows
+func (w *Watcher) spacing(pages int) time.Duration {
+	var perMinute = w.RequestsPerMinute
+	if perMinute <= 0 {
+		perMinute = DefaultRequestsPerMinute
+	}
+	var minimum = time.Minute / time.Duration(perMinute*len(w.Clients))
+	var spacing = w.freshness() / time.Duration(pages)
+	if spacing < minimum {
+		w.logger().Warn("pages can't be polled within the freshness interval", "pages", pages,
+			"freshness", w.freshness(), "tokens", len(w.Clients), "round", minimum*time.Duration(pages))
+		return minimum
+	}
+	return spacing
+}
+
+func (w *Watcher) round(ctx context.Context, started time.Time) error {
+	w.rounds++
+	var clientFor = func(page int) *client.BetterstackClient {
+		return w.Clients[(page-1+w.rounds)%len(w.Clients)]
+	}
+
+	var first, firstErr = clientFor(1).Monitors().ListPage(ctx, 1, client.Blanc, client.Blanc)
+	if firstErr != nil {
+		return firstErr
+	}
+
+	var pages = lastPage(first.Pagination.Last)
+	var spacing = w.spacing(pages)
+	var seen = map[string]bool{}
+	var complete = true
+
+	w.apply(first.Data, seen)
+
+	for page := 2; page <= pages; page++ {
+		var due = time.NewTimer(time.Until(started.Add(time.Duration(page-1) * spacing)))
+		select {
+		case <-ctx.Done():
+			due.Stop()
+			return ctx.Err()
+		case <-due.C:
+		}
+
+		var monitors, listErr = clientFor(page).Monitors().ListPage(ctx, page, client.Blanc, client.Blanc)
+		if listErr != nil {
+			w.logger().Warn("failed to poll page", "page", page, "error", listErr)
+			complete = false
+			continue
+		}
+		w.apply(monitors.Data, seen)
+	}
+
+	// Only a complete round proves a monitor is gone
+	if complete {
+		w.mu.Lock()
+		for id := range w.statuses {
+			if !seen[id] {
+				delete(w.statuses, id)
+			}
+		}
+		w.mu.Unlock()
+	}
+
+	return nil
+}
+
+func (w *Watcher) apply(monitors []client.EntityWrapper[client.Monitor], seen map[string]bool) {
+	var changes []StatusChange
+
+	w.mu.Lock()
+	if w.statuses == nil {
+		w.statuses = map[string]string{}
+	}
+	for _, entity := range monitors {
+		var monitor = entity.Attributes
+		monitor.ID = entity.ID
+		seen[monitor.ID] = true
+
+		var previous, known = w.statuses[monitor.ID]
+		w.statuses[monitor.ID] = monitor.Status
+		if known && previous != monitor.Status {
+			changes = append(changes, StatusChange{Monitor: monitor, Previous: previous, Current: monitor.Status, At: time.Now()})
+		}
+	}
+	w.mu.Unlock()
+
+	if w.OnChange == nil {
+		return
+	}
+	for _, change := range changes {
+		w.OnChange(change)
+	}
+}
+
+func (w *Watcher) logger() client.Logger {
+	if w.Logger != nil {
+		return w.Logger
+	}
+	return w.Clients[0].Logger()
+}
+
+// lastPage reads the page number of the last link, 1 when there is none
+func lastPage(link string) int {
+	var parsed, parseErr = url.Parse(link)
+	if parseErr != nil {
+		return 1
+	}
+	var page, convErr = strconv.Atoi(parsed.Query().Get("page"))
+	if convErr != nil || page < 1 {
+		return 1
+	}
+	return page
+}
@@ -0,0 +1,118 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+)
+
+type BackfillOptions struct {
+	// Time range of the replayed transitions, zero values leave it open
+	From time.Time
+	To   time.Time
+
+	// Replay only the current state of every incident instead of each of its transitions
+	LatestOnly bool
+}
+
+type BackfillResult struct {
+	Incidents int
+	Events    int
+
+	// Time of the last transition handed to the handler, pass it as From to resume an interrupted backfill
+	Last time.Time
+}
+
+// Backfill replays the incident history to the handler, oldest transition first, as if the webhook had delivered
+// every started, acknowledged and resolved event. Payloads carry the incident as it was at that transition. Events
+// are marked Replayed and keep the IDs live deliveries would have (see ToCloudEvent), handlers should be idempotent
+// since resuming from Last replays the transitions of that instant again. Backfill stops at the first handler error.
+func Backfill(ctx context.Context, c *client.BetterstackClient, handler Handler, opts BackfillOptions) (BackfillResult, error) {
+	var result BackfillResult
+	var events []Event
+
+	var pages = c.Incidents().Pages(opts.From, opts.To)
+	for pages.Next(ctx) {
+		for _, entity := range pages.Page().Data {
+			result.Incidents++
+
+			var transitions, transitionsErr = replay(entity, opts.LatestOnly)
+			if transitionsErr != nil {
+				return result, transitionsErr
+			}
+			for _, event := range transitions {
+				var at = event.OccurredAt()
+				if !opts.From.IsZero() && at.Before(opts.From) || !opts.To.IsZero() && at.After(opts.To) {
+					continue
+				}
+				events = append(events, event)
+			}
+		}
+	}
+	if pagesErr := pages.Err(); pagesErr != nil {
+		return result, fmt.Errorf("failed to list incidents: %v", pagesErr)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].OccurredAt().Before(events[j].OccurredAt())
+	})
+
+	for _, event := range events {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return result, ctxErr
+		}
+		if handleErr := handler.Handle(ctx, event); handleErr != nil {
+			return result, fmt.Errorf("failed to handle %s of incident %s: %v", event.Kind(), event.Incident.ID, handleErr)
+		}
+		result.Events++
+		result.Last = event.OccurredAt()
+	}
+
+	return result, nil
+}
+
+// replay reconstructs the events the incident went through. Later transitions are stripped from the earlier
+// states, so a started event does not know when the incident was resolved.
+func replay(entity client.EntityWrapper[client.Incident], latestOnly bool) ([]Event, error) {
+	var states []client.Incident
+	var incident = entity.Attributes
+
+	if !latestOnly {
+		var started = incident
+		started.Status = client.IncidentStatusStarted
+		started.AcknowledgedAt, started.AcknowledgedBy = nil, client.Blanc
+		started.ResolvedAt, started.ResolvedBy = nil, client.Blanc
+		states = append(states, started)
+
+		if incident.AcknowledgedAt != nil && incident.Status != client.IncidentStatusAcknowledged {
+			var acknowledged = incident
+			acknowledged.Status = client.IncidentStatusAcknowledged
+			acknowledged.ResolvedAt, acknowledged.ResolvedBy = nil, client.Blanc
+			states = append(states, acknowledged)
+		}
+	}
+	if latestOnly || incident.Status != client.IncidentStatusStarted {
+		states = append(states, incident)
+	}
+
+	var result = make([]Event, 0, len(states))
+	for _, state := range states {
+		var stateEntity = entity
+		stateEntity.Attributes = state
+
+		var payload, serErr = json.Marshal(client.IncidentResponse{Data: stateEntity})
+		if serErr != nil {
+			return nil, fmt.Errorf("failed to serialize incident %s: %v", entity.ID, serErr)
+		}
+
+		var event = EventFromEntity(stateEntity, payload)
+		event.Replayed = true
+		result = append(result, event)
+	}
+
+	return result, nil
+}
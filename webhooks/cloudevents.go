@@ -0,0 +1,50 @@
+package webhooks
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thoas/go-funk"
+)
+
+const CloudEventsSpecVersion = "1.0"
+const CloudEventsContentType = "application/cloudevents+json"
+
+// Prefix of the CloudEvents type attribute, followed by the event kind
+const CloudEventTypePrefix = "com.betterstack.incident."
+
+// CloudEvent is a CloudEvents v1.0 envelope in the structured JSON format
+type CloudEvent struct {
+	SpecVersion     string     `json:"specversion"`
+	ID              string     `json:"id"`
+	Source          string     `json:"source"`
+	Type            string     `json:"type"`
+	Subject         string     `json:"subject,omitempty"`
+	Time            *time.Time `json:"time,omitempty"`
+	DataContentType string     `json:"datacontenttype,omitempty"`
+	Data            any        `json:"data,omitempty"`
+}
+
+// ToCloudEvent wraps an incident event in a CloudEvents envelope. Source identifies the Better Stack account or
+// integration the event came from. The ID combines the incident ID with its status, so every transition of an
+// incident gets a distinct ID while redeliveries of the same transition are deduplicated downstream.
+func ToCloudEvent(event Event, source string) CloudEvent {
+	var result = CloudEvent{
+		SpecVersion:     CloudEventsSpecVersion,
+		ID:              fmt.Sprintf("%s-%s", event.Incident.ID, event.Kind()),
+		Source:          source,
+		Type:            CloudEventTypePrefix + event.Kind(),
+		DataContentType: "application/json",
+		Data:            event.Incident,
+	}
+
+	if funk.NotEmpty(event.MonitorID) {
+		result.Subject = "monitors/" + event.MonitorID
+	}
+
+	if at := event.OccurredAt(); !at.IsZero() {
+		result.Time = &at
+	}
+
+	return result
+}
@@ -0,0 +1,143 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	json "github.com/json-iterator/go"
+	"github.com/qameta/betterstack/client"
+)
+
+// Webhook payloads larger than this are rejected
+const MaxPayloadSize = 1 << 20
+
+const EventStarted = "started"
+const EventAcknowledged = "acknowledged"
+const EventResolved = "resolved"
+
+// Event is a single incident notification delivered by a Better Stack webhook integration
+type Event struct {
+	Incident  client.Incident
+	MonitorID string
+
+	// Raw payload as delivered
+	Payload []byte
+
+	// Set on events reconstructed from the incident history by Backfill, handlers may skip side effects like paging
+	Replayed bool
+}
+
+// Kind returns which transition of the incident the event announces: started, acknowledged or resolved
+func (e Event) Kind() string {
+	return strings.ToLower(e.Incident.Status)
+}
+
+// OccurredAt returns the time of the transition announced by the event
+func (e Event) OccurredAt() time.Time {
+	var at *time.Time
+	switch e.Incident.Status {
+	case client.IncidentStatusResolved:
+		at = e.Incident.ResolvedAt
+	case client.IncidentStatusAcknowledged:
+		at = e.Incident.AcknowledgedAt
+	default:
+		at = e.Incident.StartedAt
+	}
+	if at == nil {
+		return time.Time{}
+	}
+	return *at
+}
+
+// Handler consumes incident events, whether pushed by a webhook or produced from the API
+type Handler interface {
+	Handle(ctx context.Context, event Event) error
+}
+
+type HandlerFunc func(ctx context.Context, event Event) error
+
+func (f HandlerFunc) Handle(ctx context.Context, event Event) error {
+	return f(ctx, event)
+}
+
+// Parse decodes an incident webhook payload
+func Parse(payload []byte) (Event, error) {
+	var result Event
+	var wrapper client.IncidentResponse
+
+	if unmErr := json.Unmarshal(payload, &wrapper); unmErr != nil {
+		return result, fmt.Errorf("failed to unmarshal payload: %v", unmErr)
+	}
+
+	if wrapper.Data.Type != "incident" {
+		return result, fmt.Errorf("unsupported payload type: %q", wrapper.Data.Type)
+	}
+
+	return EventFromEntity(wrapper.Data, payload), nil
+}
+
+// EventFromEntity builds an event out of an incident as returned by the API
+func EventFromEntity(entity client.EntityWrapper[client.Incident], payload []byte) Event {
+	entity.Attributes.ID = entity.ID
+	return Event{
+		Incident:  entity.Attributes,
+		MonitorID: entity.Relationships["monitor"].Data.ID,
+		Payload:   payload,
+	}
+}
+
+// HTTPHandlerOption configures the handler of NewHTTPHandler
+type HTTPHandlerOption func(h *httpHandler)
+
+type httpHandler struct {
+	logger client.Logger
+}
+
+// WithLogger sends the warnings about rejected and failed deliveries to logger, logrus by default
+func WithLogger(logger client.Logger) HTTPHandlerOption {
+	return func(h *httpHandler) {
+		h.logger = logger
+	}
+}
+
+// NewHTTPHandler returns an http.Handler which decodes webhook deliveries and passes them to the handler. Deliveries
+// the handler fails on are answered with 500 so Better Stack retries them.
+func NewHTTPHandler(handler Handler, opts ...HTTPHandlerOption) http.Handler {
+	var options httpHandler
+	for _, opt := range opts {
+		opt(&options)
+	}
+	var logger = client.LoggerOrDefault(options.logger)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var payload, readErr = io.ReadAll(io.LimitReader(r.Body, MaxPayloadSize))
+		if readErr != nil {
+			http.Error(w, "failed to read payload", http.StatusBadRequest)
+			return
+		}
+
+		var event, parseErr = Parse(payload)
+		if parseErr != nil {
+			logger.Warn("rejected webhook delivery", "error", parseErr)
+			http.Error(w, parseErr.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if handleErr := handler.Handle(r.Context(), event); handleErr != nil {
+			logger.Error("failed to handle incident", "id", event.Incident.ID, "error", handleErr)
+			http.Error(w, "failed to handle event", http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}